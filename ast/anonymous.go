@@ -0,0 +1,245 @@
+package ast
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/tzmfreedom/land/parser"
+)
+
+// AnonymousClassName and AnonymousMethodName are the class and the method which anonymous Apex is compiled to
+const (
+	AnonymousClassName  = "AnonymousBlock"
+	AnonymousMethodName = "execute"
+)
+
+var namePattern = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+
+// statementKeywords can precede `(` at the start of a statement, they are not the name or the return type of a method
+var statementKeywords = map[string]bool{
+	"if": true, "for": true, "while": true, "do": true, "switch": true, "when": true, "on": true,
+	"try": true, "catch": true, "finally": true, "else": true, "return": true, "throw": true, "new": true,
+	"insert": true, "update": true, "upsert": true, "delete": true, "undelete": true, "merge": true,
+	"this": true, "super": true,
+}
+
+// ParseAnonymous parses anonymous Apex, such as the script of `land exec`.
+// Top level statements are compiled to AnonymousBlock.execute(), and local methods and classes are
+// declared as static members of AnonymousBlock. Tokens keep their positions, so locations refer to the script.
+// Unlike ParseFile, syntax errors are returned instead of being printed.
+func ParseAnonymous(src string, fileName string, processors ...PreProcessor) (Node, error) {
+	for _, processor := range processors {
+		src = processor(src)
+	}
+	errorListener := &syntaxErrorListener{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+	lexer := parser.NewapexLexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errorListener)
+	tokens := []antlr.Token{}
+	for {
+		token := lexer.NextToken()
+		tokens = append(tokens, token)
+		if token.GetTokenType() == antlr.TokenEOF {
+			break
+		}
+	}
+	if errorListener.err != nil {
+		return nil, errorListener.err
+	}
+
+	stream := antlr.NewCommonTokenStream(newAnonymousTokenSource(lexer, tokens), 0)
+	p := parser.NewapexParser(stream)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(errorListener)
+	p.BuildParseTrees = true
+	tree := p.CompilationUnit()
+	if errorListener.err != nil {
+		return nil, errorListener.err
+	}
+	return build(tree, fileName)
+}
+
+// IsIncomplete returns true if brackets of the code are not closed, such as the first line of a block typed in the REPL.
+// Brackets in string literals and comments are not counted.
+func IsIncomplete(src string) bool {
+	lexer := parser.NewapexLexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+	types := tokenTypes(lexer)
+	depth := 0
+	for token := lexer.NextToken(); token.GetTokenType() != antlr.TokenEOF; token = lexer.NextToken() {
+		switch token.GetTokenType() {
+		case types["LPAREN"], types["LBRACE"], types["LBRACK"]:
+			depth++
+		case types["RPAREN"], types["RBRACE"], types["RBRACK"]:
+			depth--
+		}
+	}
+	return depth > 0
+}
+
+// anonymousTokenSource emits the tokens of the script wrapped in the class, such as
+//
+//	public class AnonymousBlock { <methods and classes> public static void execute() { <statements> } }
+type anonymousTokenSource struct {
+	antlr.Lexer
+	factory antlr.TokenFactory
+	types   map[string]int
+	tokens  []antlr.Token
+}
+
+func newAnonymousTokenSource(lexer antlr.Lexer, tokens []antlr.Token) *anonymousTokenSource {
+	s := &anonymousTokenSource{
+		Lexer:   lexer,
+		factory: lexer.GetTokenFactory(),
+		types:   tokenTypes(lexer),
+	}
+	eof := tokens[len(tokens)-1]
+	members, statements := s.split(tokens[:len(tokens)-1])
+	head := eof
+	if len(tokens) > 1 {
+		head = tokens[0]
+	}
+	s.tokens = append(s.tokens, s.synthesize(head, "PUBLIC", "public", "CLASS", "class", "Identifier", AnonymousClassName, "LBRACE", "{")...)
+	s.tokens = append(s.tokens, members...)
+	if len(statements) > 0 {
+		head = statements[0]
+	}
+	s.tokens = append(s.tokens, s.synthesize(head, "PUBLIC", "public", "STATIC", "static", "VOID", "void", "Identifier", AnonymousMethodName, "LPAREN", "(", "RPAREN", ")", "LBRACE", "{")...)
+	s.tokens = append(s.tokens, statements...)
+	s.tokens = append(s.tokens, s.synthesize(eof, "RBRACE", "}", "RBRACE", "}")...)
+	s.tokens = append(s.tokens, eof)
+	return s
+}
+
+func (s *anonymousTokenSource) NextToken() antlr.Token {
+	token := s.tokens[0]
+	if len(s.tokens) > 1 {
+		s.tokens = s.tokens[1:]
+	}
+	return token
+}
+
+// split separates methods and classes declared at the top level from statements.
+// Methods are made static, because there is no instance of the anonymous block.
+func (s *anonymousTokenSource) split(tokens []antlr.Token) ([]antlr.Token, []antlr.Token) {
+	members := []antlr.Token{}
+	statements := []antlr.Token{}
+	depth := 0
+	start := true
+	for i := 0; i < len(tokens); {
+		if depth == 0 && start {
+			if end, modifiersEnd, isMethod, isStatic := s.declaration(tokens, i); end > i {
+				members = append(members, tokens[i:modifiersEnd]...)
+				if isMethod && !isStatic {
+					members = append(members, s.synthesize(tokens[modifiersEnd], "STATIC", "static")...)
+				}
+				members = append(members, tokens[modifiersEnd:end]...)
+				i = end
+				continue
+			}
+			start = false
+		}
+		token := tokens[i]
+		statements = append(statements, token)
+		switch token.GetTokenType() {
+		case s.types["LBRACE"], s.types["LPAREN"]:
+			depth++
+		case s.types["RBRACE"], s.types["RPAREN"]:
+			depth--
+			start = depth == 0 && token.GetTokenType() == s.types["RBRACE"]
+		case s.types["SEMI"]:
+			start = depth == 0
+		}
+		i++
+	}
+	return members, statements
+}
+
+// declaration returns the end of the method or the class declared at i, it is i if a statement starts at i.
+// It also returns the end of annotations, where static modifier is inserted.
+func (s *anonymousTokenSource) declaration(tokens []antlr.Token, i int) (end int, modifiersEnd int, isMethod bool, isStatic bool) {
+	j := i
+	for j+1 < len(tokens) && tokens[j].GetTokenType() == s.types["AT"] {
+		j += 2
+		if j < len(tokens) && tokens[j].GetTokenType() == s.types["LPAREN"] {
+			j = s.closing(tokens, j) + 1
+		}
+	}
+	for k := j; k < len(tokens); k++ {
+		switch tokens[k].GetTokenType() {
+		case s.types["CLASS"], s.types["INTERFACE"], s.types["ENUM"]:
+			for l := k; l < len(tokens); l++ {
+				if tokens[l].GetTokenType() == s.types["LBRACE"] {
+					return s.closing(tokens, l) + 1, j, false, false
+				}
+			}
+			return i, j, false, false
+		case s.types["STATIC"]:
+			isStatic = true
+		case s.types["LPAREN"]:
+			if k-j < 2 || !isName(tokens[k-1]) {
+				return i, j, false, false
+			}
+			switch tokens[k-2].GetTokenType() {
+			case s.types["GT"], s.types["RBRACK"], s.types["VOID"]:
+			default:
+				if !isName(tokens[k-2]) {
+					return i, j, false, false
+				}
+			}
+			closing := s.closing(tokens, k)
+			if closing+1 < len(tokens) && tokens[closing+1].GetTokenType() == s.types["LBRACE"] {
+				return s.closing(tokens, closing+1) + 1, j, true, isStatic
+			}
+			return i, j, false, false
+		case s.types["SEMI"], s.types["ASSIGN"], s.types["LBRACE"], s.types["RBRACE"]:
+			return i, j, false, false
+		}
+	}
+	return i, j, false, false
+}
+
+// closing returns the index of the bracket which closes the bracket at i, or the last index if it is not closed
+func (s *anonymousTokenSource) closing(tokens []antlr.Token, i int) int {
+	open := tokens[i].GetTokenType()
+	close := s.types["RPAREN"]
+	if open == s.types["LBRACE"] {
+		close = s.types["RBRACE"]
+	}
+	depth := 0
+	for j := i; j < len(tokens); j++ {
+		switch tokens[j].GetTokenType() {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return len(tokens) - 1
+}
+
+// synthesize creates tokens of the pairs of the type and the text at the position of the anchor
+func (s *anonymousTokenSource) synthesize(anchor antlr.Token, typesAndTexts ...string) []antlr.Token {
+	tokens := []antlr.Token{}
+	for i := 0; i < len(typesAndTexts); i += 2 {
+		tokens = append(tokens, s.factory.Create(
+			anchor.GetSource(),
+			s.types[typesAndTexts[i]],
+			typesAndTexts[i+1],
+			antlr.TokenDefaultChannel,
+			anchor.GetStart(),
+			anchor.GetStop(),
+			anchor.GetLine(),
+			anchor.GetColumn(),
+		))
+	}
+	return tokens
+}
+
+func isName(token antlr.Token) bool {
+	return namePattern.MatchString(token.GetText()) && !statementKeywords[strings.ToLower(token.GetText())]
+}
@@ -0,0 +1,86 @@
+package ast
+
+import (
+	"testing"
+)
+
+func TestParseAnonymous(t *testing.T) {
+	testCases := []struct {
+		Code     string
+		Expected string
+	}{
+		{
+			`System.debug(1);`,
+			`public class AnonymousBlock { public static void execute() { System.debug(1); } }`,
+		},
+		{
+			`Integer x = twice(1);
+Integer twice(Integer i) { return i * 2; }
+class Point { Integer x; }
+if (x > 1) { x++; } else { x--; }
+System.debug(x);`,
+			`public class AnonymousBlock {
+  static Integer twice(Integer i) { return i * 2; }
+  class Point { Integer x; }
+  public static void execute() {
+    Integer x = twice(1);
+    if (x > 1) { x++; } else { x--; }
+    System.debug(x);
+  }
+}`,
+		},
+		{
+			`@TestVisible private static List<String> names() { return new List<String>{'a'}; }
+List<String> l = new List<String>{'b'};
+for (String name : names()) { l.add(name); }`,
+			`public class AnonymousBlock {
+  @TestVisible private static List<String> names() { return new List<String>{'a'}; }
+  public static void execute() {
+    List<String> l = new List<String>{'b'};
+    for (String name : names()) { l.add(name); }
+  }
+}`,
+		},
+	}
+	for _, testCase := range testCases {
+		actual, err := ParseAnonymous(testCase.Code, "<anonymous>")
+		if err != nil {
+			t.Fatalf("unexpected error raised: %s", err.Error())
+		}
+		expected, err := ParseString(testCase.Expected)
+		if err != nil {
+			t.Fatalf("unexpected error raised: %s", err.Error())
+		}
+		equalNode(t, expected, actual)
+	}
+
+	invalidCodes := []string{
+		"Integer x = ;",
+		"if (true) {",
+	}
+	for _, code := range invalidCodes {
+		if _, err := ParseAnonymous(code, "<anonymous>"); err == nil {
+			t.Errorf("expected error is not raised: %s", code)
+		}
+	}
+}
+
+func TestParseAnonymousLocation(t *testing.T) {
+	actual, err := ParseAnonymous("Integer x = 1;\n\nSystem.debug(x);", "script.apex")
+	if err != nil {
+		t.Fatalf("unexpected error raised: %s", err.Error())
+	}
+	var execute *MethodDeclaration
+	for _, declaration := range actual.(*ClassDeclaration).Declarations {
+		if m, ok := declaration.(*MethodDeclaration); ok && m.Name == AnonymousMethodName {
+			execute = m
+		}
+	}
+	if execute == nil {
+		t.Fatalf("%s is not declared", AnonymousMethodName)
+	}
+	location := execute.Statements.Statements[1].GetLocation()
+	if location.FileName != "script.apex" || location.Line != 3 || location.Column != 0 {
+		t.Errorf("unexpected location: %s %d:%d", location.FileName, location.Line, location.Column)
+	}
+}
@@ -1,6 +1,7 @@
 package ast
 
 import (
+	"fmt"
 	"strings"
 
 	"strconv"
@@ -12,6 +13,8 @@ import (
 type Builder struct {
 	*parser.BaseapexVisitor
 	Source string
+	// err is the first error of literals, such as out of range integer
+	err error
 }
 
 func (v *Builder) VisitCompilationUnit(ctx *parser.CompilationUnitContext) interface{} {
@@ -47,7 +50,10 @@ func (v *Builder) VisitTypeDeclaration(ctx *parser.TypeDeclarationContext) inter
 		decl.Annotations = setParentNodeToAnnotations(annotations, decl)
 		return decl
 	} else if n := ctx.EnumDeclaration(); n != nil {
-		return n.Accept(v)
+		decl := n.Accept(v).(*EnumDeclaration)
+		decl.Modifiers = setParentNodeToModifiers(modifiers, decl)
+		decl.Annotations = setParentNodeToAnnotations(annotations, decl)
+		return decl
 	}
 	panic("not pass")
 }
@@ -126,15 +132,28 @@ func (v *Builder) VisitClassDeclaration(ctx *parser.ClassDeclarationContext) int
 }
 
 func (v *Builder) VisitEnumDeclaration(ctx *parser.EnumDeclarationContext) interface{} {
-	return v.VisitChildren(ctx)
+	n := &EnumDeclaration{
+		Name:      ctx.ApexIdentifier().GetText(),
+		Constants: []string{},
+		Location:  v.newLocation(ctx),
+	}
+	if constants := ctx.EnumConstants(); constants != nil {
+		n.Constants = constants.Accept(v).([]string)
+	}
+	return n
 }
 
 func (v *Builder) VisitEnumConstants(ctx *parser.EnumConstantsContext) interface{} {
-	return v.VisitChildren(ctx)
+	allConstants := ctx.AllEnumConstant()
+	constants := make([]string, len(allConstants))
+	for i, c := range allConstants {
+		constants[i] = c.Accept(v).(string)
+	}
+	return constants
 }
 
 func (v *Builder) VisitEnumConstant(ctx *parser.EnumConstantContext) interface{} {
-	return v.VisitChildren(ctx)
+	return ctx.ApexIdentifier().GetText()
 }
 
 func (v *Builder) VisitEnumBodyDeclarations(ctx *parser.EnumBodyDeclarationsContext) interface{} {
@@ -216,9 +235,10 @@ func (v *Builder) VisitClassBodyDeclaration(ctx *parser.ClassBodyDeclarationCont
 			decl.Modifiers = declarationModifiers
 			decl.Annotations = declarationAnnotations
 			return decl
-		//case *EnumDeclaration:
-		//	decl.Modifiers = declarationModifiers
-		//	return decl
+		case *EnumDeclaration:
+			decl.Modifiers = declarationModifiers
+			decl.Annotations = declarationAnnotations
+			return decl
 		case *PropertyDeclaration:
 			decl.Modifiers = declarationModifiers
 			decl.Annotations = declarationAnnotations
@@ -569,15 +589,21 @@ func (v *Builder) VisitQualifiedName(ctx *parser.QualifiedNameContext) interface
 
 func (v *Builder) VisitLiteral(ctx *parser.LiteralContext) interface{} {
 	if lit := ctx.IntegerLiteral(); lit != nil {
-		val, err := strconv.Atoi(lit.GetText())
+		text := strings.Replace(lit.GetText(), "_", "", -1)
+		isLong := strings.HasSuffix(text, "l") || strings.HasSuffix(text, "L")
+		if isLong {
+			text = text[:len(text)-1]
+		}
+		// Apex has no octal and hex literals, leading zeros are decimal such as 010
+		val, err := strconv.ParseInt(text, 10, 64)
 		if err != nil {
-			panic(err)
+			v.literalError("Illegal integer", lit.GetText(), ctx)
 		}
-		return &IntegerLiteral{Value: val, Location: v.newLocation(ctx)}
+		return &IntegerLiteral{Value: int(val), IsLong: isLong, Location: v.newLocation(ctx)}
 	} else if lit := ctx.FloatingPointLiteral(); lit != nil {
 		val, err := strconv.ParseFloat(lit.GetText(), 64)
 		if err != nil {
-			panic(err)
+			v.literalError("Illegal double", lit.GetText(), ctx)
 		}
 		return &DoubleLiteral{Value: val, Location: v.newLocation(ctx)}
 	} else if lit := ctx.StringLiteral(); lit != nil {
@@ -597,6 +623,12 @@ func (v *Builder) VisitAnnotation(ctx *parser.AnnotationContext) interface{} {
 	// TODO: implement annotationName
 	annotation.Name = name.Name[0]
 	annotation.Location = v.newLocation(ctx)
+	if pairs := ctx.ElementValuePairs(); pairs != nil {
+		annotation.Parameters = pairs.Accept(v).([]Node)
+	} else if value := ctx.ElementValue(); value != nil {
+		annotation.Parameters = []Node{value.Accept(v).(Node)}
+	}
+	setParentNodeToNodes(annotation.Parameters, annotation)
 	return annotation
 }
 
@@ -614,10 +646,18 @@ func (v *Builder) VisitElementValuePairs(ctx *parser.ElementValuePairsContext) i
 }
 
 func (v *Builder) VisitElementValuePair(ctx *parser.ElementValuePairContext) interface{} {
-	ctx.ApexIdentifier().GetText()
-	ctx.ElementValue().Accept(v)
-	// TODO: implement
-	return nil
+	pair := &BinaryOperator{
+		Op: "=",
+		Left: &Name{
+			Value:    []string{ctx.ApexIdentifier().GetText()},
+			Location: v.newLocation(ctx),
+		},
+		Right:    ctx.ElementValue().Accept(v).(Node),
+		Location: v.newLocation(ctx),
+	}
+	pair.Left.(*Name).Parent = pair
+	setParentNodeToNodes([]Node{pair.Right}, pair)
+	return pair
 }
 
 func (v *Builder) VisitElementValue(ctx *parser.ElementValueContext) interface{} {
@@ -827,7 +867,7 @@ func (v *Builder) VisitWhenStatement(ctx *parser.WhenStatementContext) interface
 }
 
 func (v *Builder) VisitWhenExpression(ctx *parser.WhenExpressionContext) interface{} {
-	if literals := ctx.AllLiteral(); len(literals) != 0 {
+	if literals := ctx.AllWhenLiteral(); len(literals) != 0 {
 		expressions := make([]Node, len(literals))
 		for i, l := range literals {
 			expressions[i] = l.Accept(v).(Node)
@@ -840,6 +880,25 @@ func (v *Builder) VisitWhenExpression(ctx *parser.WhenExpressionContext) interfa
 	return []Node{n}
 }
 
+func (v *Builder) VisitWhenLiteral(ctx *parser.WhenLiteralContext) interface{} {
+	if l := ctx.Literal(); l != nil {
+		n := l.Accept(v)
+		if ctx.SUB() == nil {
+			return n
+		}
+		// negative number, such as `when -1`
+		if lit, ok := n.(*IntegerLiteral); ok {
+			lit.Value = -lit.Value
+			lit.Location = v.newLocation(ctx)
+			return lit
+		}
+		v.literalError("Illegal when value", ctx.GetText(), ctx)
+		return n
+	}
+	// enum constant, such as `when WINTER, SPRING {`
+	return &Name{Value: []string{ctx.Identifier().GetText()}, Location: v.newLocation(ctx)}
+}
+
 func (v *Builder) VisitForControl(ctx *parser.ForControlContext) interface{} {
 	if c := ctx.EnhancedForControl(); c != nil {
 		return c.Accept(v)
@@ -1004,7 +1063,7 @@ func (v *Builder) VisitCastExpression(ctx *parser.CastExpressionContext) interfa
 
 func (v *Builder) VisitShiftExpression(ctx *parser.ShiftExpressionContext) interface{} {
 	n := &BinaryOperator{Location: v.newLocation(ctx)}
-	ops := []string{}
+	ops := make([]string, len(ctx.GetOp()))
 	for i, o := range ctx.GetOp() {
 		ops[i] = o.GetText()
 	}
@@ -1175,16 +1234,12 @@ func (v *Builder) VisitArrayCreatorRest(ctx *parser.ArrayCreatorRestContext) int
 func (v *Builder) VisitMapCreatorRest(ctx *parser.MapCreatorRestContext) interface{} {
 	keys := ctx.AllMapKey()
 	values := ctx.AllMapValue()
-	if len(keys) == 0 {
-		return &Init{
-			Values: map[Node]Node{},
-		}
-	}
-	initValues := map[Node]Node{}
+	initValues := make([]*MapEntry, len(keys))
 	for i, key := range keys {
-		keyNode := key.Accept(v).(Node)
-		valueNode := values[i].Accept(v).(Node)
-		initValues[keyNode] = valueNode
+		initValues[i] = &MapEntry{
+			Key:   key.Accept(v).(Node),
+			Value: values[i].Accept(v).(Node),
+		}
 	}
 	return &Init{Values: initValues}
 }
@@ -1295,6 +1350,8 @@ func (v *Builder) VisitApexIdentifier(ctx *parser.ApexIdentifierContext) interfa
 		return t.GetText()
 	} else if t := ctx.SYSTEM(); t != nil {
 		return t.GetText()
+	} else if t := ctx.END(); t != nil {
+		return t.GetText()
 	} else if t := ctx.PrimitiveType(); t != nil {
 		return t.Accept(v)
 	}
@@ -1315,7 +1372,8 @@ func (v *Builder) VisitTypeIdentifier(ctx *parser.TypeIdentifierContext) interfa
 	} else if t := ctx.SCOPE(); t != nil {
 		return t.GetText()
 	}
-	return nil
+	// other alternatives are single keyword tokens such as SYSTEM
+	return ctx.GetText()
 }
 
 /**
@@ -1442,7 +1500,11 @@ func (v *Builder) VisitOrderClause(ctx *parser.OrderClauseContext) interface{} {
 	for i, f := range ctx.AllSoqlField() {
 		fields[i] = f.Accept(v).(Node)
 	}
-	n.Asc = ctx.GetAsc_desc().GetText() == "asc"
+	n.Field = fields
+	n.Asc = true
+	if ascDesc := ctx.GetAsc_desc(); ascDesc != nil {
+		n.Asc = strings.ToLower(ascDesc.GetText()) == "asc"
+	}
 	if nulls := ctx.GetNulls(); nulls != nil {
 		n.Nulls = nulls.GetText()
 	}
@@ -1521,6 +1583,14 @@ type LocationContext interface {
 	GetStart() antlr.Token
 }
 
+// literalError records the error of the literal, the parse tree is built to the end and the first error is returned
+func (v *Builder) literalError(message string, text string, ctx LocationContext) {
+	if v.err == nil {
+		loc := v.newLocation(ctx)
+		v.err = fmt.Errorf("%s: %s at %d:%d in %s", message, text, loc.Line, loc.Column, loc.FileName)
+	}
+}
+
 func (v *Builder) newLocation(ctx LocationContext) *Location {
 	return &Location{
 		FileName: v.Source,
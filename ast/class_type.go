@@ -39,11 +39,22 @@ func (t *ClassType) IsVirtual() bool {
 	return t.Is("virtual")
 }
 
+func (t *ClassType) IsAnnotated(name string) bool {
+	return t.GetAnnotation(name) != nil
+}
+
+func (t *ClassType) GetAnnotation(name string) *Annotation {
+	return findAnnotation(t.Annotations, name)
+}
+
 func (t *ClassType) IsGenerics() bool {
 	return t.Name == "List" ||
 		t.Name == "Map" ||
 		t.Name == "Set" ||
-		t.Name == "Batchable"
+		t.Name == "Batchable" ||
+		t.Name == "Iterable" ||
+		t.Name == "Iterator" ||
+		t.Name == "Comparator"
 }
 
 func (t *ClassType) String() string {
@@ -105,8 +116,9 @@ func (f *Field) IsAccessor(modifier string, checkSetter bool) bool {
 	return is
 }
 
+// IsPublic returns true if the field is public or global, global is visible wherever public is
 func (f *Field) IsPublic(checkSetter bool) bool {
-	return f.IsAccessor("public", checkSetter)
+	return f.IsAccessor("public", checkSetter) || f.IsAccessor("global", checkSetter)
 }
 
 func (f *Field) IsPrivate(checkSetter bool) bool {
@@ -220,8 +232,9 @@ func NewConstructor(owner *ClassType, decl *ConstructorDeclaration) *Method {
 	}
 }
 
+// IsPublic returns true if the method is public or global, global is visible wherever public is
 func (m *Method) IsPublic() bool {
-	return m.Is("public")
+	return m.Is("public") || m.Is("global")
 }
 
 func (m *Method) IsPrivate() bool {
@@ -237,13 +250,11 @@ func (m *Method) IsTestMethod() bool {
 }
 
 func (m *Method) IsAnnotated(name string) bool {
-	name = strings.ToLower(name)
-	for _, annotation := range m.Annotations {
-		if strings.ToLower(annotation.Name) == name {
-			return true
-		}
-	}
-	return false
+	return m.GetAnnotation(name) != nil
+}
+
+func (m *Method) GetAnnotation(name string) *Annotation {
+	return findAnnotation(m.Annotations, name)
 }
 
 func (m *Method) AccessModifier() string {
@@ -311,7 +322,7 @@ func (m *MethodMap) Get(k string) ([]*Method, bool) {
 }
 
 func (m *MethodMap) All() [][]*Method {
-	methods := make([][]*Method, len(m.Data))
+	methods := make([][]*Method, 0, len(m.Data))
 	for _, v := range m.Data {
 		methods = append(methods, v)
 	}
@@ -392,49 +403,6 @@ func CreateField(
 	}
 }
 
-func CreateEnum(name string, enums []string) *ClassType {
-	classType := &ClassType{
-		Name:            name,
-		Modifiers:       []*Modifier{PublicModifier()},
-		InstanceFields:  NewFieldMap(),
-		InstanceMethods: NewMethodMap(),
-		StaticMethods:   NewMethodMap(),
-		InnerClasses:    NewClassMap(),
-	}
-	classType.Constructors = []*Method{
-		{
-			Modifiers: []*Modifier{PublicModifier()},
-			Parameters: []*Parameter{
-				{
-					Type: classType,
-					Name: "_",
-				},
-			},
-			NativeFunction: func(this *Object, params []*Object, extra map[string]interface{}) interface{} {
-				this.Extra["value"] = params[0]
-				return nil
-			},
-		},
-	}
-
-	fields := NewFieldMap()
-	for _, enum := range enums {
-		fields.Set(enum, &Field{
-			Name:      name,
-			Modifiers: []*Modifier{PublicModifier()},
-			Type:      classType,
-			Expression: &New{
-				Type: classType,
-				Parameters: []Node{
-					&StringLiteral{Value: enum},
-				},
-			},
-		})
-	}
-	classType.StaticFields = fields
-	return classType
-}
-
 var publicModifier = &Modifier{Name: "public"}
 var privateModifier = &Modifier{Name: "private"}
 var protectedModifier = &Modifier{Name: "protected"}
@@ -444,3 +412,29 @@ var abstractModifier = &Modifier{Name: "abstract"}
 func PublicModifier() *Modifier {
 	return publicModifier
 }
+
+func findAnnotation(annotations []*Annotation, name string) *Annotation {
+	name = strings.ToLower(name)
+	for _, annotation := range annotations {
+		if strings.ToLower(annotation.Name) == name {
+			return annotation
+		}
+	}
+	return nil
+}
+
+// Parameter returns the value of name=value pair in the annotation, such as SeeAllData in @isTest(SeeAllData=true)
+func (a *Annotation) Parameter(name string) (Node, bool) {
+	name = strings.ToLower(name)
+	for _, p := range a.Parameters {
+		pair, ok := p.(*BinaryOperator)
+		if !ok || pair.Op != "=" {
+			continue
+		}
+		key, ok := pair.Left.(*Name)
+		if ok && strings.ToLower(key.Value[0]) == name {
+			return pair.Right, true
+		}
+	}
+	return nil, false
+}
@@ -39,6 +39,10 @@ func VisitInterfaceDeclaration(v Visitor, n *InterfaceDeclaration) (interface{},
 	return visitChildren(v, n)
 }
 
+func VisitEnumDeclaration(v Visitor, n *EnumDeclaration) (interface{}, error) {
+	return visitChildren(v, n)
+}
+
 func VisitIntegerLiteral(v Visitor, n *IntegerLiteral) (interface{}, error) {
 	return visitChildren(v, n)
 }
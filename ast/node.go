@@ -44,8 +44,18 @@ type InterfaceDeclaration struct {
 	Parent      Node
 }
 
+type EnumDeclaration struct {
+	Annotations []*Annotation
+	Modifiers   []*Modifier
+	Name        string
+	Constants   []string
+	Location    *Location
+	Parent      Node
+}
+
 type IntegerLiteral struct {
 	Value    int
+	IsLong   bool
 	Location *Location
 	Parent   Node
 }
@@ -193,10 +203,16 @@ type New struct {
 
 type Init struct {
 	Records []Node
-	Values  map[Node]Node
+	Values  []*MapEntry
 	Sizes   []Node
 }
 
+// MapEntry is key and value of map initializer, such as `'a' => 1`
+type MapEntry struct {
+	Key   Node
+	Value Node
+}
+
 type NullLiteral struct {
 	Location *Location
 	Parent   Node
@@ -504,6 +520,7 @@ type Visitor interface {
 	VisitModifier(*Modifier) (interface{}, error)
 	VisitAnnotation(*Annotation) (interface{}, error)
 	VisitInterfaceDeclaration(*InterfaceDeclaration) (interface{}, error)
+	VisitEnumDeclaration(*EnumDeclaration) (interface{}, error)
 	VisitIntegerLiteral(*IntegerLiteral) (interface{}, error)
 	VisitParameter(*Parameter) (interface{}, error)
 	VisitArrayAccess(*ArrayAccess) (interface{}, error)
@@ -614,6 +631,19 @@ func (n *InterfaceDeclaration) GetChildren() []interface{} {
 	}
 }
 
+func (n *EnumDeclaration) Accept(v Visitor) (interface{}, error) {
+	return v.VisitEnumDeclaration(n)
+}
+
+func (n *EnumDeclaration) GetChildren() []interface{} {
+	return []interface{}{
+		n.Name,
+		n.Annotations,
+		n.Constants,
+		n.Modifiers,
+	}
+}
+
 func (n *IntegerLiteral) Accept(v Visitor) (interface{}, error) {
 	return v.VisitIntegerLiteral(n)
 }
@@ -717,11 +747,14 @@ func (n *Try) Accept(v Visitor) (interface{}, error) {
 }
 
 func (n *Try) GetChildren() []interface{} {
-	return []interface{}{
+	children := []interface{}{
 		n.Block,
 		n.CatchClause,
-		n.FinallyBlock,
 	}
+	if n.FinallyBlock != nil {
+		children = append(children, n.FinallyBlock)
+	}
+	return children
 }
 
 func (n *Catch) Accept(v Visitor) (interface{}, error) {
@@ -1241,6 +1274,9 @@ func (n *Annotation) GetType() string {
 func (n *InterfaceDeclaration) GetType() string {
 	return "InterfaceDeclaration"
 }
+func (n *EnumDeclaration) GetType() string {
+	return "EnumDeclaration"
+}
 func (n *IntegerLiteral) GetType() string {
 	return "Integer"
 }
@@ -1427,6 +1463,9 @@ func (n *Annotation) GetParent() Node {
 func (n *InterfaceDeclaration) GetParent() Node {
 	return n.Parent
 }
+func (n *EnumDeclaration) GetParent() Node {
+	return n.Parent
+}
 func (n *IntegerLiteral) GetParent() Node {
 	return n.Parent
 }
@@ -1617,6 +1656,10 @@ func (n *InterfaceDeclaration) SetParent(parent Node) {
 	n.Parent = parent
 }
 
+func (n *EnumDeclaration) SetParent(parent Node) {
+	n.Parent = parent
+}
+
 func (n *IntegerLiteral) SetParent(parent Node) {
 	n.Parent = parent
 }
@@ -1857,6 +1900,10 @@ func (n *InterfaceDeclaration) GetLocation() *Location {
 	return n.Location
 }
 
+func (n *EnumDeclaration) GetLocation() *Location {
+	return n.Location
+}
+
 func (n *IntegerLiteral) GetLocation() *Location {
 	return n.Location
 }
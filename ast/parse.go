@@ -1,6 +1,7 @@
 package ast
 
 import (
+	"fmt"
 	"io/ioutil"
 
 	"github.com/antlr/antlr4/runtime/Go/antlr"
@@ -19,7 +20,7 @@ func ParseFile(f string, processors ...PreProcessor) (Node, error) {
 		src = processor(src)
 	}
 	input := antlr.NewInputStream(src)
-	return parse(input, f), nil
+	return parse(input, f)
 }
 
 func ParseString(src string, processors ...PreProcessor) (Node, error) {
@@ -27,18 +28,73 @@ func ParseString(src string, processors ...PreProcessor) (Node, error) {
 		src = processor(src)
 	}
 	input := antlr.NewInputStream(src)
-	return parse(input, "<string>"), nil
+	return parse(input, "<string>")
 }
 
-func parse(input antlr.CharStream, src string) Node {
+func parse(input antlr.CharStream, src string) (Node, error) {
 	lexer := parser.NewapexLexer(input)
 	stream := antlr.NewCommonTokenStream(lexer, 0)
 	p := parser.NewapexParser(stream)
 	// p.AddErrorListener(antlr.NewDiagnosticErrorListener(true))
 	p.BuildParseTrees = true
 	tree := p.CompilationUnit()
-	t := tree.Accept(&Builder{
-		Source: src,
-	})
-	return t.(Node)
+	return build(tree, src)
+}
+
+// build converts the parse tree to the AST, and returns the first error of literals
+func build(tree antlr.ParseTree, src string) (Node, error) {
+	builder := &Builder{Source: src}
+	t := tree.Accept(builder)
+	if builder.err != nil {
+		return nil, builder.err
+	}
+	return t.(Node), nil
+}
+
+// ParseSoql parses query string of dynamic SOQL, such as Database.getQueryLocator('SELECT ...')
+func ParseSoql(query string) (*Soql, error) {
+	errorListener := &syntaxErrorListener{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+	lexer := parser.NewapexLexer(antlr.NewInputStream(query))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errorListener)
+	stream := antlr.NewCommonTokenStream(lexer, 0)
+	p := parser.NewapexParser(stream)
+	p.RemoveErrorListeners()
+	p.AddErrorListener(errorListener)
+	p.BuildParseTrees = true
+	tree := p.Query()
+	if errorListener.err != nil {
+		return nil, errorListener.err
+	}
+	if token := stream.LT(1); token.GetTokenType() != antlr.TokenEOF {
+		return nil, fmt.Errorf("unexpected token at %d:%d: %s", token.GetLine(), token.GetColumn(), token.GetText())
+	}
+	soql, err := build(tree, "<query>")
+	if err != nil {
+		return nil, err
+	}
+	return soql.(*Soql), nil
+}
+
+// syntaxErrorListener records the first syntax error instead of printing it
+type syntaxErrorListener struct {
+	*antlr.DefaultErrorListener
+	err error
+}
+
+func (l *syntaxErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	if l.err == nil {
+		l.err = fmt.Errorf("unexpected token at %d:%d: %s", line, column, msg)
+	}
+}
+
+// tokenTypes returns the token types of the lexer by the symbolic names, such as LBRACE
+func tokenTypes(lexer antlr.Lexer) map[string]int {
+	types := map[string]int{}
+	for i, name := range lexer.GetSymbolicNames() {
+		if name != "" {
+			types[name] = i
+		}
+	}
+	return types
 }
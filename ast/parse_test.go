@@ -46,6 +46,26 @@ func TestParse(t *testing.T) {
 				Declarations: []Node{},
 			},
 		},
+		{
+			`@isTest(SeeAllData=true) class Foo {}`,
+			&ClassDeclaration{
+				Modifiers: []*Modifier{},
+				Annotations: []*Annotation{
+					{
+						Name: "isTest",
+						Parameters: []Node{
+							&BinaryOperator{
+								Op:    "=",
+								Left:  &Name{Value: []string{"SeeAllData"}},
+								Right: &BooleanLiteral{Value: true},
+							},
+						},
+					},
+				},
+				Name:         "Foo",
+				Declarations: []Node{},
+			},
+		},
 		{
 			`class Foo {
 public Integer field;
@@ -369,6 +389,44 @@ i = (1 + 2) * 3;
 		{
 			`class Foo {
 public void action(){
+l = 3000000000L;
+i = ~1 >>> 2;
+}
+}`,
+			createExpectedClass([]Node{
+				&BinaryOperator{
+					Op: "=",
+					Left: &Name{
+						Value: []string{"l"},
+					},
+					Right: &IntegerLiteral{
+						Value:  3000000000,
+						IsLong: true,
+					},
+				},
+				&BinaryOperator{
+					Op: "=",
+					Left: &Name{
+						Value: []string{"i"},
+					},
+					Right: &BinaryOperator{
+						Op: ">>>",
+						Left: &UnaryOperator{
+							Op: "~",
+							Expression: &IntegerLiteral{
+								Value: 1,
+							},
+						},
+						Right: &IntegerLiteral{
+							Value: 2,
+						},
+					},
+				},
+			}),
+		},
+		{
+			`class Foo {
+public void action(){
 foo.bar.baz;
 foo.bar.baz();
 foo(1, s, false);
@@ -553,6 +611,75 @@ public void action(){
 		},
 		{
 			`class Foo {
+public void action(){
+  switch on season {
+    when WINTER, Spring {
+      true;
+    }
+    when else {
+      false;
+    }
+  }
+}
+}`,
+			createExpectedClass([]Node{
+				&Switch{
+					Expression: &Name{
+						Value: []string{"season"},
+					},
+					WhenStatements: []*When{
+						{
+							Condition: []Node{
+								&Name{
+									Value: []string{"WINTER"},
+								},
+								&Name{
+									Value: []string{"Spring"},
+								},
+							},
+							Statements: &Block{
+								Statements: []Node{
+									&BooleanLiteral{
+										Value: true,
+									},
+								},
+							},
+						},
+					},
+					ElseStatement: &Block{
+						Statements: []Node{
+							&BooleanLiteral{
+								Value: false,
+							},
+						},
+					},
+				},
+			}),
+		},
+		{
+			`class Foo {
+public void action(){
+m.end(1);
+}
+}`,
+			createExpectedClass([]Node{
+				&MethodInvocation{
+					NameOrExpression: &FieldAccess{
+						Expression: &Name{
+							Value: []string{"m"},
+						},
+						FieldName: "end",
+					},
+					Parameters: []Node{
+						&IntegerLiteral{
+							Value: 1,
+						},
+					},
+				},
+			}),
+		},
+		{
+			`class Foo {
 public void action(){
   for (Integer i = 0; i < imax; i++) {
     continue;
@@ -862,6 +989,108 @@ true;
 	}
 }
 
+func TestParseSoql(t *testing.T) {
+	actual, err := ParseSoql("SELECT Id, Name FROM Account WHERE Name = 'foo'")
+	if err != nil {
+		t.Fatalf("unexpected error raised: %s", err.Error())
+	}
+	equalNode(t, &Soql{
+		SelectFields: []Node{
+			&SelectField{Value: []string{"Id"}},
+			&SelectField{Value: []string{"Name"}},
+		},
+		FromObject: "Account",
+		Where: &WhereCondition{
+			Field:      &SelectField{Value: []string{"Name"}},
+			Op:         "=",
+			Expression: &StringLiteral{Value: "foo"},
+		},
+	}, actual)
+
+	invalidQueries := []string{
+		"SELECT FROM Account",
+		"SELECT Id FROM Account Foo Bar",
+	}
+	for _, query := range invalidQueries {
+		if _, err := ParseSoql(query); err == nil {
+			t.Errorf("expected error is not raised: %s", query)
+		}
+	}
+}
+
+func TestParseNumberLiteral(t *testing.T) {
+	testCases := []struct {
+		Code     string
+		Expected Node
+		Error    string
+	}{
+		{"010", &IntegerLiteral{Value: 10}, ""},
+		{"08", &IntegerLiteral{Value: 8}, ""},
+		{"1_000L", &IntegerLiteral{Value: 1000, IsLong: true}, ""},
+		{"1.5", &DoubleLiteral{Value: 1.5}, ""},
+		{"99999999999999999999", nil, "Illegal integer: 99999999999999999999 at 1:48 in <string>"},
+		{"0x1F", nil, "Illegal integer: 0x1F at 1:48 in <string>"},
+		{"1e999", nil, "Illegal double: 1e999 at 1:48 in <string>"},
+	}
+	for _, testCase := range testCases {
+		actual, err := ParseString("class Foo { public void action() { System.debug(" + testCase.Code + "); } }")
+		if testCase.Error != "" {
+			if err == nil || err.Error() != testCase.Error {
+				t.Errorf("%s: expected error %s, actual %v", testCase.Code, testCase.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error raised: %s", testCase.Code, err.Error())
+			continue
+		}
+		equalNode(t, createExpectedClass([]Node{
+			&MethodInvocation{
+				NameOrExpression: &Name{Value: []string{"System", "debug"}},
+				Parameters:       []Node{testCase.Expected},
+			},
+		}), actual)
+	}
+}
+
+func TestParseWhenLiteral(t *testing.T) {
+	testCases := []struct {
+		Code     string
+		Expected []Node
+		Error    string
+	}{
+		{"-5", []Node{&IntegerLiteral{Value: -5}}, ""},
+		{"1, -2L", []Node{&IntegerLiteral{Value: 1}, &IntegerLiteral{Value: -2, IsLong: true}}, ""},
+		{"WINTER, SPRING", []Node{&Name{Value: []string{"WINTER"}}, &Name{Value: []string{"SPRING"}}}, ""},
+		{"-'a'", nil, "Illegal when value: -'a' at 1:54 in <string>"},
+	}
+	for _, testCase := range testCases {
+		actual, err := ParseString("class Foo { public void action() { switch on i { when " + testCase.Code + " { } when else { } } } }")
+		if testCase.Error != "" {
+			if err == nil || err.Error() != testCase.Error {
+				t.Errorf("%s: expected error %s, actual %v", testCase.Code, testCase.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error raised: %s", testCase.Code, err.Error())
+			continue
+		}
+		equalNode(t, createExpectedClass([]Node{
+			&Switch{
+				Expression: &Name{Value: []string{"i"}},
+				WhenStatements: []*When{
+					{
+						Condition:  testCase.Expected,
+						Statements: &Block{Statements: []Node{}},
+					},
+				},
+				ElseStatement: &Block{Statements: []Node{}},
+			},
+		}), actual)
+	}
+}
+
 func equalNode(t *testing.T, expected Node, actual Node) {
 	e := ToString(expected)
 	a := ToString(actual)
@@ -92,7 +92,30 @@ func (v *TosVisitor) VisitModifier(n *Modifier) (interface{}, error) {
 }
 
 func (v *TosVisitor) VisitAnnotation(n *Annotation) (interface{}, error) {
-	return n.Name, nil
+	if len(n.Parameters) == 0 {
+		return n.Name, nil
+	}
+	parameters := make([]string, len(n.Parameters))
+	for i, p := range n.Parameters {
+		if pair, ok := p.(*BinaryOperator); ok && pair.Op == "=" {
+			l, err := pair.Left.Accept(v)
+			if err != nil {
+				return nil, err
+			}
+			r, err := pair.Right.Accept(v)
+			if err != nil {
+				return nil, err
+			}
+			parameters[i] = fmt.Sprintf("%s=%s", l.(string), r.(string))
+			continue
+		}
+		r, err := p.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		parameters[i] = r.(string)
+	}
+	return fmt.Sprintf("%s(%s)", n.Name, strings.Join(parameters, " ")), nil
 }
 
 func (v *TosVisitor) VisitInterfaceDeclaration(n *InterfaceDeclaration) (interface{}, error) {
@@ -129,7 +152,34 @@ func (v *TosVisitor) VisitInterfaceDeclaration(n *InterfaceDeclaration) (interfa
 	), nil
 }
 
+func (v *TosVisitor) VisitEnumDeclaration(n *EnumDeclaration) (interface{}, error) {
+	modifiers := make([]string, len(n.Modifiers))
+	for i, m := range n.Modifiers {
+		r, err := m.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		modifiers[i] = r.(string)
+	}
+	constants := ""
+	v.AddIndent(func() {
+		constants = v.withIndent(strings.Join(n.Constants, ", "))
+	})
+	return fmt.Sprintf(
+		`%s enum %s {
+%s
+%s`,
+		strings.Join(modifiers, " "),
+		n.Name,
+		constants,
+		v.withIndent("}"),
+	), nil
+}
+
 func (v *TosVisitor) VisitIntegerLiteral(n *IntegerLiteral) (interface{}, error) {
+	if n.IsLong {
+		return fmt.Sprintf("%dL", n.Value), nil
+	}
 	return fmt.Sprintf("%d", n.Value), nil
 }
 
@@ -237,9 +287,13 @@ func (v *TosVisitor) VisitTry(n *Try) (interface{}, error) {
 		}
 		catches[i] = r.(string)
 	}
-	f, err := n.FinallyBlock.Accept(v)
-	if err != nil {
-		return nil, err
+	finally := ""
+	if n.FinallyBlock != nil {
+		f, err := n.FinallyBlock.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		finally = f.(string)
 	}
 	return fmt.Sprintf(
 		`try {
@@ -247,7 +301,7 @@ func (v *TosVisitor) VisitTry(n *Try) (interface{}, error) {
 %s`,
 		stmt,
 		strings.Join(catches, "\n"),
-		f.(string),
+		finally,
 		v.withIndent("}"),
 	), nil
 }
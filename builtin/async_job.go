@@ -0,0 +1,250 @@
+package builtin
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+const (
+	AsyncJobTypeFuture    = "Future"
+	AsyncJobTypeQueueable = "Queueable"
+	AsyncJobTypeBatchApex = "BatchApex"
+)
+
+// AsyncJob is work enqueued by @future method call, System.enqueueJob or Database.executeBatch.
+// Receiver is *ast.Object for instance method or *ast.ClassType for static method.
+type AsyncJob struct {
+	Id          string
+	JobType     string
+	ParentJobId string
+	Receiver    interface{}
+	MethodName  string
+	Parameters  []*ast.Object
+	Node        ast.Node
+
+	// for BatchApex
+	ScopeSize         int
+	InitialState      *ast.Object
+	TotalJobItems     int
+	JobItemsProcessed int
+	NumberOfErrors    int
+}
+
+func (j *AsyncJob) ClassName() string {
+	switch r := j.Receiver.(type) {
+	case *ast.Object:
+		return r.ClassType.Name
+	case *ast.ClassType:
+		return r.Name
+	}
+	return ""
+}
+
+// AsyncJobQueue records enqueued jobs and their AsyncApexJob records.
+// Jobs are run by the interpreter after the transaction or at Test.stopTest.
+type AsyncJobQueue struct {
+	jobs    []*AsyncJob
+	Current *AsyncJob
+	clock   *Clock
+}
+
+// NewAsyncJobQueue creates the queue which stamps AsyncApexJob records with the clock
+func NewAsyncJobQueue(clock *Clock) *AsyncJobQueue {
+	return &AsyncJobQueue{jobs: []*AsyncJob{}, clock: clock}
+}
+
+// asyncJobSequence is shared by interpreters running concurrently in the servers, it is incremented atomically
+var asyncJobSequence int64
+
+// NextId returns new AsyncApexJob id, also used for child job id of batch
+func (q *AsyncJobQueue) NextId() string {
+	return fmt.Sprintf("707%012d", atomic.AddInt64(&asyncJobSequence, 1))
+}
+
+func (q *AsyncJobQueue) Enqueue(job *AsyncJob) string {
+	job.Id = q.NextId()
+	if q.Current != nil {
+		job.ParentJobId = q.Current.Id
+	}
+	q.jobs = append(q.jobs, job)
+
+	var methodName, parentJobId interface{}
+	if job.JobType == AsyncJobTypeFuture {
+		methodName = job.MethodName
+	}
+	if job.ParentJobId != "" {
+		parentJobId = job.ParentJobId
+	}
+	asyncApexJob.Insert(map[string]interface{}{
+		"Id":                job.Id,
+		"JobType":           job.JobType,
+		"ApexClassName":     job.ClassName(),
+		"MethodName":        methodName,
+		"Status":            "Queued",
+		"NumberOfErrors":    0,
+		"TotalJobItems":     0,
+		"JobItemsProcessed": 0,
+		"ParentJobId":       parentJobId,
+		"CreatedDate":       q.clock.Now().Format(datetimeLayout),
+	})
+	return job.Id
+}
+
+// Dequeue returns the first job in the queue, or nil if the queue is empty
+func (q *AsyncJobQueue) Dequeue() *AsyncJob {
+	if len(q.jobs) == 0 {
+		return nil
+	}
+	job := q.jobs[0]
+	q.jobs = q.jobs[1:]
+	return job
+}
+
+func (q *AsyncJobQueue) Size() int {
+	return len(q.jobs)
+}
+
+// Abort removes the queued job from the queue, returns false if the job is not queued
+func (q *AsyncJobQueue) Abort(id string) bool {
+	for i, job := range q.jobs {
+		if job.Id == id {
+			q.jobs = append(q.jobs[:i], q.jobs[i+1:]...)
+			q.SetStatus(job, "Aborted", "")
+			return true
+		}
+	}
+	return false
+}
+
+// Drop removes the queued jobs enqueued by the job, and the jobs enqueued by them recursively.
+// They are enqueued in the rolled back transaction of the failed job, so their AsyncApexJob records do not exist.
+func (q *AsyncJobQueue) Drop(parentJobId string) {
+	jobs := []*AsyncJob{}
+	dropped := []string{}
+	for _, job := range q.jobs {
+		if job.ParentJobId == parentJobId {
+			dropped = append(dropped, job.Id)
+		} else {
+			jobs = append(jobs, job)
+		}
+	}
+	q.jobs = jobs
+	for _, id := range dropped {
+		q.Drop(id)
+	}
+}
+
+// SetStatus updates Status and counters of AsyncApexJob record, message is set to ExtendedStatus on failure
+func (q *AsyncJobQueue) SetStatus(job *AsyncJob, status string, message string) {
+	var completedDate interface{}
+	if status == "Completed" || status == "Failed" || status == "Aborted" {
+		completedDate = q.clock.Now().Format(datetimeLayout)
+	}
+	asyncApexJob.Update(job.Id, map[string]interface{}{
+		"Status":            status,
+		"ExtendedStatus":    message,
+		"NumberOfErrors":    job.NumberOfErrors,
+		"TotalJobItems":     job.TotalJobItems,
+		"JobItemsProcessed": job.JobItemsProcessed,
+		"CompletedDate":     completedDate,
+	})
+}
+
+var asyncApexJob *systemSObject
+
+var queueableContextType = ast.CreateClass(
+	"QueueableContext",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var queueableType = ast.CreateClass(
+	"Queueable",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var queueableTypeParameter = &ast.Parameter{
+	Type: queueableType,
+	Name: "_",
+}
+
+func NewQueueableContext(jobId string) *ast.Object {
+	ctx := ast.CreateObject(queueableContextType)
+	ctx.Extra["jobId"] = jobId
+	return ctx
+}
+
+// enqueueJob is native function of System.enqueueJob
+func enqueueJob(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+	if e := extra["limits"].(*Limits).AddQueueableJob(); e != nil {
+		return CreateRaise(e)
+	}
+	queue := extra["async_jobs"].(*AsyncJobQueue)
+	job := &AsyncJob{
+		JobType:    AsyncJobTypeQueueable,
+		Receiver:   params[0],
+		MethodName: "execute",
+	}
+	if node, ok := extra["node"].(ast.Node); ok {
+		job.Node = node
+	}
+	id := queue.Enqueue(job)
+	job.Parameters = []*ast.Object{NewQueueableContext(id)}
+	return NewString(id)
+}
+
+func init() {
+	queueableContextType.Interface = true
+	queueableContextType.InstanceMethods.Set(
+		"getJobId",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getJobId",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["jobId"].(string))
+				},
+			),
+		},
+	)
+
+	queueableType.Interface = true
+	queueableType.InstanceMethods.Set(
+		"execute",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"execute",
+				nil,
+				[]*ast.Parameter{
+					{Type: queueableContextType, Name: "_"},
+				},
+				nil,
+			),
+		},
+	)
+
+	// ApexClassName holds the class name instead of ApexClassId, since ApexClass records do not exist
+	asyncApexJob = newSystemSObject("AsyncApexJob", []systemSObjectField{
+		{Name: "Id", Type: StringType},
+		{Name: "JobType", Type: StringType},
+		{Name: "ApexClassName", Type: StringType},
+		{Name: "MethodName", Type: StringType},
+		{Name: "Status", Type: StringType},
+		{Name: "ExtendedStatus", Type: StringType},
+		{Name: "NumberOfErrors", Type: IntegerType},
+		{Name: "TotalJobItems", Type: IntegerType},
+		{Name: "JobItemsProcessed", Type: IntegerType},
+		{Name: "ParentJobId", Type: StringType},
+		{Name: "CreatedDate", Type: DatetimeType},
+		{Name: "CompletedDate", Type: DatetimeType},
+	})
+
+	systemNameSpace.Set("Queueable", queueableType)
+	systemNameSpace.Set("QueueableContext", queueableContextType)
+}
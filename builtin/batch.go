@@ -0,0 +1,216 @@
+package builtin
+
+import (
+	"github.com/tzmfreedom/land/ast"
+)
+
+const (
+	defaultBatchScopeSize = 200
+	maxBatchScopeSize     = 2000
+)
+
+var batchableContextType = ast.CreateClass(
+	"BatchableContext",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var batchableContextTypeParameter = &ast.Parameter{
+	Type: batchableContextType,
+	Name: "_",
+}
+
+// statefulType is marker interface, instance fields of stateful batch are kept between transactions
+var statefulType = ast.CreateClass(
+	"Stateful",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+func NewBatchableContext(jobId, childJobId string) *ast.Object {
+	ctx := ast.CreateObject(batchableContextType)
+	ctx.Extra["jobId"] = jobId
+	ctx.Extra["childJobId"] = childJobId
+	return ctx
+}
+
+// IsStateful returns true if the class implements Database.Stateful
+func IsStateful(classType *ast.ClassType) bool {
+	for c := classType; c != nil; c = c.SuperClass {
+		for _, impl := range c.ImplementClasses {
+			if impl == statefulType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// BatchScopeType returns type parameter of Database.Batchable implemented by the class
+func BatchScopeType(classType *ast.ClassType) *ast.ClassType {
+	for c := classType; c != nil; c = c.SuperClass {
+		for _, impl := range c.ImplementClasses {
+			if impl.Name == "Batchable" && len(impl.Generics) == 1 {
+				return impl.Generics[0]
+			}
+		}
+	}
+	return SObjectType
+}
+
+// QueryLocatorRecords returns records of the object if it is Database.QueryLocator
+func QueryLocatorRecords(o *ast.Object) ([]*ast.Object, bool) {
+	if o.ClassType != queryLocatorType {
+		return nil, false
+	}
+	return o.Extra["records"].([]*ast.Object), true
+}
+
+func newQueryLocator(query string, records []*ast.Object) *ast.Object {
+	locator := ast.CreateObject(queryLocatorType)
+	locator.Extra["query"] = query
+	locator.Extra["records"] = records
+	return locator
+}
+
+// CopyObject returns deep copy of the object.
+// It is used to restore instance fields of non-stateful batch for each transaction.
+func CopyObject(o *ast.Object) *ast.Object {
+	return copyObject(o, map[*ast.Object]*ast.Object{})
+}
+
+func copyObject(o *ast.Object, copies map[*ast.Object]*ast.Object) *ast.Object {
+	if o == nil || o == Null {
+		return o
+	}
+	if c, ok := copies[o]; ok {
+		return c
+	}
+	c := &ast.Object{
+		ClassType:      o.ClassType,
+		InstanceFields: ast.NewObjectMap(),
+		Final:          o.Final,
+		Extra:          map[string]interface{}{},
+	}
+	copies[o] = c
+	for name, field := range o.InstanceFields.All() {
+		c.InstanceFields.Data[name] = copyObject(field, copies)
+	}
+	for key, value := range o.Extra {
+		switch typed := value.(type) {
+		case []*ast.Object:
+			records := make([]*ast.Object, len(typed))
+			for i, record := range typed {
+				records[i] = copyObject(record, copies)
+			}
+			c.Extra[key] = records
+		case map[string]*ast.Object:
+			values := map[string]*ast.Object{}
+			for k, v := range typed {
+				values[k] = copyObject(v, copies)
+			}
+			c.Extra[key] = values
+		case *OrderedMap:
+			values := NewOrderedMap()
+			for _, k := range typed.Keys() {
+				v, _ := typed.Get(k)
+				values.Put(copyObject(k, copies), copyObject(v, copies))
+			}
+			c.Extra[key] = values
+		default:
+			c.Extra[key] = value
+		}
+	}
+	return c
+}
+
+// executeBatch is native function of Database.executeBatch
+func executeBatch(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+	batch := params[0]
+	scopeSize := defaultBatchScopeSize
+	if len(params) > 1 {
+		scopeSize = params[1].IntegerValue()
+	}
+	if scopeSize < 1 {
+		return Raise(AsyncExceptionType, "The batch scope size must be greater than 0")
+	}
+	if scopeSize > maxBatchScopeSize {
+		scopeSize = maxBatchScopeSize
+	}
+	queue := extra["async_jobs"].(*AsyncJobQueue)
+	job := &AsyncJob{
+		JobType:   AsyncJobTypeBatchApex,
+		Receiver:  batch,
+		ScopeSize: scopeSize,
+	}
+	if !IsStateful(batch.ClassType) {
+		job.InitialState = CopyObject(batch)
+	}
+	if node, ok := extra["node"].(ast.Node); ok {
+		job.Node = node
+	}
+	return NewString(queue.Enqueue(job))
+}
+
+// getQueryLocator is native function of Database.getQueryLocator with query string,
+// the query is executed when called, that is in start method of the batch
+func getQueryLocator(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+	query := params[0].StringValue()
+	soql, err := ast.ParseSoql(query)
+	if err != nil {
+		return Raise(QueryExceptionType, err.Error())
+	}
+	if e := extra["limits"].(*Limits).AddQuery(); e != nil {
+		return CreateRaise(e)
+	}
+	records := DatabaseDriver.Query(soql, extra["interpreter"].(ast.Visitor))
+	return newQueryLocator(query, records)
+}
+
+func init() {
+	batchableContextType.Interface = true
+	batchableContextType.InstanceMethods.Set(
+		"getJobId",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getJobId",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["jobId"].(string))
+				},
+			),
+		},
+	)
+	batchableContextType.InstanceMethods.Set(
+		"getChildJobId",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getChildJobId",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["childJobId"].(string))
+				},
+			),
+		},
+	)
+
+	queryLocatorType.InstanceMethods.Set(
+		"getQuery",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getQuery",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["query"].(string))
+				},
+			),
+		},
+	)
+
+	statefulType.Interface = true
+}
@@ -1,6 +1,13 @@
 package builtin
 
-import "github.com/tzmfreedom/land/ast"
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tzmfreedom/land/ast"
+)
 
 var BlobType = &ast.ClassType{Name: "Blob"}
 var BlobTypeParameter = &ast.Parameter{
@@ -14,6 +21,41 @@ func NewBlob(value []byte) *ast.Object {
 	return t
 }
 
+// renderPdf renders the text on a page of PDF document.
+// It is a stub of the Visualforce rendering, HTML tags are not interpreted and each line is written as plain text.
+func renderPdf(text string) []byte {
+	latin1, _ := lookupCharset("ISO-8859-1")
+	escaper := strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`)
+	var content bytes.Buffer
+	content.WriteString("BT\n/F1 12 Tf\n14 TL\n50 800 Td\n")
+	for _, line := range strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n") {
+		content.WriteString("(" + escaper.Replace(string(latin1.encode(line))) + ") '\n")
+	}
+	content.WriteString("ET")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] /Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	}
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
 func init() {
 	instanceMethods := ast.NewMethodMap()
 	staticMethods := ast.NewMethodMap()
@@ -30,9 +72,27 @@ func init() {
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 					bytes := this.Extra["value"].([]byte)
+					if !utf8.Valid(bytes) {
+						return Raise(StringExceptionType, "BLOB is not a valid UTF-8 string")
+					}
 					return NewString(string(bytes))
 				},
 			),
+			ast.CreateMethod(
+				"toString",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
+					c, err := lookupCharset(params[0].StringValue())
+					if err != nil {
+						return Raise(InvalidParameterValueExceptionType, err.Error())
+					}
+					return NewString(c.decode(this.Extra["value"].([]byte)))
+				},
+			),
 		},
 	)
 	instanceMethods.Set(
@@ -59,12 +119,51 @@ func init() {
 					stringTypeParameter,
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
 					obj := ast.CreateObject(BlobType)
 					value := params[0].StringValue()
 					obj.Extra["value"] = []byte(value)
 					return obj
 				},
 			),
+			ast.CreateMethod(
+				"valueOf",
+				BlobType,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null || params[1] == Null {
+						return NewNullPointerException("String")
+					}
+					c, err := lookupCharset(params[1].StringValue())
+					if err != nil {
+						return Raise(InvalidParameterValueExceptionType, err.Error())
+					}
+					return NewBlob(c.encode(params[0].StringValue()))
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"toPdf",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"toPdf",
+				BlobType,
+				[]*ast.Parameter{
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
+					return NewBlob(renderPdf(params[0].StringValue()))
+				},
+			),
 		},
 	)
 
@@ -0,0 +1,263 @@
+package builtin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Default and maximum timeout of callouts in milliseconds
+const (
+	defaultCalloutTimeout = 10000
+	maxCalloutTimeout     = 120000
+)
+
+// CalloutConfig stands in for named credentials and remote site settings of the org.
+//
+//	named_credentials:
+//	  MyApi:
+//	    endpoint: https://api.example.com/v1
+//	    username: user
+//	    password: ${API_PASSWORD}
+//	    headers:
+//	      X-Api-Key: ${API_KEY}
+//	remote_sites:
+//	  - https://www.example.com
+//
+// Values are expanded with environment variables, so secrets need not be written in the file.
+type CalloutConfig struct {
+	NamedCredentials map[string]*NamedCredential `yaml:"named_credentials"`
+	RemoteSites      []string                    `yaml:"remote_sites"`
+}
+
+// NamedCredential is the endpoint and the authentication of callout:Name endpoints.
+// Basic authentication is used if username is set, and bearer token if token is set.
+type NamedCredential struct {
+	Endpoint string            `yaml:"endpoint"`
+	Username string            `yaml:"username"`
+	Password string            `yaml:"password"`
+	Token    string            `yaml:"token"`
+	Headers  map[string]string `yaml:"headers"`
+}
+
+// Callouts is the loaded callout config, callouts are not restricted if it is nil
+var Callouts *CalloutConfig
+
+// LoadCalloutConfig loads named credentials and remote sites from the YAML file
+func LoadCalloutConfig(path string) error {
+	body, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	config := &CalloutConfig{}
+	if err := yaml.Unmarshal([]byte(os.ExpandEnv(string(body))), config); err != nil {
+		return fmt.Errorf("invalid callout config %s: %s", path, err)
+	}
+	for _, site := range config.RemoteSites {
+		if u, err := url.Parse(site); err != nil || u.Host == "" {
+			return fmt.Errorf("invalid remote site in %s: %s", path, site)
+		}
+	}
+	Callouts = config
+	return nil
+}
+
+// calloutRequest is the request of Http.send
+type calloutRequest struct {
+	Method                string
+	Endpoint              string
+	Header                http.Header
+	Body                  []byte
+	Timeout               int
+	Compressed            bool
+	ClientCertificateName string
+}
+
+// calloutResponse is the response of Http.send, Status is the reason phrase such as "OK"
+type calloutResponse struct {
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Body       []byte
+}
+
+// CalloutTransport sends the resolved request, it is replaced to serve responses without network
+var CalloutTransport func(req *calloutRequest) (*calloutResponse, error) = sendHttp
+
+// callout resolves named credential of the request, checks the remote sites and sends it.
+// The request is replayed from or recorded to the cassette if it is loaded.
+func callout(req *calloutRequest) (*calloutResponse, error) {
+	if req.Endpoint == "" {
+		return nil, errors.New("Endpoint URL is not set")
+	}
+	if req.Method == "" {
+		return nil, errors.New("Method is not set")
+	}
+	namedCredential := strings.HasPrefix(req.Endpoint, "callout:")
+	if !namedCredential && !isRemoteSite(req.Endpoint) {
+		return nil, fmt.Errorf("Unauthorized endpoint, please check Setup->Security->Remote site settings. endpoint = %s", req.Endpoint)
+	}
+	recorded := cassetteRequest{Method: req.Method, Endpoint: req.Endpoint, Body: string(req.Body)}
+	if CalloutCassette != nil && CalloutCassette.replaying() {
+		return CalloutCassette.replay(recorded)
+	}
+	if namedCredential {
+		if err := resolveNamedCredential(req); err != nil {
+			return nil, err
+		}
+	}
+	if req.Compressed && len(req.Body) > 0 {
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		writer.Write(req.Body)
+		writer.Close()
+		req.Body = buf.Bytes()
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	res, err := CalloutTransport(req)
+	if err != nil {
+		return nil, err
+	}
+	if CalloutCassette != nil {
+		if err := CalloutCassette.record(recorded, res); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// resolveNamedCredential rewrites callout:Name/path to the endpoint of the credential, and injects authentication.
+// Merge fields {!$Credential.UserName} and {!$Credential.Password} in headers and body are replaced.
+func resolveNamedCredential(req *calloutRequest) error {
+	rest := strings.TrimPrefix(req.Endpoint, "callout:")
+	name := rest
+	if i := strings.IndexAny(rest, "/?"); i >= 0 {
+		name, rest = rest[:i], rest[i:]
+	} else {
+		rest = ""
+	}
+	var credential *NamedCredential
+	if Callouts != nil {
+		credential = Callouts.NamedCredentials[name]
+	}
+	if credential == nil {
+		return fmt.Errorf("The callout couldn't access the endpoint. The named credential %s might not exist.", name)
+	}
+	req.Endpoint = strings.TrimSuffix(credential.Endpoint, "/") + rest
+
+	replacer := strings.NewReplacer(
+		"{!$Credential.UserName}", credential.Username,
+		"{!$Credential.Password}", credential.Password,
+	)
+	for key, values := range req.Header {
+		for i, value := range values {
+			values[i] = replacer.Replace(value)
+		}
+		req.Header[key] = values
+	}
+	req.Body = []byte(replacer.Replace(string(req.Body)))
+	if credential.Username != "" {
+		auth := base64.StdEncoding.EncodeToString([]byte(credential.Username + ":" + credential.Password))
+		req.Header.Set("Authorization", "Basic "+auth)
+	} else if credential.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+credential.Token)
+	}
+	for key, value := range credential.Headers {
+		req.Header.Set(key, value)
+	}
+	return nil
+}
+
+// isRemoteSite returns true if the scheme, host and port of the endpoint are registered as a remote site
+func isRemoteSite(endpoint string) bool {
+	if Callouts == nil {
+		return true
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+	for _, site := range Callouts.RemoteSites {
+		s, _ := url.Parse(site)
+		if strings.EqualFold(s.Scheme, u.Scheme) && strings.EqualFold(hostWithPort(s), hostWithPort(u)) {
+			return true
+		}
+	}
+	return false
+}
+
+func hostWithPort(u *url.URL) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	if u.Scheme == "http" {
+		return u.Host + ":80"
+	}
+	return u.Host + ":443"
+}
+
+// sendHttp sends the request over network
+func sendHttp(req *calloutRequest) (*calloutResponse, error) {
+	httpRequest, err := http.NewRequest(req.Method, req.Endpoint, bytes.NewReader(req.Body))
+	if err != nil {
+		return nil, err
+	}
+	httpRequest.Header = req.Header
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if req.ClientCertificateName != "" {
+		certificate, err := clientCertificate(req.ClientCertificateName)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{Certificates: []tls.Certificate{certificate}}
+	}
+	client := &http.Client{
+		Transport: transport,
+		Timeout:   time.Duration(req.Timeout) * time.Millisecond,
+	}
+	res, err := client.Do(httpRequest)
+	if err != nil {
+		if e, ok := err.(*url.Error); ok && e.Timeout() {
+			return nil, errors.New("Read timed out")
+		}
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &calloutResponse{
+		StatusCode: res.StatusCode,
+		Status:     strings.TrimSpace(strings.TrimPrefix(res.Status, fmt.Sprint(res.StatusCode))),
+		Header:     res.Header,
+		Body:       body,
+	}, nil
+}
+
+// clientCertificate loads the certificate and the private key from the key store for mutual TLS
+func clientCertificate(name string) (tls.Certificate, error) {
+	if !certificateNamePattern.MatchString(name) {
+		return tls.Certificate{}, fmt.Errorf("Invalid certificate name: %s", name)
+	}
+	certificate, err := tls.LoadX509KeyPair(
+		filepath.Join(KeyStoreDirectory, name+".crt"),
+		filepath.Join(KeyStoreDirectory, name+".key"),
+	)
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("Certificate not found: %s", name)
+	}
+	return certificate, nil
+}
@@ -0,0 +1,218 @@
+package builtin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// withCallouts replaces the callout config and the transport during the test, the transport returns sent requests
+func withCallouts(t *testing.T, config *CalloutConfig) *[]*calloutRequest {
+	prevCallouts, prevTransport := Callouts, CalloutTransport
+	t.Cleanup(func() {
+		Callouts, CalloutTransport = prevCallouts, prevTransport
+	})
+	Callouts = config
+	sent := []*calloutRequest{}
+	CalloutTransport = func(req *calloutRequest) (*calloutResponse, error) {
+		sent = append(sent, req)
+		return &calloutResponse{StatusCode: 200, Status: "OK", Header: http.Header{}, Body: []byte("ok")}, nil
+	}
+	return &sent
+}
+
+func TestLoadCalloutConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "callout")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+	withCallouts(t, nil)
+	os.Setenv("LAND_TEST_PASSWORD", "s3cret")
+	defer os.Unsetenv("LAND_TEST_PASSWORD")
+
+	path := filepath.Join(dir, "callouts.yml")
+	ioutil.WriteFile(path, []byte(`
+named_credentials:
+  MyApi:
+    endpoint: https://api.example.com/v1
+    username: user
+    password: ${LAND_TEST_PASSWORD}
+remote_sites:
+  - https://www.example.com
+`), 0644)
+	if err := LoadCalloutConfig(path); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if password := Callouts.NamedCredentials["MyApi"].Password; password != "s3cret" {
+		t.Errorf("expected s3cret, actual %s", password)
+	}
+
+	ioutil.WriteFile(path, []byte("remote_sites:\n  - www.example.com\n"), 0644)
+	if err := LoadCalloutConfig(path); err == nil || err.Error() != "invalid remote site in "+path+": www.example.com" {
+		t.Errorf("expected invalid remote site error, actual %v", err)
+	}
+}
+
+func TestIsRemoteSite(t *testing.T) {
+	withCallouts(t, &CalloutConfig{RemoteSites: []string{"https://www.example.com", "http://localhost:8080"}})
+	testCases := []struct {
+		Endpoint string
+		Expected bool
+	}{
+		{"https://www.example.com/path?q=1", true},
+		{"https://WWW.EXAMPLE.COM", true},
+		{"https://www.example.com:443/path", true},
+		{"https://www.example.com:8443/path", false},
+		{"http://www.example.com/path", false},
+		{"https://api.example.com", false},
+		{"http://localhost:8080/path", true},
+		{"http://localhost/path", false},
+		{"://invalid", false},
+	}
+	for _, testCase := range testCases {
+		if actual := isRemoteSite(testCase.Endpoint); actual != testCase.Expected {
+			t.Errorf("%s: expected %t, actual %t", testCase.Endpoint, testCase.Expected, actual)
+		}
+	}
+
+	// callouts are not restricted without config
+	Callouts = nil
+	if !isRemoteSite("https://api.example.com") {
+		t.Errorf("expected any endpoint to be allowed without config")
+	}
+}
+
+func TestCalloutNamedCredential(t *testing.T) {
+	sent := withCallouts(t, &CalloutConfig{
+		NamedCredentials: map[string]*NamedCredential{
+			"Basic": {Endpoint: "https://api.example.com/v1/", Username: "user", Password: "pass"},
+			"Token": {Endpoint: "https://token.example.com", Token: "t0ken", Headers: map[string]string{"X-Api-Key": "key"}},
+		},
+	})
+	testCases := []struct {
+		Endpoint      string
+		Expected      string
+		Authorization string
+	}{
+		{"callout:Basic/accounts?limit=1", "https://api.example.com/v1/accounts?limit=1", "Basic dXNlcjpwYXNz"},
+		{"callout:Basic?limit=1", "https://api.example.com/v1?limit=1", "Basic dXNlcjpwYXNz"},
+		{"callout:Token", "https://token.example.com", "Bearer t0ken"},
+	}
+	for _, testCase := range testCases {
+		header := http.Header{}
+		header.Set("X-User", "{!$Credential.UserName}")
+		_, err := callout(&calloutRequest{
+			Method:   "POST",
+			Endpoint: testCase.Endpoint,
+			Header:   header,
+			Body:     []byte("password={!$Credential.Password}"),
+		})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", testCase.Endpoint, err.Error())
+			continue
+		}
+		req := (*sent)[len(*sent)-1]
+		if req.Endpoint != testCase.Expected {
+			t.Errorf("%s: expected %s, actual %s", testCase.Endpoint, testCase.Expected, req.Endpoint)
+		}
+		if actual := req.Header.Get("Authorization"); actual != testCase.Authorization {
+			t.Errorf("%s: expected %s, actual %s", testCase.Endpoint, testCase.Authorization, actual)
+		}
+	}
+	basic, token := (*sent)[0], (*sent)[2]
+	if basic.Header.Get("X-User") != "user" || string(basic.Body) != "password=pass" {
+		t.Errorf("expected merge fields to be replaced, actual %s, %s", basic.Header.Get("X-User"), basic.Body)
+	}
+	if token.Header.Get("X-Api-Key") != "key" {
+		t.Errorf("expected X-Api-Key header, actual %v", token.Header)
+	}
+
+	_, err := callout(&calloutRequest{Method: "GET", Endpoint: "callout:Missing/path", Header: http.Header{}})
+	expected := "The callout couldn't access the endpoint. The named credential Missing might not exist."
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected %s, actual %v", expected, err)
+	}
+}
+
+func TestCalloutError(t *testing.T) {
+	sent := withCallouts(t, &CalloutConfig{RemoteSites: []string{"https://www.example.com"}})
+	testCases := []struct {
+		Request  *calloutRequest
+		Expected string
+	}{
+		{&calloutRequest{Method: "GET"}, "Endpoint URL is not set"},
+		{&calloutRequest{Endpoint: "https://www.example.com"}, "Method is not set"},
+		{
+			&calloutRequest{Method: "GET", Endpoint: "https://evil.example.com/x"},
+			"Unauthorized endpoint, please check Setup->Security->Remote site settings. endpoint = https://evil.example.com/x",
+		},
+	}
+	for i, testCase := range testCases {
+		_, err := callout(testCase.Request)
+		if err == nil {
+			t.Errorf("%d: expected error", i)
+			continue
+		}
+		if err.Error() != testCase.Expected {
+			t.Errorf("%d: expected: %s, actual: %s", i, testCase.Expected, err.Error())
+		}
+	}
+	if len(*sent) != 0 {
+		t.Errorf("expected no request to be sent, actual %d", len(*sent))
+	}
+}
+
+func TestCalloutCompressed(t *testing.T) {
+	sent := withCallouts(t, nil)
+	_, err := callout(&calloutRequest{Method: "POST", Endpoint: "https://www.example.com", Header: http.Header{}, Body: []byte("body"), Compressed: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	req := (*sent)[0]
+	if req.Header.Get("Content-Encoding") != "gzip" {
+		t.Errorf("expected gzip Content-Encoding, actual %v", req.Header)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(req.Body))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	body, _ := ioutil.ReadAll(reader)
+	if string(body) != "body" {
+		t.Errorf("expected body, actual %s", body)
+	}
+}
+
+func TestSendHttp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("X-Method", r.Method)
+		w.WriteHeader(http.StatusCreated)
+		w.Write(append([]byte(r.Header.Get("X-Request")+":"), body...))
+	}))
+	defer server.Close()
+
+	header := http.Header{}
+	header.Set("X-Request", "value")
+	res, err := sendHttp(&calloutRequest{Method: "PUT", Endpoint: server.URL + "/path", Header: header, Body: []byte("body"), Timeout: 10000})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if res.StatusCode != 201 || res.Status != "Created" || res.Header.Get("X-Method") != "PUT" || string(res.Body) != "value:body" {
+		t.Errorf("unexpected response: %d %s %v %s", res.StatusCode, res.Status, res.Header, res.Body)
+	}
+
+	_, err = sendHttp(&calloutRequest{Method: "GET", Endpoint: server.URL + "/slow", Header: http.Header{}, Timeout: 50})
+	if err == nil || err.Error() != "Read timed out" {
+		t.Errorf("expected Read timed out, actual %v", err)
+	}
+}
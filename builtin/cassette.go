@@ -0,0 +1,122 @@
+package builtin
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Modes of the cassette
+const (
+	CassetteRecord = "record"
+	CassetteReplay = "replay"
+)
+
+// Cassette records callouts to the file, and replays them without network.
+// Requests are recorded before named credentials are resolved, so credentials are never written to the file
+// and replaying callout:Name endpoints does not need the credentials.
+type Cassette struct {
+	path         string
+	mode         string
+	Interactions []*cassetteInteraction `yaml:"interactions"`
+	used         []bool
+}
+
+type cassetteInteraction struct {
+	Request  cassetteRequest  `yaml:"request"`
+	Response cassetteResponse `yaml:"response"`
+}
+
+type cassetteRequest struct {
+	Method   string `yaml:"method"`
+	Endpoint string `yaml:"endpoint"`
+	Body     string `yaml:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int                 `yaml:"status_code"`
+	Status     string              `yaml:"status"`
+	Headers    map[string][]string `yaml:"headers,omitempty"`
+	Body       string              `yaml:"body,omitempty"`
+}
+
+// CalloutCassette is the cassette of callouts, callouts are sent over network if it is nil
+var CalloutCassette *Cassette
+
+// LoadCassette opens the cassette file, the file is truncated in record mode
+func LoadCassette(path string, mode string) (*Cassette, error) {
+	cassette := &Cassette{path: path, mode: mode, Interactions: []*cassetteInteraction{}}
+	switch mode {
+	case CassetteRecord:
+		return cassette, cassette.save()
+	case CassetteReplay:
+		body, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(body, cassette); err != nil {
+			return nil, fmt.Errorf("invalid cassette %s: %s", path, err)
+		}
+		cassette.used = make([]bool, len(cassette.Interactions))
+		return cassette, nil
+	}
+	return nil, fmt.Errorf("invalid cassette mode: %s", mode)
+}
+
+func (c *Cassette) replaying() bool {
+	return c.mode == CassetteReplay
+}
+
+// record appends the interaction and writes the cassette
+func (c *Cassette) record(req cassetteRequest, res *calloutResponse) error {
+	c.Interactions = append(c.Interactions, &cassetteInteraction{
+		Request: req,
+		Response: cassetteResponse{
+			StatusCode: res.StatusCode,
+			Status:     res.Status,
+			Headers:    res.Header,
+			Body:       string(res.Body),
+		},
+	})
+	return c.save()
+}
+
+func (c *Cassette) save() error {
+	body, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.path, body, 0644)
+}
+
+// replay returns the recorded response of the request which has the same method, endpoint and body.
+// Interactions are replayed in the recorded order, and the last one is repeated after all of them are used.
+func (c *Cassette) replay(req cassetteRequest) (*calloutResponse, error) {
+	found := -1
+	for i, interaction := range c.Interactions {
+		if interaction.Request != req {
+			continue
+		}
+		found = i
+		if !c.used[i] {
+			break
+		}
+	}
+	if found < 0 {
+		return nil, fmt.Errorf("No recorded callout matches %s %s in cassette %s", req.Method, req.Endpoint, c.path)
+	}
+	c.used[found] = true
+	res := c.Interactions[found].Response
+	header := http.Header{}
+	for key, values := range res.Headers {
+		header[http.CanonicalHeaderKey(key)] = append([]string{}, values...)
+	}
+	return &calloutResponse{
+		StatusCode: res.StatusCode,
+		Status:     res.Status,
+		Header:     header,
+		Body:       []byte(res.Body),
+	}, nil
+}
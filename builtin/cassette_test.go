@@ -0,0 +1,130 @@
+package builtin
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.yml")
+	prevCassette := CalloutCassette
+	defer func() {
+		CalloutCassette = prevCassette
+	}()
+
+	withCallouts(t, &CalloutConfig{
+		NamedCredentials: map[string]*NamedCredential{
+			"MyApi": {Endpoint: "https://api.example.com", Username: "user", Password: "s3cret"},
+		},
+	})
+	count := 0
+	CalloutTransport = func(req *calloutRequest) (*calloutResponse, error) {
+		count++
+		header := http.Header{}
+		header.Set("Content-Type", "text/plain")
+		return &calloutResponse{StatusCode: 200, Status: "OK", Header: header, Body: []byte(strings.Repeat("x", count))}, nil
+	}
+	CalloutCassette, err = LoadCassette(path, CassetteRecord)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	requests := []*calloutRequest{
+		{Method: "GET", Endpoint: "callout:MyApi/items"},
+		{Method: "GET", Endpoint: "callout:MyApi/items"},
+		{Method: "POST", Endpoint: "callout:MyApi/items", Body: []byte("a")},
+	}
+	for _, req := range requests {
+		req.Header = http.Header{}
+		if _, err := callout(req); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	body, _ := ioutil.ReadFile(path)
+	if strings.Contains(string(body), "s3cret") || strings.Contains(string(body), "api.example.com") {
+		t.Errorf("expected credentials not to be recorded, actual\n%s", body)
+	}
+
+	// credentials and network are not used in replay mode
+	withCallouts(t, nil)
+	CalloutTransport = func(req *calloutRequest) (*calloutResponse, error) {
+		t.Errorf("unexpected request: %s %s", req.Method, req.Endpoint)
+		return nil, nil
+	}
+	CalloutCassette, err = LoadCassette(path, CassetteReplay)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	testCases := []struct {
+		Method   string
+		Endpoint string
+		Body     string
+		Expected string
+	}{
+		// POST is matched by the body regardless of the order
+		{"POST", "callout:MyApi/items", "a", "xxx"},
+		// same requests are replayed in the recorded order, and the last one is repeated
+		{"GET", "callout:MyApi/items", "", "x"},
+		{"GET", "callout:MyApi/items", "", "xx"},
+		{"GET", "callout:MyApi/items", "", "xx"},
+	}
+	for i, testCase := range testCases {
+		res, err := callout(&calloutRequest{Method: testCase.Method, Endpoint: testCase.Endpoint, Header: http.Header{}, Body: []byte(testCase.Body)})
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err.Error())
+			continue
+		}
+		if string(res.Body) != testCase.Expected || res.StatusCode != 200 || res.Header.Get("Content-Type") != "text/plain" {
+			t.Errorf("%d: expected %s, actual %d %v %s", i, testCase.Expected, res.StatusCode, res.Header, res.Body)
+		}
+	}
+
+	mismatches := []*calloutRequest{
+		{Method: "POST", Endpoint: "callout:MyApi/items", Body: []byte("b")},
+		{Method: "DELETE", Endpoint: "callout:MyApi/items"},
+		{Method: "GET", Endpoint: "callout:MyApi/other"},
+	}
+	for _, req := range mismatches {
+		req.Header = http.Header{}
+		_, err := callout(req)
+		expected := "No recorded callout matches " + req.Method + " " + req.Endpoint + " in cassette " + path
+		if err == nil || err.Error() != expected {
+			t.Errorf("expected %s, actual %v", expected, err)
+		}
+	}
+}
+
+func TestLoadCassetteError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.yml")
+
+	if _, err := LoadCassette(path, "rewind"); err == nil || err.Error() != "invalid cassette mode: rewind" {
+		t.Errorf("expected invalid mode error, actual %v", err)
+	}
+	if _, err := LoadCassette(path, CassetteReplay); !os.IsNotExist(err) {
+		t.Errorf("expected not exist error, actual %v", err)
+	}
+	ioutil.WriteFile(path, []byte("interactions: {"), 0644)
+	if _, err := LoadCassette(path, CassetteReplay); err == nil || !strings.HasPrefix(err.Error(), "invalid cassette "+path) {
+		t.Errorf("expected invalid cassette error, actual %v", err)
+	}
+	// record mode truncates the file
+	if _, err := LoadCassette(path, CassetteRecord); err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if body, _ := ioutil.ReadFile(path); string(body) != "interactions: []\n" {
+		t.Errorf("expected empty cassette, actual %s", body)
+	}
+}
@@ -0,0 +1,122 @@
+package builtin
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// charset encodes and decodes strings as java.nio.charset.Charset does.
+// Characters which cannot be encoded are replaced by '?' and invalid bytes by U+FFFD.
+type charset struct {
+	name   string
+	encode func(s string) []byte
+	decode func(b []byte) string
+}
+
+var utf8Charset = &charset{
+	name:   "UTF-8",
+	encode: func(s string) []byte { return []byte(s) },
+	decode: func(b []byte) string { return strings.ToValidUTF8(string(b), "�") },
+}
+
+var charsets = []*charset{
+	utf8Charset,
+	{name: "ISO-8859-1", encode: singleByteEncoder(0xff), decode: singleByteDecoder(0xff)},
+	{name: "US-ASCII", encode: singleByteEncoder(0x7f), decode: singleByteDecoder(0x7f)},
+	{name: "UTF-16", encode: utf16Encoder(binary.BigEndian, true), decode: utf16Decoder(binary.BigEndian, true)},
+	{name: "UTF-16BE", encode: utf16Encoder(binary.BigEndian, false), decode: utf16Decoder(binary.BigEndian, false)},
+	{name: "UTF-16LE", encode: utf16Encoder(binary.LittleEndian, false), decode: utf16Decoder(binary.LittleEndian, false)},
+}
+
+var charsetAliases = map[string]string{
+	"UTF8":      "UTF-8",
+	"LATIN1":    "ISO-8859-1",
+	"ISO8859_1": "ISO-8859-1",
+	"ASCII":     "US-ASCII",
+	"UTF_16":    "UTF-16",
+	"UTF_16BE":  "UTF-16BE",
+	"UTF_16LE":  "UTF-16LE",
+}
+
+// lookupCharset finds the charset by the name case-insensitively
+func lookupCharset(name string) (*charset, error) {
+	upper := strings.ToUpper(name)
+	if alias, ok := charsetAliases[upper]; ok {
+		upper = alias
+	}
+	for _, c := range charsets {
+		if c.name == upper {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("Unsupported encoding: %s", name)
+}
+
+func singleByteEncoder(max rune) func(string) []byte {
+	return func(s string) []byte {
+		b := make([]byte, 0, len(s))
+		for _, r := range s {
+			if r > max {
+				r = '?'
+			}
+			b = append(b, byte(r))
+		}
+		return b
+	}
+}
+
+func singleByteDecoder(max byte) func([]byte) string {
+	return func(b []byte) string {
+		var buf strings.Builder
+		for _, c := range b {
+			if c > max {
+				buf.WriteRune(utf8.RuneError)
+			} else {
+				buf.WriteRune(rune(c))
+			}
+		}
+		return buf.String()
+	}
+}
+
+// utf16Encoder encodes to UTF-16, UTF-16 charset of Java writes big-endian byte order mark
+func utf16Encoder(order binary.ByteOrder, bom bool) func(string) []byte {
+	return func(s string) []byte {
+		units := utf16.Encode([]rune(s))
+		if bom && len(units) > 0 {
+			units = append([]uint16{0xfeff}, units...)
+		}
+		b := make([]byte, len(units)*2)
+		for i, unit := range units {
+			order.PutUint16(b[i*2:], unit)
+		}
+		return b
+	}
+}
+
+// utf16Decoder decodes UTF-16, UTF-16 charset of Java detects byte order by the byte order mark
+func utf16Decoder(order binary.ByteOrder, bom bool) func([]byte) string {
+	return func(b []byte) string {
+		order := order
+		if bom && len(b) >= 2 {
+			if b[0] == 0xfe && b[1] == 0xff {
+				b = b[2:]
+			} else if b[0] == 0xff && b[1] == 0xfe {
+				order = binary.LittleEndian
+				b = b[2:]
+			}
+		}
+		units := make([]uint16, len(b)/2)
+		for i := range units {
+			units[i] = order.Uint16(b[i*2:])
+		}
+		s := string(utf16.Decode(units))
+		if len(b)%2 != 0 {
+			s += string(utf8.RuneError)
+		}
+		return s
+	}
+}
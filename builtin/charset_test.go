@@ -0,0 +1,86 @@
+package builtin
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestLookupCharset(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Expected string
+		Error    string
+	}{
+		{"UTF-8", "UTF-8", ""},
+		{"utf-8", "UTF-8", ""},
+		{"UTF8", "UTF-8", ""},
+		{"latin1", "ISO-8859-1", ""},
+		{"ISO8859_1", "ISO-8859-1", ""},
+		{"ascii", "US-ASCII", ""},
+		{"utf_16le", "UTF-16LE", ""},
+		{"Shift_JIS", "", "Unsupported encoding: Shift_JIS"},
+		{"", "", "Unsupported encoding: "},
+	}
+	for i, testCase := range testCases {
+		c, err := lookupCharset(testCase.Name)
+		if testCase.Error != "" {
+			if err == nil || err.Error() != testCase.Error {
+				t.Errorf("%d: expected %s, actual %v", i, testCase.Error, err)
+			}
+			continue
+		}
+		if err != nil || c.name != testCase.Expected {
+			t.Errorf("%d: expected %s, actual %v, %v", i, testCase.Expected, c, err)
+		}
+	}
+}
+
+func TestCharsetEncode(t *testing.T) {
+	testCases := []struct {
+		Charset  string
+		Src      string
+		Expected []byte
+	}{
+		{"UTF-8", "aあ", []byte{0x61, 0xe3, 0x81, 0x82}},
+		{"ISO-8859-1", "aéあ", []byte{0x61, 0xe9, '?'}},
+		{"US-ASCII", "aé", []byte{0x61, '?'}},
+		{"UTF-16", "a", []byte{0xfe, 0xff, 0x00, 0x61}},
+		{"UTF-16", "", []byte{}},
+		{"UTF-16BE", "a😀", []byte{0x00, 0x61, 0xd8, 0x3d, 0xde, 0x00}},
+		{"UTF-16LE", "a", []byte{0x61, 0x00}},
+	}
+	for i, testCase := range testCases {
+		c, _ := lookupCharset(testCase.Charset)
+		actual := c.encode(testCase.Src)
+		if !bytes.Equal(actual, testCase.Expected) {
+			t.Errorf("%d: expected %v, actual %v", i, testCase.Expected, actual)
+		}
+	}
+}
+
+func TestCharsetDecode(t *testing.T) {
+	testCases := []struct {
+		Charset  string
+		Src      []byte
+		Expected string
+	}{
+		{"UTF-8", []byte{0x61, 0xe3, 0x81, 0x82}, "aあ"},
+		{"UTF-8", []byte{0x61, 0xff}, "a�"},
+		{"ISO-8859-1", []byte{0x61, 0xe9}, "aé"},
+		{"US-ASCII", []byte{0x61, 0xe9}, "a�"},
+		{"UTF-16", []byte{0xfe, 0xff, 0x00, 0x61}, "a"},
+		{"UTF-16", []byte{0xff, 0xfe, 0x61, 0x00}, "a"},
+		{"UTF-16", []byte{0x00, 0x61}, "a"},
+		{"UTF-16", []byte{0x00, 0x61, 0x00}, "a�"},
+		{"UTF-16BE", []byte{0xfe, 0xff, 0x00, 0x61}, "\ufeffa"},
+		{"UTF-16BE", []byte{0xd8, 0x3d, 0xde, 0x00}, "😀"},
+		{"UTF-16LE", []byte{0x61, 0x00}, "a"},
+	}
+	for i, testCase := range testCases {
+		c, _ := lookupCharset(testCase.Charset)
+		actual := c.decode(testCase.Src)
+		if actual != testCase.Expected {
+			t.Errorf("%d: expected %q, actual %q", i, testCase.Expected, actual)
+		}
+	}
+}
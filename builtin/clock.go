@@ -0,0 +1,66 @@
+package builtin
+
+import "time"
+
+// Clock is time source of land.
+// Date.today, Datetime.now, System.now, DML audit fields and scheduled jobs consult the clock of the interpreter,
+// so that time-dependent code can be run at any time.
+// It returns host time unless the time is set or the source is replaced.
+type Clock struct {
+	current *time.Time
+	source  func() time.Time
+}
+
+// SystemClock is the clock configured by --now or embedders.
+// Each interpreter runs with its own copy, so the time set in one execution does not leak into others.
+var SystemClock = &Clock{}
+
+// Copy returns a clock which has the same time and source
+func (c *Clock) Copy() *Clock {
+	return &Clock{current: c.current, source: c.source}
+}
+
+func (c *Clock) Now() time.Time {
+	if c.current != nil {
+		return *c.current
+	}
+	if c.source != nil {
+		return c.source()
+	}
+	return time.Now()
+}
+
+// Set fixes the current time of the clock
+func (c *Clock) Set(t time.Time) {
+	c.current = &t
+}
+
+// SetSource replaces the time source of the clock, embedders can supply their own clock with it.
+// The time fixed by Set takes precedence over the source.
+func (c *Clock) SetSource(source func() time.Time) {
+	c.source = source
+}
+
+// Advance moves the clock forward by the duration, the clock is fixed at the advanced time
+func (c *Clock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Unset removes the time fixed by Set, the clock returns the time of the source again
+func (c *Clock) Unset() {
+	c.current = nil
+}
+
+// Reset makes the clock return host time again
+func (c *Clock) Reset() {
+	c.current = nil
+	c.source = nil
+}
+
+// clockOf returns the clock of the interpreter, or SystemClock if the native function is called without interpreter
+func clockOf(extra map[string]interface{}) *Clock {
+	if clock, ok := extra["clock"].(*Clock); ok {
+		return clock
+	}
+	return SystemClock
+}
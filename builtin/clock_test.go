@@ -0,0 +1,44 @@
+package builtin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClock(t *testing.T) {
+	base := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	fixed := time.Date(2021, 6, 30, 23, 0, 0, 0, time.UTC)
+	configured := &Clock{}
+	configured.SetSource(func() time.Time { return base })
+
+	clock := configured.Copy()
+	clock.Set(fixed)
+	if !clock.Now().Equal(fixed) {
+		t.Errorf("expected %s, actual %s", fixed, clock.Now())
+	}
+	if !configured.Now().Equal(base) {
+		t.Errorf("expected the copied clock not to change, actual %s", configured.Now())
+	}
+
+	clock.Advance(time.Hour)
+	if expected := fixed.Add(time.Hour); !clock.Now().Equal(expected) {
+		t.Errorf("expected %s, actual %s", expected, clock.Now())
+	}
+
+	// Unset returns to the source, Reset returns to host time
+	clock.Unset()
+	if !clock.Now().Equal(base) {
+		t.Errorf("expected %s, actual %s", base, clock.Now())
+	}
+	clock.Reset()
+	if d := time.Since(clock.Now()); d < 0 || d > time.Minute {
+		t.Errorf("expected host time, actual %s", clock.Now())
+	}
+
+	if clockOf(map[string]interface{}{}) != SystemClock {
+		t.Errorf("expected SystemClock without interpreter")
+	}
+	if clockOf(map[string]interface{}{"clock": clock}) != clock {
+		t.Errorf("expected the clock of the interpreter")
+	}
+}
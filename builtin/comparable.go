@@ -0,0 +1,254 @@
+package builtin
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+var ComparableType = ast.CreateClass(
+	"Comparable",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var ComparatorType = ast.CreateClass(
+	"Comparator",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+func CreateComparatorType(classType *ast.ClassType) *ast.ClassType {
+	return &ast.ClassType{
+		Name:            "Comparator",
+		Modifiers:       ComparatorType.Modifiers,
+		Constructors:    ComparatorType.Constructors,
+		InstanceFields:  ComparatorType.InstanceFields,
+		InstanceMethods: ComparatorType.InstanceMethods,
+		StaticFields:    ComparatorType.StaticFields,
+		StaticMethods:   ComparatorType.StaticMethods,
+		Generics:        []*ast.ClassType{classType},
+		Interface:       true,
+	}
+}
+
+// isComparable returns true if the class or its super classes implement Comparable
+func isComparable(classType *ast.ClassType) bool {
+	for c := classType; c != nil; c = c.SuperClass {
+		for _, impl := range c.ImplementClasses {
+			if impl == ComparableType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sortRecords sorts the records in place with stable order, and returns the first error of compare.
+// The interruption of the interpreter stops sorting.
+func sortRecords(records []*ast.Object, compare func(*ast.Object, *ast.Object) (int, error), extra map[string]interface{}) error {
+	var err error
+	sort.SliceStable(records, func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		if e := checkNative(extra, 0); e != nil {
+			err = e
+			return false
+		}
+		c, e := compare(records[i], records[j])
+		if e != nil {
+			err = e
+			return false
+		}
+		return c < 0
+	})
+	return err
+}
+
+// compareObjects compares elements of List.sort(), null is first.
+// Comparable objects are compared by compareTo, and SObjects are compared in platform order.
+func compareObjects(o, other *ast.Object, extra map[string]interface{}) (int, error) {
+	if o == Null || other == Null {
+		return compareNull(o, other), nil
+	}
+	if isComparable(o.ClassType) {
+		r, err := extra["interpreter"].(MethodCaller).CallMethod(o, "compareTo", []*ast.Object{other})
+		if err != nil {
+			return 0, err
+		}
+		if r == Null {
+			return 0, NewExceptionError(NewException(ListExceptionType, "compareTo must return Integer"))
+		}
+		return r.IntegerValue(), nil
+	}
+	if o.ClassType.SuperClass == SObjectType && other.ClassType.SuperClass == SObjectType {
+		return compareSObjects(o, other), nil
+	}
+	if c, ok := comparePrimitives(o, other); ok {
+		return c, nil
+	}
+	return 0, NewExceptionError(NewException(ListExceptionType, "One or more of the items in this list is not Comparable"))
+}
+
+func compareNull(o, other *ast.Object) int {
+	switch {
+	case o == Null && other == Null:
+		return 0
+	case o == Null:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// comparePrimitives compares values of Integer, Long, Double, Decimal, String, Boolean, Date, Datetime, Time and enum
+func comparePrimitives(o, other *ast.Object) (int, bool) {
+	if IsEnum(o.ClassType) && o.ClassType == other.ClassType {
+		return o.Extra["ordinal"].(int) - other.Extra["ordinal"].(int), true
+	}
+	_, isDecimal := o.Value().(*Decimal)
+	_, isOtherDecimal := other.Value().(*Decimal)
+	if (isDecimal || isOtherDecimal) && IsNumeric(o.ClassType) && IsNumeric(other.ClassType) {
+		return CompareDecimal(o, other), true
+	}
+	switch v := o.Value().(type) {
+	case int:
+		switch w := other.Value().(type) {
+		case int:
+			return compareFloat(float64(v), float64(w)), true
+		case float64:
+			return compareFloat(float64(v), w), true
+		}
+	case float64:
+		switch w := other.Value().(type) {
+		case int:
+			return compareFloat(v, float64(w)), true
+		case float64:
+			return compareFloat(v, w), true
+		}
+	case string:
+		if w, ok := other.Value().(string); ok {
+			return strings.Compare(v, w), true
+		}
+	case bool:
+		if w, ok := other.Value().(bool); ok {
+			switch {
+			case v == w:
+				return 0, true
+			case !v:
+				return -1, true
+			default:
+				return 1, true
+			}
+		}
+	case time.Time:
+		if w, ok := other.Value().(time.Time); ok {
+			switch {
+			case v.Before(w):
+				return -1, true
+			case v.After(w):
+				return 1, true
+			default:
+				return 0, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func compareFloat(v, w float64) int {
+	switch {
+	case v < w:
+		return -1
+	case v > w:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// compareSObjects compares sObjects in the order of the platform:
+// label of sObject type, Name field, standard fields, and then custom fields, each in alphabetical order.
+func compareSObjects(o, other *ast.Object) int {
+	if c := strings.Compare(sObjectLabel(o.ClassType), sObjectLabel(other.ClassType)); c != 0 {
+		return c
+	}
+	for _, name := range sortFieldNames(o.ClassType) {
+		value, _ := o.InstanceFields.Get(name)
+		otherValue, _ := other.InstanceFields.Get(name)
+		if value == nil {
+			value = Null
+		}
+		if otherValue == nil {
+			otherValue = Null
+		}
+		if value == Null || otherValue == Null {
+			if c := compareNull(value, otherValue); c != 0 {
+				return c
+			}
+			continue
+		}
+		if c, ok := comparePrimitives(value, otherValue); ok && c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func sObjectLabel(classType *ast.ClassType) string {
+	if sobj, ok := sObjects[classType.Name]; ok && sobj.Label != "" {
+		return sobj.Label
+	}
+	return classType.Name
+}
+
+// sortFieldNames returns field names of the sObject in sort order, Id field is not used for comparison
+func sortFieldNames(classType *ast.ClassType) []string {
+	var standards, customs []string
+	hasName := false
+	for _, f := range classType.InstanceFields.Data {
+		switch {
+		case strings.EqualFold(f.Name, "Id"):
+		case strings.EqualFold(f.Name, "Name"):
+			hasName = true
+		case strings.HasSuffix(strings.ToLower(f.Name), "__c"):
+			customs = append(customs, f.Name)
+		default:
+			standards = append(standards, f.Name)
+		}
+	}
+	sort.Strings(standards)
+	sort.Strings(customs)
+	names := []string{}
+	if hasName {
+		names = append(names, "Name")
+	}
+	names = append(names, standards...)
+	return append(names, customs...)
+}
+
+func init() {
+	ComparableType.Interface = true
+	ComparableType.InstanceMethods.Set(
+		"compareTo",
+		[]*ast.Method{
+			ast.CreateMethod("compareTo", IntegerType, []*ast.Parameter{objectTypeParameter}, nil),
+		},
+	)
+
+	ComparatorType.Interface = true
+	ComparatorType.InstanceMethods.Set(
+		"compare",
+		[]*ast.Method{
+			ast.CreateMethod("compare", IntegerType, []*ast.Parameter{t1Parameter, t1Parameter}, nil),
+		},
+	)
+
+	systemNameSpace.Set("Comparable", ComparableType)
+	systemNameSpace.Set("Comparator", ComparatorType)
+}
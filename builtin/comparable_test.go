@@ -0,0 +1,187 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// compareCaller calls compareTo and compare of Apex classes by the function of the test case
+type compareCaller struct {
+	compare func(o, other *ast.Object) *ast.Object
+}
+
+func (c *compareCaller) CallMethod(receiver *ast.Object, methodName string, params []*ast.Object) (*ast.Object, error) {
+	if methodName == "compareTo" {
+		return c.compare(receiver, params[0]), nil
+	}
+	return c.compare(params[0], params[1]), nil
+}
+
+// callNativeMethod calls the native method of the collection with the parameters.
+// Overloads are selected by the number of the parameters and the kinds of collection parameters, such as Set.addAll(List).
+func callNativeMethod(receiver *ast.Object, name string, params []*ast.Object, caller interface{}) interface{} {
+	methods, _ := receiver.ClassType.InstanceMethods.Get(name)
+	for _, m := range methods {
+		if len(m.Parameters) != len(params) {
+			continue
+		}
+		matched := true
+		for i, p := range m.Parameters {
+			switch p.Type.Name {
+			case "List", "Set", "Map":
+				matched = matched && p.Type.Name == params[i].ClassType.Name
+			}
+		}
+		if matched {
+			return m.NativeFunction(receiver, params, map[string]interface{}{"interpreter": caller})
+		}
+	}
+	return nil
+}
+
+func sortedValues(records []*ast.Object) []interface{} {
+	values := make([]interface{}, len(records))
+	for i, record := range records {
+		if record == Null {
+			values[i] = nil
+		} else if record.ClassType.SuperClass == SObjectType {
+			name, _ := record.InstanceFields.Get("Name")
+			values[i] = name.Value()
+		} else {
+			values[i] = record.Extra["key"]
+		}
+	}
+	return values
+}
+
+func TestSort(t *testing.T) {
+	comparableType := &ast.ClassType{Name: "Foo", ImplementClasses: []*ast.ClassType{ComparableType}}
+	comparatorType := &ast.ClassType{Name: "FooComparator", ImplementClasses: []*ast.ClassType{CreateComparatorType(comparableType)}}
+	foo := func(key int) *ast.Object {
+		obj := ast.CreateObject(comparableType)
+		obj.Extra["key"] = key
+		return obj
+	}
+	ascending := func(o, other *ast.Object) *ast.Object {
+		return NewInteger(o.Extra["key"].(int) - other.Extra["key"].(int))
+	}
+	descending := func(o, other *ast.Object) *ast.Object {
+		return NewInteger(other.Extra["key"].(int) - o.Extra["key"].(int))
+	}
+	returnsNull := func(o, other *ast.Object) *ast.Object {
+		return Null
+	}
+	testCases := []struct {
+		Records    []*ast.Object
+		Comparator bool
+		Compare    func(o, other *ast.Object) *ast.Object
+		Expected   []interface{}
+		Error      string
+	}{
+		// Comparable
+		{[]*ast.Object{foo(3), foo(1), foo(2)}, false, ascending, []interface{}{1, 2, 3}, ""},
+		{[]*ast.Object{foo(3), Null, foo(1)}, false, ascending, []interface{}{nil, 1, 3}, ""},
+		{[]*ast.Object{foo(1), foo(2)}, false, returnsNull, nil, "ListException: compareTo must return Integer"},
+		// Comparator
+		{[]*ast.Object{foo(1), foo(3), foo(2)}, true, descending, []interface{}{3, 2, 1}, ""},
+		{[]*ast.Object{foo(1), foo(2)}, true, returnsNull, nil, "ListException: compare must return Integer"},
+		// not Comparable
+		{[]*ast.Object{NewInteger(1), NewString("a")}, false, ascending, nil, "ListException: One or more of the items in this list is not Comparable"},
+	}
+	for i, testCase := range testCases {
+		list := CreateListObject(CreateListType(comparableType), testCase.Records)
+		caller := &compareCaller{compare: testCase.Compare}
+		var r interface{}
+		if testCase.Comparator {
+			r = callNativeMethod(list, "sort", []*ast.Object{ast.CreateObject(comparatorType)}, caller)
+		} else {
+			r = callNativeMethod(list, "sort", []*ast.Object{}, caller)
+		}
+		if testCase.Error != "" {
+			err, ok := r.(error)
+			if !ok || err.Error() != testCase.Error {
+				t.Errorf("%d: expected error %s, actual %v", i, testCase.Error, r)
+			}
+			continue
+		}
+		if r != nil {
+			t.Errorf("%d: unexpected error %v", i, r)
+			continue
+		}
+		actual := sortedValues(testCase.Records)
+		for j, expected := range testCase.Expected {
+			if actual[j] != expected {
+				t.Errorf("%d: expected %v, actual %v", i, testCase.Expected, actual)
+				break
+			}
+		}
+	}
+}
+
+func TestSortSObjects(t *testing.T) {
+	sObjectType := func(name string) *ast.ClassType {
+		fields := ast.NewFieldMap()
+		for _, f := range []string{"Id", "Name", "Amount", "Foo__c"} {
+			fields.Set(f, ast.CreateField(f, StringType))
+		}
+		return &ast.ClassType{Name: name, SuperClass: SObjectType, InstanceFields: fields}
+	}
+	account := sObjectType("Account")
+	contact := sObjectType("Contact")
+	record := func(classType *ast.ClassType, name string, values ...*ast.Object) *ast.Object {
+		obj := ast.CreateObject(classType)
+		obj.InstanceFields.Set("Name", NewString(name))
+		if len(values) > 0 {
+			obj.InstanceFields.Set("Amount", values[0])
+		}
+		if len(values) > 1 {
+			obj.InstanceFields.Set("Foo__c", values[1])
+		}
+		return obj
+	}
+	testCases := []struct {
+		Records  []*ast.Object
+		Expected []interface{}
+	}{
+		// sObject type first, and then Name
+		{[]*ast.Object{record(contact, "a"), record(account, "c"), record(account, "b")}, []interface{}{"b", "c", "a"}},
+		// Name before other fields
+		{[]*ast.Object{
+			record(account, "x", NewString("1"), NewString("c")),
+			record(account, "y", NewString("2"), NewString("b")),
+			record(account, "z", NewString("3"), NewString("a")),
+		}, []interface{}{"x", "y", "z"}},
+		{[]*ast.Object{
+			record(account, "a", NewString("2")),
+			record(account, "a", NewString("1"), NewString("b")),
+			record(account, "a", NewString("1"), NewString("a")),
+			record(account, "a"),
+		}, nil},
+	}
+	for i, testCase := range testCases {
+		records := append([]*ast.Object{}, testCase.Records...)
+		list := CreateListObject(CreateListType(SObjectType), records)
+		if r := callNativeMethod(list, "sort", []*ast.Object{}, &compareCaller{}); r != nil {
+			t.Errorf("%d: unexpected error %v", i, r)
+			continue
+		}
+		if testCase.Expected == nil {
+			// same Name, ordered by standard field Amount and then custom field Foo__c, null first
+			expected := []*ast.Object{testCase.Records[3], testCase.Records[2], testCase.Records[1], testCase.Records[0]}
+			for j, record := range records {
+				if record != expected[j] {
+					t.Errorf("%d: unexpected order at %d", i, j)
+				}
+			}
+			continue
+		}
+		actual := sortedValues(records)
+		for j, expected := range testCase.Expected {
+			if actual[j] != expected {
+				t.Errorf("%d: expected %v, actual %v", i, testCase.Expected, actual)
+				break
+			}
+		}
+	}
+}
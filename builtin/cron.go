@@ -0,0 +1,316 @@
+package builtin
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	cronMinYear = 1970
+	cronMaxYear = 2099
+)
+
+var cronMonthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var cronDayNames = map[string]int{
+	"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+}
+
+var (
+	cronNearestWeekdayPattern = regexp.MustCompile(`^(\d+)W$`)
+	cronLastDayOfWeekPattern  = regexp.MustCompile(`^(\w+)L$`)
+	cronNthDayOfWeekPattern   = regexp.MustCompile(`^(\w+)#(\d+)$`)
+)
+
+// CronExpression is cron expression of System.schedule in Salesforce format,
+// that is "Seconds Minutes Hours Day_of_month Month Day_of_week Optional_year".
+type CronExpression struct {
+	Expression string
+
+	seconds []bool
+	minutes []bool
+	hours   []bool
+	months  []bool
+	years   []bool // nil means every year
+
+	// Day_of_month, used unless it is '?'
+	dayOfMonthSpecified bool
+	daysOfMonth         []bool
+	lastDayOfMonth      bool // L
+	lastWeekdayOfMonth  bool // LW
+	nearestWeekday      int  // nW
+
+	// Day_of_week, used unless it is '?'
+	daysOfWeek    []bool
+	lastDayOfWeek int // nL, last n-day of the month
+	nthDayOfWeek  int // n#k, k-th n-day of the month
+	nth           int
+}
+
+func ParseCronExpression(expression string) (*CronExpression, error) {
+	fields := strings.Fields(expression)
+	if len(fields) < 6 {
+		return nil, fmt.Errorf("Unexpected end of expression.")
+	}
+	if len(fields) > 7 {
+		return nil, fmt.Errorf("Unexpected characters at the end of expression: %s", strings.Join(fields[7:], " "))
+	}
+	e := &CronExpression{Expression: expression}
+	var err error
+	if e.seconds, err = parseCronValues(fields[0], 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if e.minutes, err = parseCronValues(fields[1], 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if e.hours, err = parseCronValues(fields[2], 0, 23, nil); err != nil {
+		return nil, err
+	}
+	if e.months, err = parseCronValues(fields[4], 1, 12, cronMonthNames); err != nil {
+		return nil, err
+	}
+	if len(fields) == 7 && fields[6] != "*" {
+		years, err := parseCronValues(fields[6], cronMinYear, cronMaxYear, nil)
+		if err != nil {
+			return nil, err
+		}
+		e.years = years
+	}
+
+	dayOfMonth, dayOfWeek := fields[3], fields[5]
+	if dayOfMonth == "?" && dayOfWeek == "?" {
+		return nil, fmt.Errorf("'?' can only be specified for Day-of-Month -OR- Day-of-Week.")
+	}
+	if dayOfMonth != "?" && dayOfWeek != "?" {
+		return nil, fmt.Errorf("Support for specifying both a day-of-week AND a day-of-month parameter is not implemented.")
+	}
+	if dayOfMonth != "?" {
+		e.dayOfMonthSpecified = true
+		if err := e.parseDayOfMonth(dayOfMonth); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := e.parseDayOfWeek(dayOfWeek); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+func (e *CronExpression) parseDayOfMonth(field string) error {
+	switch {
+	case field == "L":
+		e.lastDayOfMonth = true
+	case field == "LW":
+		e.lastWeekdayOfMonth = true
+	case cronNearestWeekdayPattern.MatchString(field):
+		day, _ := strconv.Atoi(cronNearestWeekdayPattern.FindStringSubmatch(field)[1])
+		if day < 1 || day > 31 {
+			return fmt.Errorf("Day of month values must be between 1 and 31: %s", field)
+		}
+		e.nearestWeekday = day
+	default:
+		values, err := parseCronValues(field, 1, 31, nil)
+		if err != nil {
+			return err
+		}
+		e.daysOfMonth = values
+	}
+	return nil
+}
+
+func (e *CronExpression) parseDayOfWeek(field string) error {
+	switch {
+	case field == "L":
+		// L alone means Saturday
+		e.daysOfWeek = make([]bool, 8)
+		e.daysOfWeek[7] = true
+	case cronLastDayOfWeekPattern.MatchString(field):
+		day, err := parseCronValue(cronLastDayOfWeekPattern.FindStringSubmatch(field)[1], 1, 7, cronDayNames)
+		if err != nil {
+			return err
+		}
+		e.lastDayOfWeek = day
+	case cronNthDayOfWeekPattern.MatchString(field):
+		matches := cronNthDayOfWeekPattern.FindStringSubmatch(field)
+		day, err := parseCronValue(matches[1], 1, 7, cronDayNames)
+		if err != nil {
+			return err
+		}
+		nth, _ := strconv.Atoi(matches[2])
+		if nth < 1 || nth > 5 {
+			return fmt.Errorf("A numeric value between 1 and 5 must follow the '#' option: %s", field)
+		}
+		e.nthDayOfWeek = day
+		e.nth = nth
+	default:
+		values, err := parseCronValues(field, 1, 7, cronDayNames)
+		if err != nil {
+			return err
+		}
+		e.daysOfWeek = values
+	}
+	return nil
+}
+
+// parseCronValues parses comma separated list of '*', value, range 'a-b' and increment '/n'.
+// Returned slice is indexed by value, offset by min for years.
+func parseCronValues(field string, min, max int, names map[string]int) ([]bool, error) {
+	offset := 0
+	if min >= cronMinYear {
+		offset = min
+	}
+	values := make([]bool, max-offset+1)
+	span := max - min + 1
+	for _, item := range strings.Split(field, ",") {
+		step := 1
+		hasStep := false
+		rangePart := item
+		if i := strings.Index(item, "/"); i >= 0 {
+			var err error
+			step, err = strconv.Atoi(item[i+1:])
+			if err != nil || step < 1 || step > span {
+				return nil, fmt.Errorf("Increment must be between 1 and %d: %s", span, item)
+			}
+			hasStep = true
+			rangePart = item[:i]
+		}
+		var start, end int
+		switch {
+		case rangePart == "*":
+			start, end = min, max
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if start, err = parseCronValue(bounds[0], min, max, names); err != nil {
+				return nil, err
+			}
+			if end, err = parseCronValue(bounds[1], min, max, names); err != nil {
+				return nil, err
+			}
+			if end < start {
+				// range wraps around, such as FRI-MON
+				end += span
+			}
+		default:
+			var err error
+			if start, err = parseCronValue(rangePart, min, max, names); err != nil {
+				return nil, err
+			}
+			end = start
+			if hasStep {
+				end = max
+			}
+		}
+		for v := start; v <= end; v += step {
+			values[(v-min)%span+min-offset] = true
+		}
+	}
+	return values, nil
+}
+
+func parseCronValue(s string, min, max int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("Illegal characters for this position: '%s'", s)
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("Value %d out of range, must be between %d and %d", v, min, max)
+	}
+	return v, nil
+}
+
+// Next returns the first fire time after the time, or nil if the expression never fires again
+func (e *CronExpression) Next(after time.Time) *time.Time {
+	loc := after.Location()
+	t := after.Truncate(time.Second).Add(time.Second)
+	for t.Year() <= cronMaxYear {
+		if !e.matchYear(t.Year()) {
+			t = time.Date(t.Year()+1, 1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !e.months[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !e.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !e.hours[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if !e.minutes[t.Minute()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()+1, 0, 0, loc)
+			continue
+		}
+		if !e.seconds[t.Second()] {
+			t = t.Add(time.Second)
+			continue
+		}
+		return &t
+	}
+	return nil
+}
+
+func (e *CronExpression) matchYear(year int) bool {
+	if e.years == nil {
+		return year >= cronMinYear
+	}
+	return year >= cronMinYear && e.years[year-cronMinYear]
+}
+
+func (e *CronExpression) matchDay(t time.Time) bool {
+	lastDay := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	if e.dayOfMonthSpecified {
+		switch {
+		case e.lastDayOfMonth:
+			return t.Day() == lastDay
+		case e.lastWeekdayOfMonth:
+			return t.Day() == nearestWeekday(t, lastDay, lastDay)
+		case e.nearestWeekday > 0:
+			day := e.nearestWeekday
+			if day > lastDay {
+				day = lastDay
+			}
+			return t.Day() == nearestWeekday(t, day, lastDay)
+		}
+		return e.daysOfMonth[t.Day()]
+	}
+
+	dayOfWeek := int(t.Weekday()) + 1
+	switch {
+	case e.lastDayOfWeek > 0:
+		return dayOfWeek == e.lastDayOfWeek && t.Day()+7 > lastDay
+	case e.nthDayOfWeek > 0:
+		return dayOfWeek == e.nthDayOfWeek && (t.Day()-1)/7+1 == e.nth
+	}
+	return e.daysOfWeek[dayOfWeek]
+}
+
+// nearestWeekday returns the weekday nearest to the day in the month of t, without crossing the month
+func nearestWeekday(t time.Time, day, lastDay int) int {
+	switch time.Date(t.Year(), t.Month(), day, 0, 0, 0, 0, t.Location()).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
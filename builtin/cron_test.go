@@ -0,0 +1,94 @@
+package builtin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronExpressionNext(t *testing.T) {
+	date := func(year int, month time.Month, day, hour, min, sec int) time.Time {
+		return time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+	}
+	testCases := []struct {
+		Expression string
+		After      time.Time
+		Expected   *time.Time
+	}{
+		{"0 0 12 * * ?", date(2020, 1, 1, 0, 0, 0), timePtr(date(2020, 1, 1, 12, 0, 0))},
+		// fire time is after the time, not at the time
+		{"0 0 12 * * ?", date(2020, 1, 1, 12, 0, 0), timePtr(date(2020, 1, 2, 12, 0, 0))},
+		{"0 0/30 * * * ?", date(2020, 1, 1, 10, 10, 0), timePtr(date(2020, 1, 1, 10, 30, 0))},
+		{"15,45 * * * * ?", date(2020, 1, 1, 10, 10, 20), timePtr(date(2020, 1, 1, 10, 10, 45))},
+		{"0 0 8-10 * * ?", date(2020, 1, 1, 10, 0, 0), timePtr(date(2020, 1, 2, 8, 0, 0))},
+		{"0 0 0 1 JAN,JUL ?", date(2020, 1, 2, 0, 0, 0), timePtr(date(2020, 7, 1, 0, 0, 0))},
+		// 2020-01-04 is Saturday
+		{"0 15 10 ? * MON-FRI", date(2020, 1, 4, 0, 0, 0), timePtr(date(2020, 1, 6, 10, 15, 0))},
+		// range wraps around the week
+		{"0 0 0 ? * FRI-MON", date(2020, 1, 7, 0, 0, 0), timePtr(date(2020, 1, 10, 0, 0, 0))},
+		{"0 0 0 ? * 1", date(2020, 1, 1, 0, 0, 0), timePtr(date(2020, 1, 5, 0, 0, 0))},
+		// L alone in Day_of_week is Saturday
+		{"0 0 0 ? * L", date(2020, 1, 1, 0, 0, 0), timePtr(date(2020, 1, 4, 0, 0, 0))},
+		{"0 0 0 ? * 6L", date(2020, 1, 1, 0, 0, 0), timePtr(date(2020, 1, 31, 0, 0, 0))},
+		{"0 0 0 ? * 2#1", date(2020, 1, 31, 0, 0, 0), timePtr(date(2020, 2, 3, 0, 0, 0))},
+		{"0 0 0 ? * MON#5", date(2020, 1, 1, 0, 0, 0), timePtr(date(2020, 3, 30, 0, 0, 0))},
+		{"0 0 0 L * ?", date(2020, 2, 1, 0, 0, 0), timePtr(date(2020, 2, 29, 0, 0, 0))},
+		{"0 0 0 31 * ?", date(2020, 2, 1, 0, 0, 0), timePtr(date(2020, 3, 31, 0, 0, 0))},
+		// 2020-05-31 is Sunday
+		{"0 0 0 LW * ?", date(2020, 5, 1, 0, 0, 0), timePtr(date(2020, 5, 29, 0, 0, 0))},
+		// 2020-02-15 is Saturday, 2020-02-01 is Saturday and the nearest weekday does not cross the month
+		{"0 0 0 15W * ?", date(2020, 2, 1, 0, 0, 0), timePtr(date(2020, 2, 14, 0, 0, 0))},
+		{"0 0 0 1W * ?", date(2020, 1, 31, 0, 0, 0), timePtr(date(2020, 2, 3, 0, 0, 0))},
+		{"0 0 0 1 1 ? 2021", date(2020, 6, 1, 0, 0, 0), timePtr(date(2021, 1, 1, 0, 0, 0))},
+		{"0 0 0 1 1 ? 2021", date(2021, 1, 1, 0, 0, 0), nil},
+		{"0 0 0 1 1 ? 2021-2099/2", date(2021, 1, 1, 0, 0, 0), timePtr(date(2023, 1, 1, 0, 0, 0))},
+	}
+	for i, testCase := range testCases {
+		e, err := ParseCronExpression(testCase.Expression)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err.Error())
+			continue
+		}
+		actual := e.Next(testCase.After)
+		switch {
+		case testCase.Expected == nil && actual != nil:
+			t.Errorf("%d: %s: expected nil, actual %s", i, testCase.Expression, actual)
+		case testCase.Expected != nil && actual == nil:
+			t.Errorf("%d: %s: expected %s, actual nil", i, testCase.Expression, testCase.Expected)
+		case testCase.Expected != nil && !actual.Equal(*testCase.Expected):
+			t.Errorf("%d: %s: expected %s, actual %s", i, testCase.Expression, testCase.Expected, actual)
+		}
+	}
+}
+
+func TestParseCronExpressionError(t *testing.T) {
+	testCases := []struct {
+		Expression string
+		Expected   string
+	}{
+		{"0 0 12 * *", "Unexpected end of expression."},
+		{"0 0 12 * * ? 2020 2021", "Unexpected characters at the end of expression: 2021"},
+		{"0 0 12 ? * ?", "'?' can only be specified for Day-of-Month -OR- Day-of-Week."},
+		{"0 0 12 * * MON", "Support for specifying both a day-of-week AND a day-of-month parameter is not implemented."},
+		{"60 0 0 * * ?", "Value 60 out of range, must be between 0 and 59"},
+		{"0 0 24 * * ?", "Value 24 out of range, must be between 0 and 23"},
+		{"0 0/0 0 * * ?", "Increment must be between 1 and 60: 0/0"},
+		{"0 0 0 X * ?", "Illegal characters for this position: 'X'"},
+		{"0 0 0 32W * ?", "Day of month values must be between 1 and 31: 32W"},
+		{"0 0 0 ? * MON#6", "A numeric value between 1 and 5 must follow the '#' option: MON#6"},
+		{"0 0 0 1 1 ? 1969", "Value 1969 out of range, must be between 1970 and 2099"},
+	}
+	for i, testCase := range testCases {
+		_, err := ParseCronExpression(testCase.Expression)
+		if err == nil {
+			t.Errorf("%d: %s: expected error", i, testCase.Expression)
+			continue
+		}
+		if err.Error() != testCase.Expected {
+			t.Errorf("%d: expected: %s, actual: %s", i, testCase.Expected, err.Error())
+		}
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
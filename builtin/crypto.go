@@ -340,7 +340,7 @@ func init() {
 		},
 	)
 
-	primitiveClassMap.Set("Date", DateType)
+	primitiveClassMap.Set("Crypto", cryptoType)
 }
 
 func padPKCS7(data []byte) []byte {
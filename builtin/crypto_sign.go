@@ -0,0 +1,310 @@
+package builtin
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	cryptorand "crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// KeyStoreDirectory is the directory which stands in for certificates of the org.
+// The certificate named "name" consists of name.key (PEM private key) and name.crt (PEM certificate).
+var KeyStoreDirectory = "keystore"
+
+var certificateNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// signatureAlgorithm is the algorithm name of Crypto.sign and Crypto.verify, such as RSA-SHA256
+type signatureAlgorithm struct {
+	hash  crypto.Hash
+	ecdsa bool
+}
+
+var signatureAlgorithms = map[string]*signatureAlgorithm{
+	"RSA":          {hash: crypto.SHA1},
+	"RSA-SHA1":     {hash: crypto.SHA1},
+	"RSA-SHA256":   {hash: crypto.SHA256},
+	"RSA-SHA384":   {hash: crypto.SHA384},
+	"RSA-SHA512":   {hash: crypto.SHA512},
+	"ECDSA-SHA256": {hash: crypto.SHA256, ecdsa: true},
+	"ECDSA-SHA384": {hash: crypto.SHA384, ecdsa: true},
+	"ECDSA-SHA512": {hash: crypto.SHA512, ecdsa: true},
+}
+
+func lookupSignatureAlgorithm(name string) (*signatureAlgorithm, error) {
+	algorithm, ok := signatureAlgorithms[name]
+	if !ok {
+		return nil, fmt.Errorf("Invalid algorithm: %s", name)
+	}
+	return algorithm, nil
+}
+
+func (a *signatureAlgorithm) digest(input []byte) []byte {
+	h := a.hash.New()
+	h.Write(input)
+	return h.Sum(nil)
+}
+
+// sign signs the input with the private key, ECDSA signature is ASN.1 DER encoded as Java does
+func (a *signatureAlgorithm) sign(input []byte, key crypto.PrivateKey) ([]byte, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if !a.ecdsa {
+			return rsa.SignPKCS1v15(cryptorand.Reader, k, a.hash, a.digest(input))
+		}
+	case *ecdsa.PrivateKey:
+		if a.ecdsa {
+			return ecdsa.SignASN1(cryptorand.Reader, k, a.digest(input))
+		}
+	}
+	return nil, errors.New("Key type does not match the algorithm")
+}
+
+func (a *signatureAlgorithm) verify(input []byte, signature []byte, key crypto.PublicKey) (bool, error) {
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		if !a.ecdsa {
+			return rsa.VerifyPKCS1v15(k, a.hash, a.digest(input), signature) == nil, nil
+		}
+	case *ecdsa.PublicKey:
+		if a.ecdsa {
+			return ecdsa.VerifyASN1(k, a.digest(input), signature), nil
+		}
+	}
+	return false, errors.New("Key type does not match the algorithm")
+}
+
+// parsePrivateKey parses DER encoded private key in PKCS#8, PKCS#1 or SEC 1 form
+func parsePrivateKey(der []byte) (crypto.PrivateKey, error) {
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("Invalid private key")
+}
+
+// parsePublicKey parses DER encoded public key in X.509 SubjectPublicKeyInfo or PKCS#1 form
+func parsePublicKey(der []byte) (crypto.PublicKey, error) {
+	if key, err := x509.ParsePKIXPublicKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PublicKey(der); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("Invalid public key")
+}
+
+// readKeyStore reads the first PEM block of the certificate file in the key store, files can not be read in the sandbox
+func readKeyStore(extra map[string]interface{}, name string, ext string) ([]byte, error) {
+	if sandboxOf(extra) != nil {
+		return nil, errors.New("Certificates are not allowed in the sandbox")
+	}
+	if !certificateNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("Invalid certificate name: %s", name)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(KeyStoreDirectory, name+ext))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("Certificate not found: %s", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("Invalid PEM file: %s%s", name, ext)
+	}
+	return block.Bytes, nil
+}
+
+// certificatePrivateKey returns the private key of the certificate in the key store
+func certificatePrivateKey(extra map[string]interface{}, name string) (crypto.PrivateKey, error) {
+	der, err := readKeyStore(extra, name, ".key")
+	if err != nil {
+		return nil, err
+	}
+	return parsePrivateKey(der)
+}
+
+// certificatePublicKey returns the public key of the certificate in the key store
+func certificatePublicKey(extra map[string]interface{}, name string) (crypto.PublicKey, error) {
+	der, err := readKeyStore(extra, name, ".crt")
+	if err != nil {
+		return nil, err
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid certificate: %s", name)
+	}
+	return certificate.PublicKey, nil
+}
+
+func signWith(algorithmName string, input []byte, key crypto.PrivateKey) interface{} {
+	algorithm, err := lookupSignatureAlgorithm(algorithmName)
+	if err != nil {
+		return Raise(InvalidParameterValueExceptionType, err.Error())
+	}
+	signature, err := algorithm.sign(input, key)
+	if err != nil {
+		return Raise(SecurityExceptionType, err.Error())
+	}
+	return NewBlob(signature)
+}
+
+func verifyWith(algorithmName string, input []byte, signature []byte, key crypto.PublicKey) interface{} {
+	algorithm, err := lookupSignatureAlgorithm(algorithmName)
+	if err != nil {
+		return Raise(InvalidParameterValueExceptionType, err.Error())
+	}
+	ok, err := algorithm.verify(input, signature, key)
+	if err != nil {
+		return Raise(SecurityExceptionType, err.Error())
+	}
+	return NewBoolean(ok)
+}
+
+// signXml is native function of Crypto.signXml, XML signature is not supported
+func signXml(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+	return Raise(SecurityExceptionType, "Crypto.signXml is not supported")
+}
+
+func init() {
+	cryptoType.StaticMethods.Set(
+		"sign",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"sign",
+				BlobType,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					BlobTypeParameter,
+					BlobTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null || params[1] == Null || params[2] == Null {
+						return NewNullPointerException("Argument")
+					}
+					key, err := parsePrivateKey(params[2].Value().([]byte))
+					if err != nil {
+						return Raise(SecurityExceptionType, err.Error())
+					}
+					return signWith(params[0].StringValue(), params[1].Value().([]byte), key)
+				},
+			),
+		},
+	)
+
+	cryptoType.StaticMethods.Set(
+		"signWithCertificate",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"signWithCertificate",
+				BlobType,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					BlobTypeParameter,
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null || params[1] == Null || params[2] == Null {
+						return NewNullPointerException("Argument")
+					}
+					key, err := certificatePrivateKey(extra, params[2].StringValue())
+					if err != nil {
+						return Raise(InvalidParameterValueExceptionType, err.Error())
+					}
+					return signWith(params[0].StringValue(), params[1].Value().([]byte), key)
+				},
+			),
+		},
+	)
+
+	// signXml is declared so that the code which uses it compiles, it cannot sign because Dom.XmlNode is not available
+	cryptoType.StaticMethods.Set(
+		"signXml",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"signXml",
+				nil,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					objectTypeParameter,
+					stringTypeParameter,
+					stringTypeParameter,
+				},
+				signXml,
+			),
+			ast.CreateMethod(
+				"signXml",
+				nil,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					objectTypeParameter,
+					stringTypeParameter,
+					stringTypeParameter,
+					objectTypeParameter,
+				},
+				signXml,
+			),
+		},
+	)
+
+	cryptoType.StaticMethods.Set(
+		"verify",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"verify",
+				BooleanType,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					BlobTypeParameter,
+					BlobTypeParameter,
+					BlobTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null || params[1] == Null || params[2] == Null || params[3] == Null {
+						return NewNullPointerException("Argument")
+					}
+					key, err := parsePublicKey(params[3].Value().([]byte))
+					if err != nil {
+						return Raise(SecurityExceptionType, err.Error())
+					}
+					return verifyWith(params[0].StringValue(), params[1].Value().([]byte), params[2].Value().([]byte), key)
+				},
+			),
+			ast.CreateMethod(
+				"verify",
+				BooleanType,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					BlobTypeParameter,
+					BlobTypeParameter,
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null || params[1] == Null || params[2] == Null || params[3] == Null {
+						return NewNullPointerException("Argument")
+					}
+					key, err := certificatePublicKey(extra, params[3].StringValue())
+					if err != nil {
+						return Raise(InvalidParameterValueExceptionType, err.Error())
+					}
+					return verifyWith(params[0].StringValue(), params[1].Value().([]byte), params[2].Value().([]byte), key)
+				},
+			),
+		},
+	)
+}
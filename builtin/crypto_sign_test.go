@@ -0,0 +1,219 @@
+package builtin
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	cryptorand "crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+func TestSignatureAlgorithm(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(cryptorand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	input := []byte("message")
+	for name, algorithm := range signatureAlgorithms {
+		var privateKey crypto.PrivateKey = rsaKey
+		var publicKey, otherKey crypto.PublicKey = &rsaKey.PublicKey, &ecKey.PublicKey
+		if algorithm.ecdsa {
+			privateKey, publicKey, otherKey = ecKey, &ecKey.PublicKey, &rsaKey.PublicKey
+		}
+		signature, err := algorithm.sign(input, privateKey)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err.Error())
+			continue
+		}
+		if ok, err := algorithm.verify(input, signature, publicKey); !ok || err != nil {
+			t.Errorf("%s: expected valid signature, actual %t, %v", name, ok, err)
+		}
+		if ok, _ := algorithm.verify([]byte("tampered"), signature, publicKey); ok {
+			t.Errorf("%s: expected invalid signature for tampered input", name)
+		}
+		if _, err := algorithm.verify(input, signature, otherKey); err == nil || err.Error() != "Key type does not match the algorithm" {
+			t.Errorf("%s: expected key type error, actual %v", name, err)
+		}
+	}
+
+	// RSA is RSA-SHA1
+	signature, _ := signatureAlgorithms["RSA"].sign(input, rsaKey)
+	if ok, _ := signatureAlgorithms["RSA-SHA1"].verify(input, signature, &rsaKey.PublicKey); !ok {
+		t.Errorf("expected RSA to be verified by RSA-SHA1")
+	}
+	if ok, _ := signatureAlgorithms["RSA-SHA256"].verify(input, signature, &rsaKey.PublicKey); ok {
+		t.Errorf("expected RSA not to be verified by RSA-SHA256")
+	}
+	if _, err := lookupSignatureAlgorithm("DSA"); err == nil || err.Error() != "Invalid algorithm: DSA" {
+		t.Errorf("expected invalid algorithm error, actual %v", err)
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(cryptorand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	rsaPKCS8, _ := x509.MarshalPKCS8PrivateKey(rsaKey)
+	ecPKCS8, _ := x509.MarshalPKCS8PrivateKey(ecKey)
+	ecSEC1, _ := x509.MarshalECPrivateKey(ecKey)
+	privateKeys := []struct {
+		Name  string
+		Der   []byte
+		IsRSA bool
+	}{
+		{"PKCS#8 RSA", rsaPKCS8, true},
+		{"PKCS#1", x509.MarshalPKCS1PrivateKey(rsaKey), true},
+		{"PKCS#8 EC", ecPKCS8, false},
+		{"SEC 1", ecSEC1, false},
+	}
+	for _, testCase := range privateKeys {
+		key, err := parsePrivateKey(testCase.Der)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", testCase.Name, err.Error())
+			continue
+		}
+		if _, isRSA := key.(*rsa.PrivateKey); isRSA != testCase.IsRSA {
+			t.Errorf("%s: unexpected key type %T", testCase.Name, key)
+		}
+	}
+	if _, err := parsePrivateKey([]byte("invalid")); err == nil || err.Error() != "Invalid private key" {
+		t.Errorf("expected invalid private key error, actual %v", err)
+	}
+
+	rsaPKIX, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	for name, der := range map[string][]byte{"PKIX": rsaPKIX, "PKCS#1": x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)} {
+		key, err := parsePublicKey(der)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err.Error())
+			continue
+		}
+		if _, ok := key.(*rsa.PublicKey); !ok {
+			t.Errorf("%s: unexpected key type %T", name, key)
+		}
+	}
+	if _, err := parsePublicKey([]byte("invalid")); err == nil || err.Error() != "Invalid public key" {
+		t.Errorf("expected invalid public key error, actual %v", err)
+	}
+}
+
+func TestKeyStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	defer os.RemoveAll(dir)
+	prevDirectory := KeyStoreDirectory
+	KeyStoreDirectory = dir
+	defer func() {
+		KeyStoreDirectory = prevDirectory
+	}()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), cryptorand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certificate, err := x509.CreateCertificate(cryptorand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	keyDer, _ := x509.MarshalECPrivateKey(key)
+	writePem := func(file, blockType string, der []byte) {
+		data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+		if err := ioutil.WriteFile(filepath.Join(dir, file), data, 0600); err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+	}
+	writePem("My_Cert.key", "EC PRIVATE KEY", keyDer)
+	writePem("My_Cert.crt", "CERTIFICATE", certificate)
+	ioutil.WriteFile(filepath.Join(dir, "Broken.key"), []byte("not pem"), 0600)
+	writePem("Broken.crt", "CERTIFICATE", []byte("not der"))
+
+	extra := map[string]interface{}{}
+	privateKey, err := certificatePrivateKey(extra, "My_Cert")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	publicKey, err := certificatePublicKey(extra, "My_Cert")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	algorithm := signatureAlgorithms["ECDSA-SHA256"]
+	signature, err := algorithm.sign([]byte("message"), privateKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	if ok, _ := algorithm.verify([]byte("message"), signature, publicKey); !ok {
+		t.Errorf("expected the signature to be verified by the certificate")
+	}
+
+	errorCases := []struct {
+		Name     string
+		Private  bool
+		Extra    map[string]interface{}
+		Expected string
+	}{
+		{"Missing", true, extra, "Certificate not found: Missing"},
+		{"../My_Cert", true, extra, "Invalid certificate name: ../My_Cert"},
+		{"Broken", true, extra, "Invalid PEM file: Broken.key"},
+		{"Broken", false, extra, "Invalid certificate: Broken"},
+		{"My_Cert", true, map[string]interface{}{"sandbox": &Sandbox{}}, "Certificates are not allowed in the sandbox"},
+	}
+	for i, testCase := range errorCases {
+		var err error
+		if testCase.Private {
+			_, err = certificatePrivateKey(testCase.Extra, testCase.Name)
+		} else {
+			_, err = certificatePublicKey(testCase.Extra, testCase.Name)
+		}
+		if err == nil {
+			t.Errorf("%d: %s: expected error", i, testCase.Name)
+			continue
+		}
+		if err.Error() != testCase.Expected {
+			t.Errorf("%d: expected: %s, actual: %s", i, testCase.Expected, err.Error())
+		}
+	}
+}
+
+func TestSignXml(t *testing.T) {
+	methods, _ := cryptoType.StaticMethods.Get("signXML")
+	for _, m := range methods {
+		params := make([]*ast.Object, len(m.Parameters))
+		for i := range params {
+			params[i] = Null
+		}
+		actual := resultString(m.NativeFunction(nil, params, map[string]interface{}{}))
+		if actual != "SecurityException: Crypto.signXml is not supported" {
+			t.Errorf("%d: expected SecurityException: Crypto.signXml is not supported, actual %s", len(params), actual)
+		}
+	}
+	if len(methods) != 2 {
+		t.Errorf("expected 2 methods, actual %d", len(methods))
+	}
+}
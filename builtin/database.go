@@ -23,7 +23,7 @@ func init() {
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 				obj := params[0]
 				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("insert", obj.ClassType.Name, records, "")
+				return DatabaseDriver.Execute("insert", obj.ClassType.Name, records, "", clockOf(extra).Now())
 			},
 		),
 		ast.CreateMethod(
@@ -39,7 +39,7 @@ func init() {
 				obj := params[0]
 				records := obj.Extra["records"].([]*ast.Object)
 				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("insert", sObjectType, records, "")
+				return DatabaseDriver.Execute("insert", sObjectType, records, "", clockOf(extra).Now())
 			},
 		),
 	})
@@ -52,7 +52,7 @@ func init() {
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 				obj := params[0]
 				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("update", obj.ClassType.Name, records, "")
+				return DatabaseDriver.Execute("update", obj.ClassType.Name, records, "", clockOf(extra).Now())
 			},
 		),
 		ast.CreateMethod(
@@ -68,7 +68,7 @@ func init() {
 				obj := params[0]
 				records := obj.Extra["records"].([]*ast.Object)
 				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("update", sObjectType, records, "")
+				return DatabaseDriver.Execute("update", sObjectType, records, "", clockOf(extra).Now())
 			},
 		),
 	})
@@ -81,7 +81,7 @@ func init() {
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 				obj := params[0]
 				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("delete", obj.ClassType.Name, records, "")
+				return DatabaseDriver.Execute("delete", obj.ClassType.Name, records, "", clockOf(extra).Now())
 			},
 		),
 		ast.CreateMethod(
@@ -97,7 +97,7 @@ func init() {
 				obj := params[0]
 				records := obj.Extra["records"].([]*ast.Object)
 				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("delete", sObjectType, records, "")
+				return DatabaseDriver.Execute("delete", sObjectType, records, "", clockOf(extra).Now())
 			},
 		),
 	})
@@ -114,7 +114,7 @@ func init() {
 				obj := params[0]
 				key := params[1].StringValue()
 				records := []*ast.Object{obj}
-				return DatabaseDriver.Execute("upsert", obj.ClassType.Name, records, key)
+				return DatabaseDriver.Execute("upsert", obj.ClassType.Name, records, key, clockOf(extra).Now())
 			},
 		),
 		ast.CreateMethod(
@@ -132,7 +132,7 @@ func init() {
 				key := params[1].StringValue()
 				records := obj.Extra["records"].([]*ast.Object)
 				sObjectType := records[0].ClassType.Name
-				return DatabaseDriver.Execute("upsert", sObjectType, records, key)
+				return DatabaseDriver.Execute("upsert", sObjectType, records, key, clockOf(extra).Now())
 			},
 		),
 	})
@@ -157,15 +157,37 @@ func init() {
 	)
 	staticMethods.Set("rollback", []*ast.Method{method})
 
-	method = ast.CreateMethod(
-		"getQueryLocator",
-		queryLocatorType,
-		[]*ast.Parameter{stringTypeParameter},
-		func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-			return Null
-		},
-	)
-	staticMethods.Set("getQueryLocator", []*ast.Method{method})
+	staticMethods.Set("getQueryLocator", []*ast.Method{
+		ast.CreateMethod(
+			"getQueryLocator",
+			queryLocatorType,
+			[]*ast.Parameter{stringTypeParameter},
+			getQueryLocator,
+		),
+		ast.CreateMethod(
+			"getQueryLocator",
+			queryLocatorType,
+			[]*ast.Parameter{CreateListTypeParameter(SObjectType)},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return newQueryLocator("", params[0].Extra["records"].([]*ast.Object))
+			},
+		),
+	})
+
+	staticMethods.Set("executeBatch", []*ast.Method{
+		ast.CreateMethod(
+			"executeBatch",
+			StringType,
+			[]*ast.Parameter{objectTypeParameter},
+			executeBatch,
+		),
+		ast.CreateMethod(
+			"executeBatch",
+			StringType,
+			[]*ast.Parameter{objectTypeParameter, IntegerTypeParameter},
+			executeBatch,
+		),
+	})
 
 	databaseClass := ast.CreateClass(
 		"Database",
@@ -226,18 +248,8 @@ func init() {
 	classMap.Set("SaveResult", saveResultType)
 	classMap.Set("QueryLocator", queryLocatorType)
 
-	batchableContext := ast.CreateClass(
-		"BatchableContext",
-		[]*ast.Method{},
-		ast.NewMethodMap(),
-		ast.NewMethodMap(),
-	)
-	classMap.Set("BatchableContext", batchableContext)
-
-	batchableContextTypeParameter := &ast.Parameter{
-		Type: batchableContext,
-		Name: "_",
-	}
+	classMap.Set("BatchableContext", batchableContextType)
+	classMap.Set("Stateful", statefulType)
 
 	instanceMethods = ast.NewMethodMap()
 	instanceMethods.Set(
@@ -328,9 +328,7 @@ func init() {
 				DateType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					obj := ast.CreateObject(DateType)
-					obj.Extra["value"] = time.Now()
-					return obj
+					return newDateOf(clockOf(extra).Now().In(UserTimeZone))
 				},
 			),
 		},
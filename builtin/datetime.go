@@ -18,122 +18,294 @@ var DatetimeType = ast.CreateClass(
 	ast.NewMethodMap(),
 )
 
+var datetimeTypeParameter = &ast.Parameter{
+	Type: DatetimeType,
+	Name: "_",
+}
+
+// datetimeStringLayout is the format of Datetime.valueOf and string conversion of Datetime
+const datetimeStringLayout = "yyyy-MM-dd HH:mm:ss"
+
+func NewDatetime(value time.Time) *ast.Object {
+	obj := ast.CreateObject(DatetimeType)
+	obj.Extra["value"] = value
+	return obj
+}
+
+func datetimeValue(o *ast.Object) time.Time {
+	return o.Extra["value"].(time.Time)
+}
+
+// newDateOf creates Date object of the day of the time
+func newDateOf(t time.Time) *ast.Object {
+	obj := ast.CreateObject(DateType)
+	obj.Extra["value"] = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return obj
+}
+
+// newTimeOf creates Time object of the time of day
+func newTimeOf(t time.Time) *ast.Object {
+	obj := ast.CreateObject(timeType)
+	obj.Extra["value"] = time.Date(2020, time.Month(1), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	return obj
+}
+
+// datetimeOf returns the time of the date and time of day in the location
+func datetimeOf(date, timeOfDay time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), timeOfDay.Hour(), timeOfDay.Minute(), timeOfDay.Second(), timeOfDay.Nanosecond(), loc)
+}
+
+// datetimeFromIntegers returns the time of year, month, day, hour, minute and second parameters in the location
+func datetimeFromIntegers(params []*ast.Object, loc *time.Location) time.Time {
+	values := make([]int, 6)
+	for i, p := range params {
+		values[i] = p.IntegerValue()
+	}
+	return time.Date(values[0], time.Month(values[1]), values[2], values[3], values[4], values[5], 0, loc)
+}
+
+// formatDatetime formats the Datetime object, the error is raised as StringException
+func formatDatetime(this *ast.Object, pattern string, loc *time.Location) interface{} {
+	s, err := FormatDate(datetimeValue(this), pattern, loc, UserLocale)
+	if err != nil {
+		return Raise(StringExceptionType, err.Error())
+	}
+	return NewString(s)
+}
+
+// parseDatetime parses the string with the pattern, the error is raised as TypeException
+func parseDatetime(value, pattern string, loc *time.Location) interface{} {
+	tm, err := ParseDate(value, pattern, loc, UserLocale)
+	if err != nil {
+		return Raise(TypeExceptionType, err.Error())
+	}
+	return NewDatetime(tm)
+}
+
+// localTimeGetter returns the method of the field of the time in user time zone
+func localTimeGetter(name string, field func(time.Time) int) []*ast.Method {
+	return []*ast.Method{
+		ast.CreateMethod(
+			name,
+			IntegerType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewInteger(field(datetimeValue(this).In(UserTimeZone)))
+			},
+		),
+	}
+}
+
+// gmtGetter returns the method of the field of the time in GMT
+func gmtGetter(name string, field func(time.Time) int) []*ast.Method {
+	return []*ast.Method{
+		ast.CreateMethod(
+			name,
+			IntegerType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewInteger(field(datetimeValue(this).UTC()))
+			},
+		),
+	}
+}
+
+// datetimeAdder returns the method which adds the amount to the time
+func datetimeAdder(name string, add func(time.Time, int) time.Time) []*ast.Method {
+	return []*ast.Method{
+		ast.CreateMethod(
+			name,
+			DatetimeType,
+			[]*ast.Parameter{IntegerTypeParameter},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewDatetime(add(datetimeValue(this).In(UserTimeZone), params[0].IntegerValue()))
+			},
+		),
+	}
+}
+
 func init() {
+	DatetimeType.ToString = func(o *ast.Object) string {
+		s, _ := FormatDate(datetimeValue(o), datetimeStringLayout, time.UTC, "en_US")
+		return s
+	}
+
+	fields := map[string]func(time.Time) int{
+		"year":        func(t time.Time) int { return t.Year() },
+		"month":       func(t time.Time) int { return int(t.Month()) },
+		"day":         func(t time.Time) int { return t.Day() },
+		"hour":        func(t time.Time) int { return t.Hour() },
+		"minute":      func(t time.Time) int { return t.Minute() },
+		"second":      func(t time.Time) int { return t.Second() },
+		"millisecond": func(t time.Time) int { return t.Nanosecond() / int(time.Millisecond) },
+		"dayOfYear":   func(t time.Time) int { return t.YearDay() },
+	}
+	for name, field := range fields {
+		DatetimeType.InstanceMethods.Set(name, localTimeGetter(name, field))
+		DatetimeType.InstanceMethods.Set(name+"Gmt", gmtGetter(name+"Gmt", field))
+	}
+
 	DatetimeType.InstanceMethods.Set(
-		"year",
+		"addDays",
+		datetimeAdder("addDays", func(t time.Time, n int) time.Time { return t.AddDate(0, 0, n) }),
+	)
+	DatetimeType.InstanceMethods.Set(
+		"addMonths",
+		datetimeAdder("addMonths", func(t time.Time, n int) time.Time { return addMonths(t, n) }),
+	)
+	DatetimeType.InstanceMethods.Set(
+		"addYears",
+		datetimeAdder("addYears", func(t time.Time, n int) time.Time { return addMonths(t, n*12) }),
+	)
+	DatetimeType.InstanceMethods.Set(
+		"addHours",
+		datetimeAdder("addHours", func(t time.Time, n int) time.Time { return t.Add(time.Duration(n) * time.Hour) }),
+	)
+	DatetimeType.InstanceMethods.Set(
+		"addMinutes",
+		datetimeAdder("addMinutes", func(t time.Time, n int) time.Time { return t.Add(time.Duration(n) * time.Minute) }),
+	)
+	DatetimeType.InstanceMethods.Set(
+		"addSeconds",
+		datetimeAdder("addSeconds", func(t time.Time, n int) time.Time { return t.Add(time.Duration(n) * time.Second) }),
+	)
+
+	DatetimeType.InstanceMethods.Set(
+		"date",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"year",
-				IntegerType,
+				"date",
+				DateType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					tm := this.Extra["value"].(time.Time)
-					return NewInteger(tm.Year())
+					return newDateOf(datetimeValue(this).In(UserTimeZone))
 				},
 			),
 		},
 	)
-
 	DatetimeType.InstanceMethods.Set(
-		"month",
+		"dateGmt",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"month",
-				IntegerType,
+				"dateGmt",
+				DateType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					tm := this.Extra["value"].(time.Time)
-					return NewInteger(int(tm.Month()))
+					return newDateOf(datetimeValue(this).UTC())
 				},
 			),
 		},
 	)
-
 	DatetimeType.InstanceMethods.Set(
-		"day",
+		"time",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"day",
-				IntegerType,
+				"time",
+				timeType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					tm := this.Extra["value"].(time.Time)
-					return NewInteger(tm.Day())
+					return newTimeOf(datetimeValue(this).In(UserTimeZone))
 				},
 			),
 		},
 	)
-
 	DatetimeType.InstanceMethods.Set(
-		"hour",
+		"timeGmt",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"hour",
-				IntegerType,
+				"timeGmt",
+				timeType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					tm := this.Extra["value"].(time.Time)
-					return NewInteger(tm.Hour())
+					return newTimeOf(datetimeValue(this).UTC())
 				},
 			),
 		},
 	)
 
 	DatetimeType.InstanceMethods.Set(
-		"minute",
+		"equals",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"minute",
-				IntegerType,
-				[]*ast.Parameter{},
+				"equals",
+				BooleanType,
+				[]*ast.Parameter{objectTypeParameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					tm := this.Extra["value"].(time.Time)
-					return NewInteger(tm.Minute())
+					other := params[0]
+					if other == Null || other.ClassType != DatetimeType {
+						return NewBoolean(false)
+					}
+					return NewBoolean(datetimeValue(this).Equal(datetimeValue(other)))
 				},
 			),
 		},
 	)
 
 	DatetimeType.InstanceMethods.Set(
-		"second",
+		"format",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"second",
-				IntegerType,
+				"format",
+				StringType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					tm := this.Extra["value"].(time.Time)
-					return NewInteger(tm.Second())
+					return formatDatetime(this, getLocaleData(UserLocale).datetimeFormat, UserTimeZone)
+				},
+			),
+			ast.CreateMethod(
+				"format",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return formatDatetime(this, params[0].StringValue(), UserTimeZone)
+				},
+			),
+			ast.CreateMethod(
+				"format",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter, stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					loc := timeZoneValue(NewTimeZone(params[1].StringValue()))
+					return formatDatetime(this, params[0].StringValue(), loc)
 				},
 			),
 		},
 	)
-
 	DatetimeType.InstanceMethods.Set(
-		"millisecond",
+		"formatGmt",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"millisecond",
-				IntegerType,
-				[]*ast.Parameter{},
+				"formatGmt",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					tm := this.Extra["value"].(time.Time)
-					return NewInteger(tm.Nanosecond() / 1000)
+					return formatDatetime(this, params[0].StringValue(), time.UTC)
 				},
 			),
 		},
 	)
-
 	DatetimeType.InstanceMethods.Set(
-		"format",
+		"formatLong",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"format",
+				"formatLong",
 				StringType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					tm := this.Extra["value"].(time.Time)
-					return NewString(tm.Format("2006/01/02"))
+					return formatDatetime(this, getLocaleData(UserLocale).longDatetimeFormat, UserTimeZone)
+				},
+			),
+		},
+	)
+	DatetimeType.InstanceMethods.Set(
+		"getTime",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getTime",
+				LongType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewLong(int(datetimeValue(this).UnixNano() / int64(time.Millisecond)))
 				},
 			),
 		},
@@ -144,12 +316,130 @@ func init() {
 		[]*ast.Method{
 			ast.CreateMethod(
 				"now",
-				DateType,
+				DatetimeType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					obj := ast.CreateObject(DatetimeType)
-					obj.Extra["value"] = time.Now()
-					return obj
+					return NewDatetime(clockOf(extra).Now())
+				},
+			),
+		},
+	)
+	DatetimeType.StaticMethods.Set(
+		"newInstance",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"newInstance",
+				DatetimeType,
+				[]*ast.Parameter{longTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					milliseconds := int64(params[0].IntegerValue())
+					return NewDatetime(time.Unix(0, milliseconds*int64(time.Millisecond)))
+				},
+			),
+			ast.CreateMethod(
+				"newInstance",
+				DatetimeType,
+				[]*ast.Parameter{dateTypeParameter, timeTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewDatetime(datetimeOf(params[0].Extra["value"].(time.Time), params[1].Extra["value"].(time.Time), UserTimeZone))
+				},
+			),
+			ast.CreateMethod(
+				"newInstance",
+				DatetimeType,
+				[]*ast.Parameter{IntegerTypeParameter, IntegerTypeParameter, IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewDatetime(datetimeFromIntegers(params, UserTimeZone))
+				},
+			),
+			ast.CreateMethod(
+				"newInstance",
+				DatetimeType,
+				[]*ast.Parameter{
+					IntegerTypeParameter,
+					IntegerTypeParameter,
+					IntegerTypeParameter,
+					IntegerTypeParameter,
+					IntegerTypeParameter,
+					IntegerTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewDatetime(datetimeFromIntegers(params, UserTimeZone))
+				},
+			),
+		},
+	)
+	DatetimeType.StaticMethods.Set(
+		"newInstanceGmt",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"newInstanceGmt",
+				DatetimeType,
+				[]*ast.Parameter{dateTypeParameter, timeTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewDatetime(datetimeOf(params[0].Extra["value"].(time.Time), params[1].Extra["value"].(time.Time), time.UTC))
+				},
+			),
+			ast.CreateMethod(
+				"newInstanceGmt",
+				DatetimeType,
+				[]*ast.Parameter{IntegerTypeParameter, IntegerTypeParameter, IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewDatetime(datetimeFromIntegers(params, time.UTC))
+				},
+			),
+			ast.CreateMethod(
+				"newInstanceGmt",
+				DatetimeType,
+				[]*ast.Parameter{
+					IntegerTypeParameter,
+					IntegerTypeParameter,
+					IntegerTypeParameter,
+					IntegerTypeParameter,
+					IntegerTypeParameter,
+					IntegerTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewDatetime(datetimeFromIntegers(params, time.UTC))
+				},
+			),
+		},
+	)
+	DatetimeType.StaticMethods.Set(
+		"parse",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"parse",
+				DatetimeType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return parseDatetime(params[0].StringValue(), getLocaleData(UserLocale).datetimeFormat, UserTimeZone)
+				},
+			),
+		},
+	)
+	DatetimeType.StaticMethods.Set(
+		"valueOf",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"valueOf",
+				DatetimeType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return parseDatetime(params[0].StringValue(), datetimeStringLayout, UserTimeZone)
+				},
+			),
+		},
+	)
+	DatetimeType.StaticMethods.Set(
+		"valueOfGmt",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"valueOfGmt",
+				DatetimeType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return parseDatetime(params[0].StringValue(), datetimeStringLayout, time.UTC)
 				},
 			),
 		},
@@ -157,3 +447,14 @@ func init() {
 
 	primitiveClassMap.Set("Datetime", DatetimeType)
 }
+
+// addMonths adds months to the time, the day is clamped to the last day of the month such as Jan 31 + 1 month = Feb 28
+func addMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(n), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
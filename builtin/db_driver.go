@@ -4,6 +4,7 @@ import (
 	"database/sql"
 
 	"fmt"
+	"strconv"
 	"strings"
 
 	"math/rand"
@@ -23,15 +24,17 @@ var DatabaseDriver = NewDatabaseDriver()
 func NewDatabaseDriver() *databaseDriver {
 	// TODO: implment not sqlite3
 	db, _ := sql.Open("sqlite3", "./database.sqlite3")
+	// BEGIN/ROLLBACK and SAVEPOINT are issued as plain statements, so they must share one connection
+	db.SetMaxOpenConns(1)
 	return &databaseDriver{db}
 }
 
 func (d *databaseDriver) Query(n *ast.Soql, interpreter ast.Visitor) []*ast.Object {
 	builder := SqlBuilder{interpreter: interpreter}
-	sql, selectFields, relations := builder.Build(n)
-	// pp.Println(sql)
+	query, selectFields, relations := builder.Build(n)
+	// pp.Println(query)
 
-	rows, err := d.db.Query(sql)
+	rows, err := d.db.Query(query)
 	if err != nil {
 		panic(err)
 	}
@@ -41,7 +44,7 @@ func (d *databaseDriver) Query(n *ast.Soql, interpreter ast.Visitor) []*ast.Obje
 	for rows.Next() {
 		dispatches := make([]interface{}, len(selectFields))
 		for i, _ := range selectFields {
-			var temp string
+			var temp sql.NullString
 			dispatches[i] = &temp
 		}
 		err := rows.Scan(dispatches...)
@@ -52,18 +55,20 @@ func (d *databaseDriver) Query(n *ast.Soql, interpreter ast.Visitor) []*ast.Obje
 		for i, field := range selectFields {
 			tmpTable := field[0]
 			fieldName := field[1]
-			value := NewString(*dispatches[i].(*string))
+			column := *dispatches[i].(*sql.NullString)
 
 			if tmpTable == "t0" {
+				value := columnValue(classType, fieldName, column)
 				record.InstanceFields.Set(fieldName, value)
 				continue
 			}
 			relationInfo := relations[tmpTable]
+			relationType, _ := PrimitiveClassMap().Get(relationInfo.ReferenceTo)
+			value := columnValue(relationType, fieldName, column)
 			relationField, ok := record.InstanceFields.Get(relationInfo.RelationshipName)
 			if ok {
 				relationField.InstanceFields.Set(fieldName, value)
 			} else {
-				relationType, _ := PrimitiveClassMap().Get(relationInfo.ReferenceTo)
 				relationField = ast.CreateObject(relationType)
 				relationField.InstanceFields.Set(fieldName, value)
 				record.InstanceFields.Set(relationInfo.RelationshipName, relationField)
@@ -74,6 +79,78 @@ func (d *databaseDriver) Query(n *ast.Soql, interpreter ast.Visitor) []*ast.Obje
 	return records
 }
 
+// columnValue converts column value to the type of the field, NULL column is converted to null
+func columnValue(classType *ast.ClassType, fieldName string, column sql.NullString) *ast.Object {
+	if !column.Valid {
+		return Null
+	}
+	if classType == nil {
+		return NewString(column.String)
+	}
+	field, ok := classType.InstanceFields.Get(fieldName)
+	if !ok {
+		return NewString(column.String)
+	}
+	switch field.Type {
+	case IntegerType:
+		if i, err := strconv.Atoi(column.String); err == nil {
+			return NewInteger(i)
+		}
+	case DoubleType:
+		if f, err := strconv.ParseFloat(column.String, 64); err == nil {
+			return NewDouble(f)
+		}
+	case DecimalType:
+		if d, err := ParseDecimal(column.String); err == nil {
+			return NewDecimal(d)
+		}
+	case DateType:
+		if tm, err := time.Parse(dateLayout, column.String); err == nil {
+			return newDateOf(tm)
+		}
+	case DatetimeType:
+		if tm, err := time.Parse(datetimeLayout, column.String); err == nil {
+			datetime := ast.CreateObject(DatetimeType)
+			datetime.Extra["value"] = tm
+			return datetime
+		}
+	}
+	return NewString(column.String)
+}
+
+// auditFields returns column values of the audit fields which the sObject has, stamped with the time.
+// CreatedDate is stamped on insert only.
+func auditFields(classType *ast.ClassType, created bool, now time.Time) map[string]string {
+	stamp := now.UTC().Format(datetimeLayout)
+	names := []string{"LastModifiedDate", "SystemModstamp"}
+	if created {
+		names = append(names, "CreatedDate")
+	}
+	fields := map[string]string{}
+	for _, name := range names {
+		if _, ok := classType.InstanceFields.Get(name); ok {
+			fields[name] = stamp
+		}
+	}
+	return fields
+}
+
+// sqlValue converts the field value to the literal of column value
+func sqlValue(o *ast.Object) string {
+	switch v := o.Value().(type) {
+	case string:
+		return v
+	case *Decimal:
+		return v.String()
+	case time.Time:
+		if o.ClassType == DateType {
+			return v.Format(dateLayout)
+		}
+		return v.UTC().Format(datetimeLayout)
+	}
+	return String(o)
+}
+
 func (d *databaseDriver) QueryRaw(query string) {
 	rows, err := d.db.Query(query)
 	if err != nil {
@@ -82,15 +159,46 @@ func (d *databaseDriver) QueryRaw(query string) {
 	pp.Println(rows)
 }
 
-func (d *databaseDriver) Begin() {
-	d.db.Exec("BEGIN;")
+func (d *databaseDriver) Begin() error {
+	_, err := d.db.Exec("BEGIN;")
+	return err
+}
+
+func (d *databaseDriver) Rollback() error {
+	_, err := d.db.Exec("ROLLBACK;")
+	return err
+}
+
+// Savepoint records current data state in the transaction, restored by RollbackTo
+func (d *databaseDriver) Savepoint(name string) error {
+	_, err := d.db.Exec(fmt.Sprintf("SAVEPOINT `%s`;", name))
+	return err
+}
+
+// RollbackTo restores data to the savepoint, the savepoint remains available for later rollbacks
+func (d *databaseDriver) RollbackTo(name string) error {
+	_, err := d.db.Exec(fmt.Sprintf("ROLLBACK TO SAVEPOINT `%s`;", name))
+	return err
 }
 
-func (d *databaseDriver) Rollback() {
-	d.db.Exec("ROLLBACK;")
+// Release removes the savepoint, changes after the savepoint are kept in the transaction
+func (d *databaseDriver) Release(name string) error {
+	_, err := d.db.Exec(fmt.Sprintf("RELEASE SAVEPOINT `%s`;", name))
+	return err
 }
 
-func (d *databaseDriver) Execute(dmlType string, sObjectType string, records []*ast.Object, upsertKey string) *ast.Object {
+// Truncate deletes all records of loaded sobjects, used to hide org data from tests
+func (d *databaseDriver) Truncate() error {
+	for name := range sObjects {
+		if _, err := d.db.Exec(fmt.Sprintf("DELETE FROM `%s`;", name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Execute runs DML of the records, audit fields are stamped with now which is the time of the clock of the interpreter
+func (d *databaseDriver) Execute(dmlType string, sObjectType string, records []*ast.Object, upsertKey string, now time.Time) *ast.Object {
 	saveResults := make([]*ast.Object, len(records))
 	for i, record := range records {
 		var query string
@@ -99,15 +207,19 @@ func (d *databaseDriver) Execute(dmlType string, sObjectType string, records []*
 		case "insert":
 			fields := []string{}
 			values := []string{}
-			rand.Seed(time.Now().UnixNano())
-			record.InstanceFields.Set("Id", NewString(string(rand.Int())))
+			record.InstanceFields.Set("Id", NewString(newRecordId()))
+			audits := auditFields(record.ClassType, true, now)
 			for name, field := range record.InstanceFields.All() {
 				// TODO: convert type
-				if field == Null {
+				if _, ok := audits[name]; ok || field == Null {
 					continue
 				}
 				fields = append(fields, name)
-				values = append(values, fmt.Sprintf("'%s'", field.StringValue()))
+				values = append(values, fmt.Sprintf("'%s'", sqlValue(field)))
+			}
+			for name, value := range audits {
+				fields = append(fields, name)
+				values = append(values, fmt.Sprintf("'%s'", value))
 			}
 			query = fmt.Sprintf(
 				"INSERT INTO %s(%s) VALUES (%s)",
@@ -117,12 +229,19 @@ func (d *databaseDriver) Execute(dmlType string, sObjectType string, records []*
 			)
 		case "update":
 			updateFields := []string{}
+			audits := auditFields(record.ClassType, false, now)
 			for name, field := range record.InstanceFields.All() {
 				// TODO: convert type
-				if field == Null {
+				if name == "CreatedDate" || field == Null {
 					continue
 				}
-				updateFields = append(updateFields, fmt.Sprintf("%s = '%s'", name, field.StringValue()))
+				if _, ok := audits[name]; ok {
+					continue
+				}
+				updateFields = append(updateFields, fmt.Sprintf("%s = '%s'", name, sqlValue(field)))
+			}
+			for name, value := range audits {
+				updateFields = append(updateFields, fmt.Sprintf("%s = '%s'", name, value))
 			}
 			id, ok := record.InstanceFields.Get("Id")
 			if !ok {
@@ -169,6 +288,14 @@ func (d *databaseDriver) Execute(dmlType string, sObjectType string, records []*
 	return listObject
 }
 
+var recordIdSequence = rand.New(rand.NewSource(time.Now().UnixNano())).Int63n(1e9)
+
+// newRecordId returns unique 15 characters id for inserted record
+func newRecordId() string {
+	recordIdSequence++
+	return fmt.Sprintf("000%012d", recordIdSequence)
+}
+
 func (d *databaseDriver) ExecuteRaw(query string, args ...interface{}) error {
 	_, err := d.db.Exec(query, args...)
 	return err
@@ -0,0 +1,45 @@
+package builtin
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+func TestColumnValue(t *testing.T) {
+	classType := &ast.ClassType{
+		Name:           "Account",
+		InstanceFields: ast.NewFieldMap(),
+	}
+	classType.InstanceFields.Set("Name", &ast.Field{Type: StringType})
+	classType.InstanceFields.Set("NumberOfEmployees", &ast.Field{Type: IntegerType})
+
+	testCases := []struct {
+		ClassType *ast.ClassType
+		FieldName string
+		Column    sql.NullString
+		Expected  *ast.Object
+	}{
+		{classType, "Name", sql.NullString{String: "foo", Valid: true}, NewString("foo")},
+		{classType, "NumberOfEmployees", sql.NullString{String: "10", Valid: true}, NewInteger(10)},
+		{classType, "NumberOfEmployees", sql.NullString{String: "", Valid: false}, Null},
+		{classType, "Name", sql.NullString{String: "", Valid: false}, Null},
+		// columns which can not be converted are strings
+		{classType, "NumberOfEmployees", sql.NullString{String: "ten", Valid: true}, NewString("ten")},
+		{classType, "Unknown", sql.NullString{String: "1", Valid: true}, NewString("1")},
+		{nil, "Name", sql.NullString{String: "1", Valid: true}, NewString("1")},
+	}
+	for i, testCase := range testCases {
+		actual := columnValue(testCase.ClassType, testCase.FieldName, testCase.Column)
+		if testCase.Expected == Null {
+			if actual != Null {
+				t.Errorf("%d: expected null, actual %s", i, String(actual))
+			}
+			continue
+		}
+		if actual.ClassType != testCase.Expected.ClassType || actual.Value() != testCase.Expected.Value() {
+			t.Errorf("%d: expected %s, actual %s", i, String(testCase.Expected), String(actual))
+		}
+	}
+}
@@ -0,0 +1,684 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// Decimal is arbitrary-precision signed decimal number as java.math.BigDecimal,
+// the value is unscaled * 10^-scale
+type Decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+// Rounding modes of System.RoundingMode
+const (
+	RoundingUp          = "UP"
+	RoundingDown        = "DOWN"
+	RoundingCeiling     = "CEILING"
+	RoundingFloor       = "FLOOR"
+	RoundingHalfUp      = "HALF_UP"
+	RoundingHalfDown    = "HALF_DOWN"
+	RoundingHalfEven    = "HALF_EVEN"
+	RoundingUnnecessary = "UNNECESSARY"
+)
+
+// decimalDivisionPrecision is number of significant digits of non-terminating quotient of / operator,
+// same as java.math.MathContext.DECIMAL128
+const decimalDivisionPrecision = 34
+
+var ErrDivideByZero = errors.New("Divide by 0")
+var ErrRoundingNecessary = errors.New("Rounding necessary")
+
+var decimalPattern = regexp.MustCompile(`^([+-]?)(\d*)(?:\.(\d*))?(?:[eE]([+-]?\d+))?$`)
+
+var bigTen = big.NewInt(10)
+
+func NewDecimalValue(unscaled *big.Int, scale int) *Decimal {
+	return &Decimal{unscaled: unscaled, scale: scale}
+}
+
+// ParseDecimal parses decimal string such as 12.30, -0.5 or 1.2E+3, the scale of the string is kept
+func ParseDecimal(s string) (*Decimal, error) {
+	m := decimalPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || m[2]+m[3] == "" {
+		return nil, fmt.Errorf("Invalid decimal: %s", s)
+	}
+	unscaled, _ := new(big.Int).SetString(m[2]+m[3], 10)
+	if m[1] == "-" {
+		unscaled.Neg(unscaled)
+	}
+	scale := len(m[3])
+	if m[4] != "" {
+		exp, err := strconv.Atoi(m[4])
+		if err != nil {
+			return nil, fmt.Errorf("Invalid decimal: %s", s)
+		}
+		scale -= exp
+	}
+	return NewDecimalValue(unscaled, scale), nil
+}
+
+func DecimalFromInt(i int) *Decimal {
+	return NewDecimalValue(big.NewInt(int64(i)), 0)
+}
+
+// DecimalFromFloat converts Double to Decimal with the shortest representation of the value, such as 0.1.
+// Infinity and NaN have no Decimal representation.
+func DecimalFromFloat(f float64) (*Decimal, error) {
+	switch {
+	case math.IsNaN(f):
+		return nil, errors.New("Invalid decimal: NaN")
+	case math.IsInf(f, 1):
+		return nil, errors.New("Invalid decimal: Infinity")
+	case math.IsInf(f, -1):
+		return nil, errors.New("Invalid decimal: -Infinity")
+	}
+	return ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// rescale returns unscaled value of d on the larger scale
+func (d *Decimal) rescale(scale int) *big.Int {
+	if scale == d.scale {
+		return d.unscaled
+	}
+	return new(big.Int).Mul(d.unscaled, pow10(scale-d.scale))
+}
+
+func (d *Decimal) Scale() int {
+	return d.scale
+}
+
+func (d *Decimal) Sign() int {
+	return d.unscaled.Sign()
+}
+
+// Precision returns number of digits of the unscaled value, precision of zero is 1
+func (d *Decimal) Precision() int {
+	if d.unscaled.Sign() == 0 {
+		return 1
+	}
+	return len(new(big.Int).Abs(d.unscaled).String())
+}
+
+func (d *Decimal) Cmp(other *Decimal) int {
+	scale := maxInt(d.scale, other.scale)
+	return d.rescale(scale).Cmp(other.rescale(scale))
+}
+
+func (d *Decimal) Add(other *Decimal) *Decimal {
+	scale := maxInt(d.scale, other.scale)
+	return NewDecimalValue(new(big.Int).Add(d.rescale(scale), other.rescale(scale)), scale)
+}
+
+func (d *Decimal) Sub(other *Decimal) *Decimal {
+	scale := maxInt(d.scale, other.scale)
+	return NewDecimalValue(new(big.Int).Sub(d.rescale(scale), other.rescale(scale)), scale)
+}
+
+func (d *Decimal) Mul(other *Decimal) *Decimal {
+	return NewDecimalValue(new(big.Int).Mul(d.unscaled, other.unscaled), d.scale+other.scale)
+}
+
+func (d *Decimal) Neg() *Decimal {
+	return NewDecimalValue(new(big.Int).Neg(d.unscaled), d.scale)
+}
+
+func (d *Decimal) Abs() *Decimal {
+	return NewDecimalValue(new(big.Int).Abs(d.unscaled), d.scale)
+}
+
+func (d *Decimal) Pow(n int) *Decimal {
+	return NewDecimalValue(new(big.Int).Exp(d.unscaled, big.NewInt(int64(n)), nil), d.scale*n)
+}
+
+// Quo divides d by the divisor, the quotient has the scale and is rounded by the mode
+func (d *Decimal) Quo(divisor *Decimal, scale int, mode string) (*Decimal, error) {
+	if divisor.Sign() == 0 {
+		return nil, ErrDivideByZero
+	}
+	// quotient * 10^scale = d.unscaled * 10^(scale + divisor.scale - d.scale) / divisor.unscaled
+	numerator := d.unscaled
+	denominator := divisor.unscaled
+	if exp := scale + divisor.scale - d.scale; exp >= 0 {
+		numerator = new(big.Int).Mul(numerator, pow10(exp))
+	} else {
+		denominator = new(big.Int).Mul(denominator, pow10(-exp))
+	}
+	q, _, err := divRound(numerator, denominator, mode)
+	if err != nil {
+		return nil, err
+	}
+	return NewDecimalValue(q, scale), nil
+}
+
+// Divide is quotient of / operator, it is exact if the quotient terminates,
+// otherwise it is rounded to 34 significant digits by HALF_EVEN
+func (d *Decimal) Divide(divisor *Decimal) (*Decimal, error) {
+	if divisor.Sign() == 0 {
+		return nil, ErrDivideByZero
+	}
+	if d.Sign() == 0 {
+		return NewDecimalValue(new(big.Int), maxInt(d.scale-divisor.scale, 0)), nil
+	}
+	// estimate the scale which makes the quotient have the precision digits
+	integerDigits := (d.Precision() - d.scale) - (divisor.Precision() - divisor.scale) + 1
+	scale := decimalDivisionPrecision - integerDigits
+	q, err := d.Quo(divisor, scale, RoundingHalfEven)
+	if err != nil {
+		return nil, err
+	}
+	// the estimation may be different from the precision by one digit
+	if diff := q.Precision() - decimalDivisionPrecision; diff > 0 || (diff < 0 && q.Mul(divisor).Cmp(d) != 0) {
+		scale -= diff
+		q, err = d.Quo(divisor, scale, RoundingHalfEven)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// exact quotient has the scale as close to the preferred scale as possible
+	preferredScale := d.scale - divisor.scale
+	if q.Mul(divisor).Cmp(d) == 0 {
+		for q.scale > preferredScale && q.scale > 0 {
+			quo, rem := new(big.Int).QuoRem(q.unscaled, bigTen, new(big.Int))
+			if rem.Sign() != 0 {
+				break
+			}
+			q = NewDecimalValue(quo, q.scale-1)
+		}
+	}
+	return q, nil
+}
+
+// SetScale returns the decimal which has the scale, it is rounded by the mode if digits are dropped
+func (d *Decimal) SetScale(scale int, mode string) (*Decimal, error) {
+	if scale >= d.scale {
+		return NewDecimalValue(d.rescale(scale), scale), nil
+	}
+	q, _, err := divRound(d.unscaled, pow10(d.scale-scale), mode)
+	if err != nil {
+		return nil, err
+	}
+	return NewDecimalValue(q, scale), nil
+}
+
+// StripTrailingZeros removes zeros of the fraction part, the scale does not become negative
+func (d *Decimal) StripTrailingZeros() *Decimal {
+	unscaled := d.unscaled
+	scale := d.scale
+	for scale > 0 {
+		quo, rem := new(big.Int).QuoRem(unscaled, bigTen, new(big.Int))
+		if rem.Sign() != 0 {
+			break
+		}
+		unscaled = quo
+		scale--
+	}
+	return NewDecimalValue(unscaled, scale)
+}
+
+// String returns plain string representation without exponent, such as 1234.50
+func (d *Decimal) String() string {
+	if d.scale <= 0 {
+		s := d.unscaled.String()
+		if d.unscaled.Sign() == 0 {
+			return s
+		}
+		return s + strings.Repeat("0", -d.scale)
+	}
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if len(digits) <= d.scale {
+		digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+	}
+	point := len(digits) - d.scale
+	s := digits[:point] + "." + digits[point:]
+	if d.unscaled.Sign() < 0 {
+		return "-" + s
+	}
+	return s
+}
+
+// Format returns string formatted by the locale of en_US, with grouping and at most 3 fraction digits
+func (d *Decimal) Format() string {
+	rounded := d
+	if d.scale > 3 {
+		rounded, _ = d.SetScale(3, RoundingHalfEven)
+	}
+	s := rounded.StripTrailingZeros().String()
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	integerPart, fractionPart := s, ""
+	if i := strings.Index(s, "."); i >= 0 {
+		integerPart, fractionPart = s[:i], s[i:]
+	}
+	groups := []string{}
+	for len(integerPart) > 3 {
+		groups = append([]string{integerPart[len(integerPart)-3:]}, groups...)
+		integerPart = integerPart[:len(integerPart)-3]
+	}
+	groups = append([]string{integerPart}, groups...)
+	return sign + strings.Join(groups, ",") + fractionPart
+}
+
+func (d *Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Int returns integer part of the decimal, the fraction is truncated
+func (d *Decimal) Int() int {
+	if d.scale <= 0 {
+		return int(d.rescale(0).Int64())
+	}
+	return int(new(big.Int).Quo(d.unscaled, pow10(d.scale)).Int64())
+}
+
+// divRound divides n by d, the quotient is rounded by the mode.
+// exact is false if the remainder is not zero.
+func divRound(n, d *big.Int, mode string) (q *big.Int, exact bool, err error) {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q, true, nil
+	}
+	sign := n.Sign() * d.Sign()
+	// compare the remainder with the half of the divisor
+	half := new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2)).Cmp(new(big.Int).Abs(d))
+	increment := false
+	switch mode {
+	case RoundingUp:
+		increment = true
+	case RoundingDown:
+	case RoundingCeiling:
+		increment = sign > 0
+	case RoundingFloor:
+		increment = sign < 0
+	case RoundingHalfUp:
+		increment = half >= 0
+	case RoundingHalfDown:
+		increment = half > 0
+	case RoundingHalfEven:
+		increment = half > 0 || (half == 0 && q.Bit(0) == 1)
+	case RoundingUnnecessary:
+		return nil, false, ErrRoundingNecessary
+	default:
+		return nil, false, fmt.Errorf("unknown rounding mode: %s", mode)
+	}
+	if increment {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q, false, nil
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+var DecimalType = &ast.ClassType{
+	Name:            "Decimal",
+	InstanceMethods: ast.NewMethodMap(),
+	StaticMethods:   ast.NewMethodMap(),
+	ToString: func(o *ast.Object) string {
+		return o.Value().(*Decimal).String()
+	},
+}
+
+var decimalTypeParameter = &ast.Parameter{
+	Type: DecimalType,
+	Name: "_",
+}
+
+var RoundingModeType = NewEnumType("RoundingMode", []string{
+	RoundingCeiling,
+	RoundingDown,
+	RoundingFloor,
+	RoundingHalfDown,
+	RoundingHalfEven,
+	RoundingHalfUp,
+	RoundingUp,
+	RoundingUnnecessary,
+})
+
+var roundingModeTypeParameter = &ast.Parameter{
+	Type: RoundingModeType,
+	Name: "_",
+}
+
+// DecimalValue returns the value of Decimal object
+func DecimalValue(o *ast.Object) *Decimal {
+	return o.Value().(*Decimal)
+}
+
+// ToDecimal converts Integer, Long, Double or Decimal object to Decimal, TypeException is returned for infinity and NaN of Double
+func ToDecimal(o *ast.Object) (*Decimal, error) {
+	switch v := o.Value().(type) {
+	case *Decimal:
+		return v, nil
+	case int:
+		return DecimalFromInt(v), nil
+	case float64:
+		d, err := DecimalFromFloat(v)
+		if err != nil {
+			return nil, NewExceptionError(NewException(TypeExceptionType, err.Error()))
+		}
+		return d, nil
+	}
+	panic(fmt.Sprintf("%s is not a number", o.ClassType.Name))
+}
+
+// CompareDecimal compares the numbers either of which is Decimal, infinity and NaN of Double are compared as Double
+func CompareDecimal(o, other *ast.Object) int {
+	l, lErr := ToDecimal(o)
+	r, rErr := ToDecimal(other)
+	if lErr != nil || rErr != nil {
+		return compareFloat(numberFloat(o), numberFloat(other))
+	}
+	return l.Cmp(r)
+}
+
+// numberFloat converts Integer, Long, Double or Decimal object to float64
+func numberFloat(o *ast.Object) float64 {
+	switch v := o.Value().(type) {
+	case *Decimal:
+		return v.Float64()
+	case int:
+		return float64(v)
+	}
+	return o.DoubleValue()
+}
+
+// raiseDecimalError converts error of the decimal operation to MathException
+func raiseDecimalError(err error) *ast.Object {
+	return Raise(MathExceptionType, err.Error())
+}
+
+func decimalResult(d *Decimal, err error) interface{} {
+	if err != nil {
+		return raiseDecimalError(err)
+	}
+	return NewDecimal(d)
+}
+
+func init() {
+	instanceMethods := DecimalType.InstanceMethods
+	instanceMethods.Set(
+		"abs",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"abs",
+				DecimalType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewDecimal(DecimalValue(this).Abs())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"divide",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"divide",
+				DecimalType,
+				[]*ast.Parameter{decimalTypeParameter, IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return decimalResult(DecimalValue(this).Quo(DecimalValue(params[0]), params[1].IntegerValue(), RoundingHalfUp))
+				},
+			),
+			ast.CreateMethod(
+				"divide",
+				DecimalType,
+				[]*ast.Parameter{decimalTypeParameter, IntegerTypeParameter, roundingModeTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return decimalResult(DecimalValue(this).Quo(DecimalValue(params[0]), params[1].IntegerValue(), params[2].StringValue()))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"doubleValue",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"doubleValue",
+				DoubleType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewDouble(DecimalValue(this).Float64())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"equals",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"equals",
+				BooleanType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					other := params[0]
+					if other == Null || !IsNumeric(other.ClassType) {
+						return NewBoolean(false)
+					}
+					d, err := ToDecimal(other)
+					if err != nil {
+						return NewBoolean(false)
+					}
+					return NewBoolean(DecimalValue(this).Cmp(d) == 0)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"format",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"format",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(DecimalValue(this).Format())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"intValue",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"intValue",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(DecimalValue(this).Int())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"longValue",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"longValue",
+				LongType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewLong(DecimalValue(this).Int())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"pow",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"pow",
+				DecimalType,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					n := params[0].IntegerValue()
+					if n < 0 || n > 32767 {
+						return Raise(MathExceptionType, "Invalid operation")
+					}
+					return NewDecimal(DecimalValue(this).Pow(n))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"precision",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"precision",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(DecimalValue(this).Precision())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"round",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"round",
+				LongType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					rounded, _ := DecimalValue(this).SetScale(0, RoundingHalfEven)
+					return NewLong(rounded.Int())
+				},
+			),
+			ast.CreateMethod(
+				"round",
+				LongType,
+				[]*ast.Parameter{roundingModeTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					rounded, err := DecimalValue(this).SetScale(0, params[0].StringValue())
+					if err != nil {
+						return raiseDecimalError(err)
+					}
+					return NewLong(rounded.Int())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"scale",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"scale",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(DecimalValue(this).Scale())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setScale",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setScale",
+				DecimalType,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return decimalResult(DecimalValue(this).SetScale(params[0].IntegerValue(), RoundingHalfEven))
+				},
+			),
+			ast.CreateMethod(
+				"setScale",
+				DecimalType,
+				[]*ast.Parameter{IntegerTypeParameter, roundingModeTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return decimalResult(DecimalValue(this).SetScale(params[0].IntegerValue(), params[1].StringValue()))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"stripTrailingZeros",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"stripTrailingZeros",
+				DecimalType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewDecimal(DecimalValue(this).StripTrailingZeros())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"toPlainString",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"toPlainString",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(DecimalValue(this).String())
+				},
+			),
+		},
+	)
+
+	DecimalType.StaticMethods.Set(
+		"valueOf",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"valueOf",
+				DecimalType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					d, err := ParseDecimal(params[0].StringValue())
+					if err != nil {
+						return Raise(TypeExceptionType, "Invalid decimal: "+params[0].StringValue())
+					}
+					return NewDecimal(d)
+				},
+			),
+			ast.CreateMethod(
+				"valueOf",
+				DecimalType,
+				[]*ast.Parameter{doubleTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					d, err := ToDecimal(params[0])
+					if err != nil {
+						return err
+					}
+					return NewDecimal(d)
+				},
+			),
+			ast.CreateMethod(
+				"valueOf",
+				DecimalType,
+				[]*ast.Parameter{longTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewDecimal(DecimalFromInt(params[0].IntegerValue()))
+				},
+			),
+		},
+	)
+
+	primitiveClassMap.Set("Decimal", DecimalType)
+	systemNameSpace.Set("RoundingMode", RoundingModeType)
+}
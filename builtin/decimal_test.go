@@ -0,0 +1,214 @@
+package builtin
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+func mustParseDecimal(t *testing.T, s string) *Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	return d
+}
+
+func TestParseDecimal(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+		Scale    int
+	}{
+		{"12.30", "12.30", 2},
+		{"-0.5", "-0.5", 1},
+		{"+.5", "0.5", 1},
+		{"1.2E+3", "1200", -2},
+		{"1.25e-2", "0.0125", 4},
+		{"100", "100", 0},
+		{" 7 ", "7", 0},
+	}
+	for i, testCase := range testCases {
+		d := mustParseDecimal(t, testCase.Input)
+		if d.String() != testCase.Expected || d.Scale() != testCase.Scale {
+			t.Errorf("%d: expected %s (scale %d), actual %s (scale %d)", i, testCase.Expected, testCase.Scale, d.String(), d.Scale())
+		}
+	}
+	for _, input := range []string{"", ".", "1.2.3", "abc", "1e"} {
+		if _, err := ParseDecimal(input); err == nil {
+			t.Errorf("expected error: %q", input)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	testCases := []struct {
+		Op       string
+		Left     string
+		Right    string
+		Expected string
+	}{
+		{"+", "1.10", "2.205", "3.305"},
+		{"-", "1", "0.25", "0.75"},
+		{"*", "1.5", "2.0", "3.00"},
+		{"/", "10", "4", "2.5"},
+		{"/", "6", "2", "3"},
+		{"/", "1.00", "8", "0.125"},
+		{"/", "1", "3", "0.3333333333333333333333333333333333"},
+		{"/", "2", "3", "0.6666666666666666666666666666666667"},
+		{"/", "-1", "3", "-0.3333333333333333333333333333333333"},
+		{"/", "0.00", "5", "0.00"},
+	}
+	for i, testCase := range testCases {
+		l := mustParseDecimal(t, testCase.Left)
+		r := mustParseDecimal(t, testCase.Right)
+		var actual *Decimal
+		switch testCase.Op {
+		case "+":
+			actual = l.Add(r)
+		case "-":
+			actual = l.Sub(r)
+		case "*":
+			actual = l.Mul(r)
+		case "/":
+			var err error
+			actual, err = l.Divide(r)
+			if err != nil {
+				t.Errorf("%d: unexpected error: %s", i, err.Error())
+				continue
+			}
+		}
+		if actual.String() != testCase.Expected {
+			t.Errorf("%d: %s %s %s: expected %s, actual %s", i, testCase.Left, testCase.Op, testCase.Right, testCase.Expected, actual.String())
+		}
+	}
+	if _, err := DecimalFromInt(1).Divide(DecimalFromInt(0)); err != ErrDivideByZero {
+		t.Errorf("expected divide by zero error, actual %v", err)
+	}
+}
+
+// same as the table of java.math.RoundingMode
+func TestDecimalSetScale(t *testing.T) {
+	inputs := []string{"5.5", "2.5", "1.6", "1.1", "1.0", "-1.0", "-1.1", "-1.6", "-2.5", "-5.5"}
+	testCases := []struct {
+		Mode     string
+		Expected []string
+	}{
+		{RoundingUp, []string{"6", "3", "2", "2", "1", "-1", "-2", "-2", "-3", "-6"}},
+		{RoundingDown, []string{"5", "2", "1", "1", "1", "-1", "-1", "-1", "-2", "-5"}},
+		{RoundingCeiling, []string{"6", "3", "2", "2", "1", "-1", "-1", "-1", "-2", "-5"}},
+		{RoundingFloor, []string{"5", "2", "1", "1", "1", "-1", "-2", "-2", "-3", "-6"}},
+		{RoundingHalfUp, []string{"6", "3", "2", "1", "1", "-1", "-1", "-2", "-3", "-6"}},
+		{RoundingHalfDown, []string{"5", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-5"}},
+		{RoundingHalfEven, []string{"6", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-6"}},
+	}
+	for _, testCase := range testCases {
+		for i, input := range inputs {
+			actual, err := mustParseDecimal(t, input).SetScale(0, testCase.Mode)
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %s", testCase.Mode, input, err.Error())
+				continue
+			}
+			if actual.String() != testCase.Expected[i] {
+				t.Errorf("%s %s: expected %s, actual %s", testCase.Mode, input, testCase.Expected[i], actual.String())
+			}
+		}
+	}
+
+	if _, err := mustParseDecimal(t, "1.1").SetScale(0, RoundingUnnecessary); err != ErrRoundingNecessary {
+		t.Errorf("expected rounding necessary error, actual %v", err)
+	}
+	if actual, err := mustParseDecimal(t, "1.0").SetScale(0, RoundingUnnecessary); err != nil || actual.String() != "1" {
+		t.Errorf("expected 1, actual %v, %v", actual, err)
+	}
+	if actual, _ := mustParseDecimal(t, "1.5").SetScale(3, RoundingHalfUp); actual.String() != "1.500" {
+		t.Errorf("expected 1.500, actual %s", actual.String())
+	}
+}
+
+func TestDecimalFormat(t *testing.T) {
+	testCases := []struct {
+		Input        string
+		String       string
+		Format       string
+		StripZeros   string
+		IntegerValue int
+	}{
+		{"1234567.891", "1234567.891", "1,234,567.891", "1234567.891", 1234567},
+		{"1234.5678", "1234.5678", "1,234.568", "1234.5678", 1234},
+		{"-1000.00", "-1000.00", "-1,000", "-1000", -1000},
+		{"0.000", "0.000", "0", "0", 0},
+		{"1E+3", "1000", "1,000", "1000", 1000},
+		{"-0.5", "-0.5", "-0.5", "-0.5", 0},
+	}
+	for i, testCase := range testCases {
+		d := mustParseDecimal(t, testCase.Input)
+		if d.String() != testCase.String {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.String, d.String())
+		}
+		if d.Format() != testCase.Format {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Format, d.Format())
+		}
+		if d.StripTrailingZeros().String() != testCase.StripZeros {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.StripZeros, d.StripTrailingZeros().String())
+		}
+		if d.Int() != testCase.IntegerValue {
+			t.Errorf("%d: expected %d, actual %d", i, testCase.IntegerValue, d.Int())
+		}
+	}
+	a, _ := DecimalFromFloat(0.1)
+	b, _ := DecimalFromFloat(0.2)
+	if f := a.Add(b); f.String() != "0.3" {
+		t.Errorf("expected 0.3, actual %s", f.String())
+	}
+}
+
+func TestToDecimal(t *testing.T) {
+	testCases := []struct {
+		Value    *ast.Object
+		Expected string
+		Error    string
+	}{
+		{NewInteger(-3), "-3", ""},
+		{NewDouble(1.25), "1.25", ""},
+		{NewDouble(1e20), "100000000000000000000", ""},
+		{NewDouble(math.Inf(1)), "", "TypeException: Invalid decimal: Infinity"},
+		{NewDouble(math.Inf(-1)), "", "TypeException: Invalid decimal: -Infinity"},
+		{NewDouble(math.NaN()), "", "TypeException: Invalid decimal: NaN"},
+	}
+	for i, testCase := range testCases {
+		d, err := ToDecimal(testCase.Value)
+		if testCase.Error != "" {
+			if err == nil || err.Error() != testCase.Error {
+				t.Errorf("%d: expected error %s, actual %v", i, testCase.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error %s", i, err.Error())
+			continue
+		}
+		if d.String() != testCase.Expected {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Expected, d.String())
+		}
+	}
+}
+
+func TestCompareDecimal(t *testing.T) {
+	testCases := []struct {
+		Left     *ast.Object
+		Right    *ast.Object
+		Expected int
+	}{
+		{NewDecimal(DecimalFromInt(1)), NewDouble(0.5), 1},
+		{NewDouble(0.1), NewDecimal(mustParseDecimal(t, "0.1")), 0},
+		{NewDouble(math.Inf(1)), NewDecimal(DecimalFromInt(1)), 1},
+		{NewDecimal(DecimalFromInt(1)), NewDouble(math.Inf(-1)), 1},
+	}
+	for i, testCase := range testCases {
+		if actual := CompareDecimal(testCase.Left, testCase.Right); actual != testCase.Expected {
+			t.Errorf("%d: expected %d, actual %d", i, testCase.Expected, actual)
+		}
+	}
+}
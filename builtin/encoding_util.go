@@ -2,12 +2,69 @@ package builtin
 
 import (
 	"encoding/base64"
-
-	"net/url"
+	"encoding/hex"
+	"errors"
+	"strings"
 
 	"github.com/tzmfreedom/land/ast"
 )
 
+// urlEncode encodes the string as application/x-www-form-urlencoded as java.net.URLEncoder does
+func urlEncode(s string, c *charset) string {
+	var buf strings.Builder
+	for _, b := range c.encode(s) {
+		switch {
+		case 'a' <= b && b <= 'z', 'A' <= b && b <= 'Z', '0' <= b && b <= '9', b == '.', b == '-', b == '*', b == '_':
+			buf.WriteByte(b)
+		case b == ' ':
+			buf.WriteByte('+')
+		default:
+			buf.WriteString("%" + strings.ToUpper(hex.EncodeToString([]byte{b})))
+		}
+	}
+	return buf.String()
+}
+
+// urlDecode decodes application/x-www-form-urlencoded string as java.net.URLDecoder does
+func urlDecode(s string, c *charset) (string, error) {
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '+':
+			buf.WriteByte(' ')
+		case '%':
+			// consecutive escapes are decoded together as they may be a multibyte character
+			encoded := []byte{}
+			for ; i < len(s) && s[i] == '%'; i += 3 {
+				if i+3 > len(s) {
+					return "", errors.New("URLDecoder: Incomplete trailing escape (%) pattern")
+				}
+				b, err := hex.DecodeString(s[i+1 : i+3])
+				if err != nil {
+					return "", errors.New("URLDecoder: Illegal hex characters in escape (%) pattern")
+				}
+				encoded = append(encoded, b[0])
+			}
+			i--
+			buf.WriteString(c.decode(encoded))
+		default:
+			buf.WriteByte(s[i])
+		}
+	}
+	return buf.String(), nil
+}
+
+// base64Decode decodes base64 string leniently, whitespaces and missing paddings are allowed
+func base64Decode(s string) ([]byte, error) {
+	s = strings.Map(func(r rune) rune {
+		if r == ' ' || r == '\t' || r == '\r' || r == '\n' {
+			return -1
+		}
+		return r
+	}, s)
+	return base64.RawStdEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
 func init() {
 	instanceMethods := ast.NewMethodMap()
 	staticMethods := ast.NewMethodMap()
@@ -26,12 +83,14 @@ func init() {
 				BlobType,
 				[]*ast.Parameter{stringTypeParameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					msg := params[0].StringValue()
-					encoded, err := base64.StdEncoding.DecodeString(msg)
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
+					decoded, err := base64Decode(params[0].StringValue())
 					if err != nil {
-						panic(err) // TODO: impl
+						return Raise(StringExceptionType, "Unable to convert the input string to a Blob: "+err.Error())
 					}
-					return NewBlob(encoded)
+					return NewBlob(decoded)
 				},
 			),
 		},
@@ -45,6 +104,9 @@ func init() {
 				StringType,
 				[]*ast.Parameter{BlobTypeParameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("Blob")
+					}
 					msg := params[0].Value().([]byte)
 					encoded := base64.StdEncoding.EncodeToString(msg)
 					return NewString(encoded)
@@ -53,6 +115,44 @@ func init() {
 		},
 	)
 
+	staticMethods.Set(
+		"convertToHex",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"convertToHex",
+				StringType,
+				[]*ast.Parameter{BlobTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("Blob")
+					}
+					return NewString(hex.EncodeToString(params[0].Value().([]byte)))
+				},
+			),
+		},
+	)
+
+	staticMethods.Set(
+		"convertFromHex",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"convertFromHex",
+				BlobType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
+					decoded, err := hex.DecodeString(params[0].StringValue())
+					if err != nil {
+						return Raise(InvalidParameterValueExceptionType, "Invalid hex string: "+params[0].StringValue())
+					}
+					return NewBlob(decoded)
+				},
+			),
+		},
+	)
+
 	staticMethods.Set(
 		"urlEncode",
 		[]*ast.Method{
@@ -64,9 +164,42 @@ func init() {
 					stringTypeParameter,
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					inputString := params[0].StringValue()
-					// encodingScheme := params[1].StringValue()
-					return NewString(url.QueryEscape(inputString))
+					if params[0] == Null || params[1] == Null {
+						return NewNullPointerException("String")
+					}
+					c, err := lookupCharset(params[1].StringValue())
+					if err != nil {
+						return Raise(InvalidParameterValueExceptionType, err.Error())
+					}
+					return NewString(urlEncode(params[0].StringValue(), c))
+				},
+			),
+		},
+	)
+
+	staticMethods.Set(
+		"urlDecode",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"urlDecode",
+				StringType,
+				[]*ast.Parameter{
+					stringTypeParameter,
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null || params[1] == Null {
+						return NewNullPointerException("String")
+					}
+					c, err := lookupCharset(params[1].StringValue())
+					if err != nil {
+						return Raise(InvalidParameterValueExceptionType, err.Error())
+					}
+					decoded, err := urlDecode(params[0].StringValue(), c)
+					if err != nil {
+						return Raise(StringExceptionType, err.Error())
+					}
+					return NewString(decoded)
 				},
 			),
 		},
@@ -0,0 +1,114 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+func TestUrlEncode(t *testing.T) {
+	testCases := []struct {
+		Src      string
+		Charset  string
+		Expected string
+	}{
+		{"a b&c=d", "UTF-8", "a+b%26c%3Dd"},
+		{"-_.*~", "UTF-8", "-_.*%7E"},
+		{"あ", "UTF-8", "%E3%81%82"},
+		{"é", "ISO-8859-1", "%E9"},
+		{"a", "UTF-16", "%FE%FF%00a"},
+	}
+	for i, testCase := range testCases {
+		c, _ := lookupCharset(testCase.Charset)
+		actual := urlEncode(testCase.Src, c)
+		if actual != testCase.Expected {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Expected, actual)
+		}
+	}
+}
+
+func TestUrlDecode(t *testing.T) {
+	testCases := []struct {
+		Src      string
+		Charset  string
+		Expected string
+		Error    string
+	}{
+		{"a+b%26c%3dd", "UTF-8", "a b&c=d", ""},
+		{"%E3%81%82%20x", "UTF-8", "あ x", ""},
+		{"%E9", "ISO-8859-1", "é", ""},
+		{"%E9", "UTF-8", "�", ""},
+		{"%FE%FF%00%61", "UTF-16", "a", ""},
+		{"a%2", "UTF-8", "", "URLDecoder: Incomplete trailing escape (%) pattern"},
+		{"a%41%", "UTF-8", "", "URLDecoder: Incomplete trailing escape (%) pattern"},
+		{"a%zz", "UTF-8", "", "URLDecoder: Illegal hex characters in escape (%) pattern"},
+		{"%4", "UTF-8", "", "URLDecoder: Incomplete trailing escape (%) pattern"},
+	}
+	for i, testCase := range testCases {
+		c, _ := lookupCharset(testCase.Charset)
+		actual, err := urlDecode(testCase.Src, c)
+		if testCase.Error != "" {
+			if err == nil || err.Error() != testCase.Error {
+				t.Errorf("%d: expected %s, actual %v", i, testCase.Error, err)
+			}
+			continue
+		}
+		if err != nil || actual != testCase.Expected {
+			t.Errorf("%d: expected %s, actual %s, %v", i, testCase.Expected, actual, err)
+		}
+	}
+}
+
+func TestBase64Decode(t *testing.T) {
+	testCases := []struct {
+		Src      string
+		Expected string
+		Error    bool
+	}{
+		{"aGVsbG8=", "hello", false},
+		{"aGVsbG8", "hello", false},
+		{"aGVs\r\nbG8=\n", "hello", false},
+		{" aGVs bG8 ", "hello", false},
+		{"aGk=", "hi", false},
+		{"", "", false},
+		{"aGVsbG8*", "", true},
+		{"a", "", true},
+	}
+	for i, testCase := range testCases {
+		actual, err := base64Decode(testCase.Src)
+		if testCase.Error {
+			if err == nil {
+				t.Errorf("%d: expected error, actual %s", i, actual)
+			}
+			continue
+		}
+		if err != nil || string(actual) != testCase.Expected {
+			t.Errorf("%d: expected %s, actual %s, %v", i, testCase.Expected, actual, err)
+		}
+	}
+}
+
+func TestConvertFromHex(t *testing.T) {
+	encodingUtilType, _ := primitiveClassMap.Get("EncodingUtil")
+	methods, _ := encodingUtilType.StaticMethods.Get("convertFromHex")
+	testCases := []struct {
+		Src      *ast.Object
+		Expected string
+	}{
+		{NewString("68656c6c6f"), "hello"},
+		{NewString("68656C6C6F"), "hello"},
+		{NewString(""), ""},
+		{NewString("6"), "InvalidParameterValueException: Invalid hex string: 6"},
+		{NewString("zz"), "InvalidParameterValueException: Invalid hex string: zz"},
+	}
+	for i, testCase := range testCases {
+		r := methods[0].NativeFunction(nil, []*ast.Object{testCase.Src}, map[string]interface{}{})
+		actual := resultString(r)
+		if obj, ok := r.(*ast.Object); ok && obj.ClassType == BlobType {
+			actual = string(obj.Value().([]byte))
+		}
+		if actual != testCase.Expected {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Expected, actual)
+		}
+	}
+}
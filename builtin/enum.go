@@ -0,0 +1,146 @@
+package builtin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// NewEnumType creates enum type with the constants, such as ApexPages.Severity or enum declared in apex code.
+// Each constant is a singleton object referred as static field, its value is the name of the constant.
+func NewEnumType(name string, constants []string) *ast.ClassType {
+	classType := &ast.ClassType{
+		Name:            name,
+		Modifiers:       []*ast.Modifier{ast.PublicModifier()},
+		Constructors:    []*ast.Method{},
+		InstanceFields:  ast.NewFieldMap(),
+		StaticFields:    ast.NewFieldMap(),
+		InstanceMethods: ast.NewMethodMap(),
+		StaticMethods:   ast.NewMethodMap(),
+		InnerClasses:    ast.NewClassMap(),
+		Extra:           map[string]interface{}{},
+		ToString: func(o *ast.Object) string {
+			return o.StringValue()
+		},
+	}
+	values := make([]*ast.Object, len(constants))
+	for i, constant := range constants {
+		value := ast.CreateObject(classType)
+		value.Extra["value"] = constant
+		value.Extra["ordinal"] = i
+		value.Final = true
+		values[i] = value
+		classType.StaticFields.Set(constant, &ast.Field{
+			Name: constant,
+			Modifiers: []*ast.Modifier{
+				ast.PublicModifier(),
+				{Name: "static"},
+				{Name: "final"},
+			},
+			Type: classType,
+		})
+	}
+	classType.Extra["enum_values"] = values
+
+	classType.InstanceMethods.Set(
+		"name",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"name",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.StringValue())
+				},
+			),
+		},
+	)
+	classType.InstanceMethods.Set(
+		"ordinal",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"ordinal",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(this.Extra["ordinal"].(int))
+				},
+			),
+		},
+	)
+	classType.InstanceMethods.Set(
+		"equals",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"equals",
+				BooleanType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					other := params[0]
+					return NewBoolean(other.ClassType == this.ClassType && other.StringValue() == this.StringValue())
+				},
+			),
+		},
+	)
+	classType.StaticMethods.Set(
+		"values",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"values",
+				CreateListType(classType),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := make([]*ast.Object, len(values))
+					copy(records, values)
+					return CreateListObject(CreateListType(classType), records)
+				},
+			),
+		},
+	)
+	classType.StaticMethods.Set(
+		"valueOf",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"valueOf",
+				classType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					value, ok := EnumValueOf(classType, params[0].StringValue())
+					if !ok {
+						return Raise(NoSuchElementExceptionType, fmt.Sprintf("No enum constant %s.%s", classType.Name, params[0].StringValue()))
+					}
+					return value
+				},
+			),
+		},
+	)
+	return classType
+}
+
+// IsEnum returns true if the type is created by NewEnumType
+func IsEnum(classType *ast.ClassType) bool {
+	if classType == nil || classType.Extra == nil {
+		return false
+	}
+	_, ok := classType.Extra["enum_values"]
+	return ok
+}
+
+// EnumValues returns constants of the enum in declared order
+func EnumValues(classType *ast.ClassType) []*ast.Object {
+	return classType.Extra["enum_values"].([]*ast.Object)
+}
+
+// EnumValueOf returns the constant of the enum by name, the name is case-insensitive as other identifiers
+func EnumValueOf(classType *ast.ClassType, name string) (*ast.Object, bool) {
+	if !IsEnum(classType) {
+		return nil, false
+	}
+	for _, value := range EnumValues(classType) {
+		if strings.EqualFold(value.StringValue(), name) {
+			return value, true
+		}
+	}
+	return nil, false
+}
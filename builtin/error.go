@@ -1,9 +1,13 @@
 package builtin
 
-import "github.com/tzmfreedom/land/ast"
+import (
+	"fmt"
+
+	"github.com/tzmfreedom/land/ast"
+)
 
 type NullPointerException struct {
-	name string
+	name     string
 	location *ast.Location
 }
 
@@ -11,10 +15,52 @@ func NewNullPointerException(name string) *NullPointerException {
 	return &NullPointerException{name: name}
 }
 
+// NewNullPointerExceptionAt creates NullPointerException of the name dereferenced at the location
+func NewNullPointerExceptionAt(name string, location *ast.Location) *NullPointerException {
+	return &NullPointerException{name: name, location: location}
+}
+
 func (e *NullPointerException) Error() string {
-	return "null pointer exception"
+	if e.location == nil {
+		return "null pointer exception"
+	}
+	return fmt.Sprintf("null pointer exception: %s at %d:%d", e.name, e.location.Line, e.location.Column)
 }
 
 func (e *NullPointerException) GetName() string {
 	return e.name
 }
+
+func (e *NullPointerException) GetLocation() *ast.Location {
+	return e.location
+}
+
+// ExceptionError propagates exception thrown from method call up to enclosing try statement
+type ExceptionError struct {
+	Exception *ast.Object
+}
+
+func NewExceptionError(exception *ast.Object) *ExceptionError {
+	return &ExceptionError{Exception: exception}
+}
+
+func (e *ExceptionError) Error() string {
+	message, ok := e.Exception.Extra["message"].(*ast.Object)
+	if !ok || message == Null {
+		return e.Exception.ClassType.Name
+	}
+	return fmt.Sprintf("%s: %s", e.Exception.ClassType.Name, message.StringValue())
+}
+
+// GetLocation returns the location where the exception is thrown, it is nil if unknown
+func (e *ExceptionError) GetLocation() *ast.Location {
+	location, _ := e.Exception.Extra["location"].(*ast.Location)
+	return location
+}
+
+// SetExceptionLocation records the location where the exception is thrown first, rethrowing keeps the location
+func SetExceptionLocation(exception *ast.Object, location *ast.Location) {
+	if _, ok := exception.Extra["location"]; !ok && location != nil {
+		exception.Extra["location"] = location
+	}
+}
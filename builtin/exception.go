@@ -6,7 +6,7 @@ import (
 	"github.com/tzmfreedom/land/ast"
 )
 
-var ExceptionType = &ast.ClassType{}
+var ExceptionType = &ast.ClassType{Name: "Exception"}
 
 var exceptionTypeParameter = &ast.Parameter{
 	Type: ExceptionType,
@@ -62,12 +62,64 @@ func createExceptionType() {
 	ExceptionType.StaticFields = ast.NewFieldMap()
 	ExceptionType.InstanceMethods = instanceMethods
 	ExceptionType.StaticMethods = ast.NewMethodMap()
-	ExceptionType.ToString = func(o *ast.Object) string {
-		return fmt.Sprintf("<%s> { message => %s } ", ExceptionType.Name, String(o.Extra["message"].(*ast.Object)))
+	ExceptionType.ToString = exceptionToString
+}
+
+func exceptionToString(o *ast.Object) string {
+	return fmt.Sprintf("<%s> { message => %s } ", o.ClassType.Name, String(o.Extra["message"].(*ast.Object)))
+}
+
+// CreateExceptionType creates builtin exception class which extends Exception, such as System.LimitException
+func CreateExceptionType(name string) *ast.ClassType {
+	return &ast.ClassType{
+		Name:            name,
+		Modifiers:       []*ast.Modifier{ast.PublicModifier()},
+		SuperClass:      ExceptionType,
+		Constructors:    []*ast.Method{},
+		InstanceFields:  ast.NewFieldMap(),
+		StaticFields:    ast.NewFieldMap(),
+		InstanceMethods: ast.NewMethodMap(),
+		StaticMethods:   ast.NewMethodMap(),
+		InnerClasses:    ast.NewClassMap(),
+		ToString:        exceptionToString,
 	}
 }
 
+// NewException creates exception object with the message
+func NewException(classType *ast.ClassType, message string) *ast.Object {
+	e := ast.CreateObject(classType)
+	e.Extra["message"] = NewString(message)
+	e.Extra["exception"] = Null
+	return e
+}
+
+// Raise returns raise object of new exception, native functions return it to throw exception
+func Raise(classType *ast.ClassType, message string) *ast.Object {
+	return CreateRaise(NewException(classType, message))
+}
+
+var AsyncExceptionType = CreateExceptionType("AsyncException")
+var QueryExceptionType = CreateExceptionType("QueryException")
+var StringExceptionType = CreateExceptionType("StringException")
+var NoSuchElementExceptionType = CreateExceptionType("NoSuchElementException")
+var ListExceptionType = CreateExceptionType("ListException")
+var MathExceptionType = CreateExceptionType("MathException")
+var TypeExceptionType = CreateExceptionType("TypeException")
+var InvalidParameterValueExceptionType = CreateExceptionType("InvalidParameterValueException")
+var SecurityExceptionType = CreateExceptionType("SecurityException")
+var CalloutExceptionType = CreateExceptionType("CalloutException")
+
 func init() {
 	createExceptionType()
 	primitiveClassMap.Set("Exception", ExceptionType)
+	primitiveClassMap.Set("AsyncException", AsyncExceptionType)
+	primitiveClassMap.Set("QueryException", QueryExceptionType)
+	primitiveClassMap.Set("StringException", StringExceptionType)
+	primitiveClassMap.Set("NoSuchElementException", NoSuchElementExceptionType)
+	primitiveClassMap.Set("ListException", ListExceptionType)
+	primitiveClassMap.Set("MathException", MathExceptionType)
+	primitiveClassMap.Set("TypeException", TypeExceptionType)
+	primitiveClassMap.Set("InvalidParameterValueException", InvalidParameterValueExceptionType)
+	primitiveClassMap.Set("SecurityException", SecurityExceptionType)
+	primitiveClassMap.Set("CalloutException", CalloutExceptionType)
 }
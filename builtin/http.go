@@ -1,11 +1,8 @@
 package builtin
 
 import (
-	"io/ioutil"
 	"net/http"
 
-	"strings"
-
 	"github.com/tzmfreedom/land/ast"
 )
 
@@ -38,30 +35,30 @@ func init() {
 					httpRequestTypeParameter,
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					request := params[0]
-					endpoint := request.Extra["endpoint"].(string)
-					method := request.Extra["method"].(string)
-					headers := request.Extra["headers"].(map[string]*ast.Object)
-					body := request.Extra["body"].(string)
-					req, err := http.NewRequest(method, endpoint, strings.NewReader(body))
-					if err != nil {
-						panic(err) // TODO: impl
+					if params[0] == Null {
+						return NewNullPointerException("HttpRequest")
 					}
-					for header, value := range headers {
-						req.Header.Add(header, value.StringValue())
+					if sandboxOf(extra) != nil {
+						return Raise(CalloutExceptionType, "Callouts are not allowed in the sandbox")
 					}
-					client := &http.Client{}
-					res, err := client.Do(req)
-					if err != nil {
-						panic(err) // TODO: impl
+					request := params[0]
+					header := http.Header{}
+					for key, value := range request.Extra["headers"].(map[string]*ast.Object) {
+						header.Set(key, value.StringValue())
 					}
-					buf, err := ioutil.ReadAll(res.Body)
+					res, err := callout(&calloutRequest{
+						Method:                request.Extra["method"].(string),
+						Endpoint:              request.Extra["endpoint"].(string),
+						Header:                header,
+						Body:                  []byte(request.Extra["body"].(string)),
+						Timeout:               request.Extra["timeout"].(int),
+						Compressed:            request.Extra["compressed"].(bool),
+						ClientCertificateName: request.Extra["clientCertificateName"].(string),
+					})
 					if err != nil {
-						panic(err)
+						return Raise(CalloutExceptionType, err.Error())
 					}
-					responseObj := ast.CreateObject(httpResponseType)
-					responseObj.Extra["body"] = string(buf)
-					return responseObj
+					return newHttpResponse(res)
 				},
 			),
 		},
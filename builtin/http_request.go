@@ -1,6 +1,7 @@
 package builtin
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/tzmfreedom/land/ast"
@@ -12,6 +13,18 @@ var httpRequestTypeParameter = &ast.Parameter{
 	Name: "_",
 }
 
+// httpRequestGetter creates the getter method which returns the value of Extra
+func httpRequestGetter(name string, returnType *ast.ClassType, key string, toObject func(interface{}) *ast.Object) *ast.Method {
+	return ast.CreateMethod(
+		name,
+		returnType,
+		[]*ast.Parameter{},
+		func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+			return toObject(this.Extra[key])
+		},
+	)
+}
+
 func init() {
 	instanceMethods := ast.NewMethodMap()
 	staticMethods := ast.NewMethodMap()
@@ -22,6 +35,12 @@ func init() {
 			[]*ast.Parameter{},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 				this.Extra["headers"] = map[string]*ast.Object{}
+				this.Extra["method"] = ""
+				this.Extra["endpoint"] = ""
+				this.Extra["body"] = ""
+				this.Extra["timeout"] = defaultCalloutTimeout
+				this.Extra["compressed"] = false
+				this.Extra["clientCertificateName"] = ""
 				return nil
 			},
 		),
@@ -50,6 +69,27 @@ func init() {
 			),
 		},
 	)
+	instanceMethods.Set(
+		"getHeader",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getHeader",
+				StringType,
+				[]*ast.Parameter{
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					key := params[0].StringValue()
+					for name, value := range this.Extra["headers"].(map[string]*ast.Object) {
+						if strings.EqualFold(name, key) {
+							return value
+						}
+					}
+					return Null
+				},
+			),
+		},
+	)
 	instanceMethods.Set(
 		"setMethod",
 		[]*ast.Method{
@@ -101,6 +141,65 @@ func init() {
 			),
 		},
 	)
+	instanceMethods.Set(
+		"setTimeout",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setTimeout",
+				nil,
+				[]*ast.Parameter{
+					IntegerTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					timeout := params[0].IntegerValue()
+					if timeout < 1 || timeout > maxCalloutTimeout {
+						return Raise(CalloutExceptionType, fmt.Sprintf("Timeout value must be between 1 and %d milliseconds: %d", maxCalloutTimeout, timeout))
+					}
+					this.Extra["timeout"] = timeout
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setCompressed",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setCompressed",
+				nil,
+				[]*ast.Parameter{
+					booleanTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["compressed"] = params[0].BoolValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setClientCertificateName",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setClientCertificateName",
+				nil,
+				[]*ast.Parameter{
+					stringTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["clientCertificateName"] = params[0].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	toString := func(v interface{}) *ast.Object { return NewString(v.(string)) }
+	instanceMethods.Set("getMethod", []*ast.Method{httpRequestGetter("getMethod", StringType, "method", toString)})
+	instanceMethods.Set("getEndpoint", []*ast.Method{httpRequestGetter("getEndpoint", StringType, "endpoint", toString)})
+	instanceMethods.Set("getBody", []*ast.Method{httpRequestGetter("getBody", StringType, "body", toString)})
+	instanceMethods.Set("getCompressed", []*ast.Method{
+		httpRequestGetter("getCompressed", BooleanType, "compressed", func(v interface{}) *ast.Object { return NewBoolean(v.(bool)) }),
+	})
 
 	primitiveClassMap.Set("HttpRequest", httpRequestType)
 }
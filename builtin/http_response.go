@@ -1,13 +1,27 @@
 package builtin
 
-import "github.com/tzmfreedom/land/ast"
+import (
+	"net/http"
+	"sort"
+
+	"github.com/tzmfreedom/land/ast"
+)
 
 var httpResponseType = &ast.ClassType{Name: "HttpResponse"}
 var httpResponseTypeParameter = &ast.Parameter{
-	Type: httpRequestType,
+	Type: httpResponseType,
 	Name: "_",
 }
 
+func newHttpResponse(res *calloutResponse) *ast.Object {
+	obj := ast.CreateObject(httpResponseType)
+	obj.Extra["body"] = string(res.Body)
+	obj.Extra["statusCode"] = res.StatusCode
+	obj.Extra["status"] = res.Status
+	obj.Extra["headers"] = res.Header
+	return obj
+}
+
 func init() {
 	instanceMethods := ast.NewMethodMap()
 	staticMethods := ast.NewMethodMap()
@@ -17,10 +31,16 @@ func init() {
 			nil,
 			[]*ast.Parameter{},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				this.Extra["body"] = ""
+				this.Extra["statusCode"] = 0
+				this.Extra["status"] = ""
+				this.Extra["headers"] = http.Header{}
 				return nil
 			},
 		),
 	}
+	httpResponseType.InstanceFields = ast.NewFieldMap()
+	httpResponseType.StaticFields = ast.NewFieldMap()
 	httpResponseType.InstanceMethods = instanceMethods
 	httpResponseType.StaticMethods = staticMethods
 
@@ -37,6 +57,140 @@ func init() {
 			),
 		},
 	)
+	instanceMethods.Set(
+		"getBodyAsBlob",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getBodyAsBlob",
+				BlobType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBlob([]byte(this.Extra["body"].(string)))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setBody",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setBody",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["body"] = params[0].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getStatusCode",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getStatusCode",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(this.Extra["statusCode"].(int))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setStatusCode",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStatusCode",
+				nil,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["statusCode"] = params[0].IntegerValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getStatus",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getStatus",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["status"].(string))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setStatus",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setStatus",
+				nil,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["status"] = params[0].StringValue()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getHeader",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getHeader",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					values := this.Extra["headers"].(http.Header)[http.CanonicalHeaderKey(params[0].StringValue())]
+					if len(values) == 0 {
+						return Null
+					}
+					return NewString(values[0])
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"setHeader",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setHeader",
+				nil,
+				[]*ast.Parameter{stringTypeParameter, stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["headers"].(http.Header).Set(params[0].StringValue(), params[1].StringValue())
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getHeaderKeys",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getHeaderKeys",
+				CreateListType(StringType),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					keys := []string{}
+					for key := range this.Extra["headers"].(http.Header) {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					records := make([]*ast.Object, len(keys))
+					for i, key := range keys {
+						records[i] = NewString(key)
+					}
+					return CreateListObject(CreateListType(StringType), records)
+				},
+			),
+		},
+	)
 
 	primitiveClassMap.Set("HttpResponse", httpResponseType)
 }
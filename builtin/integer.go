@@ -49,11 +49,7 @@ func init() {
 				IntegerType,
 				[]*ast.Parameter{stringTypeParameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					value, err := strconv.Atoi(params[0].StringValue())
-					if err != nil {
-						panic(err)
-					}
-					return NewInteger(value)
+					return parseInteger(params[0].StringValue())
 				},
 			),
 			ast.CreateMethod(
@@ -61,11 +57,13 @@ func init() {
 				IntegerType,
 				[]*ast.Parameter{objectTypeParameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					switch this.ClassType {
+					switch params[0].ClassType {
 					case IntegerType:
-						return NewInteger(this.IntegerValue())
+						return NewInteger(params[0].IntegerValue())
+					case StringType:
+						return parseInteger(params[0].StringValue())
 					}
-					panic("not expected type")
+					return Raise(TypeExceptionType, fmt.Sprintf("Invalid conversion from runtime type %s to Integer", params[0].ClassType.Name))
 				},
 			),
 		},
@@ -73,3 +71,12 @@ func init() {
 
 	primitiveClassMap.Set("Integer", IntegerType)
 }
+
+// parseInteger parses the string as 32-bit integer, out of range values raise TypeException instead of being wrapped
+func parseInteger(s string) interface{} {
+	value, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return Raise(TypeExceptionType, fmt.Sprintf("Invalid integer: %s", s))
+	}
+	return NewInteger(int(value))
+}
@@ -0,0 +1,50 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+func TestValueOf(t *testing.T) {
+	testCases := []struct {
+		ClassType *ast.ClassType
+		Parameter *ast.Object
+		Expected  *ast.Object
+		Error     string
+	}{
+		{IntegerType, NewString("2147483647"), NewInteger(2147483647), ""},
+		{IntegerType, NewString("-2147483648"), NewInteger(-2147483648), ""},
+		{IntegerType, NewString("2147483648"), nil, "Invalid integer: 2147483648"},
+		{IntegerType, NewString("-2147483649"), nil, "Invalid integer: -2147483649"},
+		{IntegerType, NewString("1.5"), nil, "Invalid integer: 1.5"},
+		{IntegerType, NewString("abc"), nil, "Invalid integer: abc"},
+		{LongType, NewString("2147483648"), NewLong(2147483648), ""},
+		{LongType, NewString("9223372036854775808"), nil, "Invalid long: 9223372036854775808"},
+		{LongType, NewString(""), nil, "Invalid long: "},
+	}
+	for i, testCase := range testCases {
+		methods, _ := testCase.ClassType.StaticMethods.Get("valueOf")
+		var m *ast.Method
+		for _, method := range methods {
+			if method.Parameters[0].Type == StringType {
+				m = method
+			}
+		}
+		actual := m.NativeFunction(nil, []*ast.Object{testCase.Parameter}, map[string]interface{}{}).(*ast.Object)
+		if testCase.Error != "" {
+			if actual.ClassType != RaiseType {
+				t.Errorf("%d: expected TypeException, actual %s", i, String(actual))
+				continue
+			}
+			e := actual.Value().(*ast.Object)
+			if e.ClassType != TypeExceptionType || e.Extra["message"].(*ast.Object).StringValue() != testCase.Error {
+				t.Errorf("%d: expected TypeException: %s, actual %s", i, testCase.Error, String(e))
+			}
+			continue
+		}
+		if actual.ClassType != testCase.Expected.ClassType || actual.Value() != testCase.Expected.Value() {
+			t.Errorf("%d: expected %s, actual %s", i, String(testCase.Expected), String(actual))
+		}
+	}
+}
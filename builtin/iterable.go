@@ -0,0 +1,63 @@
+package builtin
+
+import "github.com/tzmfreedom/land/ast"
+
+var IterableType = ast.CreateClass(
+	"Iterable",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var IteratorType = ast.CreateClass(
+	"Iterator",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+// isIterableOf returns true if List or Set of the other can be used as Iterable of t
+func isIterableOf(t, other *ast.ClassType) bool {
+	if t.Name != "Iterable" || (other.Name != "List" && other.Name != "Set") {
+		return false
+	}
+	if len(t.Generics) != 1 || len(other.Generics) != 1 {
+		return false
+	}
+	return Equals(t.Generics[0], other.Generics[0])
+}
+
+// iterableElements returns elements of List or Set in iteration order
+func iterableElements(iterable *ast.Object) []*ast.Object {
+	if records, ok := iterable.Extra["records"].([]*ast.Object); ok {
+		return records
+	}
+	return iterable.Extra["values"].(*OrderedMap).Keys()
+}
+
+func init() {
+	IteratorType.Interface = true
+	IteratorType.InstanceMethods.Set(
+		"hasNext",
+		[]*ast.Method{
+			ast.CreateMethod("hasNext", BooleanType, []*ast.Parameter{}, nil),
+		},
+	)
+	IteratorType.InstanceMethods.Set(
+		"next",
+		[]*ast.Method{
+			ast.CreateMethod("next", T1type, []*ast.Parameter{}, nil),
+		},
+	)
+
+	IterableType.Interface = true
+	IterableType.InstanceMethods.Set(
+		"iterator",
+		[]*ast.Method{
+			ast.CreateMethod("iterator", IteratorType, []*ast.Parameter{}, nil),
+		},
+	)
+
+	systemNameSpace.Set("Iterable", IterableType)
+	systemNameSpace.Set("Iterator", IteratorType)
+}
@@ -1,13 +1,18 @@
 package builtin
 
 import (
+	"encoding/base64"
 	"encoding/json"
-
 	"fmt"
+	"math"
+	"strconv"
+	"time"
 
 	"github.com/tzmfreedom/land/ast"
 )
 
+var JSONExceptionType = CreateExceptionType("JSONException")
+
 func init() {
 	staticMethods := ast.NewMethodMap()
 	staticMethods.Set(
@@ -18,12 +23,7 @@ func init() {
 				StringType,
 				[]*ast.Parameter{objectTypeParameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					mapValue := serializeJson(params[0], false)
-					value, err := json.Marshal(mapValue)
-					if err != nil {
-						panic(err)
-					}
-					return NewString(string(value))
+					return serializeJson(params[0], false, false, extra)
 				},
 			),
 			ast.CreateMethod(
@@ -34,12 +34,31 @@ func init() {
 					booleanTypeParameter,
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					mapValue := serializeJson(params[0], params[1].BoolValue())
-					value, err := json.Marshal(mapValue)
-					if err != nil {
-						panic(err)
-					}
-					return NewString(string(value))
+					return serializeJson(params[0], params[1].BoolValue(), false, extra)
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"serializePretty",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"serializePretty",
+				StringType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return serializeJson(params[0], false, true, extra)
+				},
+			),
+			ast.CreateMethod(
+				"serializePretty",
+				StringType,
+				[]*ast.Parameter{
+					objectTypeParameter,
+					booleanTypeParameter,
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return serializeJson(params[0], params[1].BoolValue(), true, extra)
 				},
 			),
 		},
@@ -52,12 +71,69 @@ func init() {
 				ObjectType,
 				[]*ast.Parameter{stringTypeParameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					srcMap := map[string]interface{}{}
-					err := json.Unmarshal([]byte(params[0].StringValue()), &srcMap)
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
+					value, err := parseJson(params[0].StringValue())
 					if err != nil {
-						panic(err)
+						return Raise(JSONExceptionType, err.Error())
 					}
-					return deserializeJson(srcMap)
+					return deserializeJson(value)
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"deserialize",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"deserialize",
+				ObjectType,
+				[]*ast.Parameter{stringTypeParameter, typeTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return deserializeTypedJson(params[0], params[1], false)
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"deserializeStrict",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"deserializeStrict",
+				ObjectType,
+				[]*ast.Parameter{stringTypeParameter, typeTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return deserializeTypedJson(params[0], params[1], true)
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"createGenerator",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"createGenerator",
+				JSONGeneratorType,
+				[]*ast.Parameter{booleanTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return newJsonGenerator(params[0].BoolValue())
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"createParser",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"createParser",
+				JSONParserType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
+					return newJsonParser(params[0].StringValue())
 				},
 			),
 		},
@@ -71,48 +147,38 @@ func init() {
 	)
 
 	primitiveClassMap.Set("JSON", classType)
+	primitiveClassMap.Set("JSONException", JSONExceptionType)
 }
 
-func serializeJson(object *ast.Object, suppressApexObjectNull bool) interface{} {
-	classType := object.ClassType
-	switch classType {
-	case StringType:
-		return object.StringValue()
-	case IntegerType:
-		return object.IntegerValue()
-	case DoubleType:
-		return object.DoubleValue()
-	case BooleanType:
-		return object.BoolValue()
-	case NullType:
-		return nil
+func serializeJson(object *ast.Object, suppressApexObjectNulls bool, pretty bool, extra map[string]interface{}) interface{} {
+	w := newJsonWriter(pretty)
+	w.check = nativeCheck(extra)
+	if err := w.writeObject(object, suppressApexObjectNulls); err != nil {
+		return jsonWriteError(err)
 	}
-	if classType.Name == "List" {
-		records := object.Extra["records"].([]*ast.Object)
-		values := make([]interface{}, len(records))
-		for i, record := range records {
-			values[i] = serializeJson(record, suppressApexObjectNull)
-		}
-		return values
+	return NewString(w.String())
+}
+
+func deserializeTypedJson(text *ast.Object, typeObj *ast.Object, strict bool) interface{} {
+	if text == Null {
+		return NewNullPointerException("String")
 	}
-	if classType.Name == "Map" {
-		ret := map[string]interface{}{}
-		values := object.Extra["values"].(map[string]*ast.Object)
-		for field, value := range values {
-			ret[field] = serializeJson(value, suppressApexObjectNull)
-		}
-		return ret
+	if typeObj == Null {
+		return NewNullPointerException("Type")
 	}
-	ret := map[string]interface{}{}
-	for field, value := range object.InstanceFields.All() {
-		if value == Null && suppressApexObjectNull {
-			continue
-		}
-		ret[field] = serializeJson(value, suppressApexObjectNull)
+	value, err := parseJson(text.StringValue())
+	if err != nil {
+		return Raise(JSONExceptionType, err.Error())
+	}
+	obj, err := jsonToObject(value, typeObj.Extra["value"].(*ast.ClassType), strict)
+	if err != nil {
+		return Raise(JSONExceptionType, err.Error())
 	}
-	return ret
+	return obj
 }
 
+// deserializeJson converts the parsed value to untyped object as JSON.deserializeUntyped does.
+// Numbers are Integer, Long or Decimal, arrays are List<Object> and objects are Map<String, Object>.
 func deserializeJson(value interface{}) *ast.Object {
 	if value == nil {
 		return Null
@@ -120,30 +186,276 @@ func deserializeJson(value interface{}) *ast.Object {
 	switch typedValue := value.(type) {
 	case string:
 		return NewString(typedValue)
-	case int:
-		return NewInteger(typedValue)
-	case float64:
-		return NewDouble(typedValue)
+	case json.Number:
+		if i, err := strconv.ParseInt(typedValue.String(), 10, 64); err == nil {
+			if i < math.MinInt32 || i > math.MaxInt32 {
+				return NewLong(int(i))
+			}
+			return NewInteger(int(i))
+		}
+		if d, err := ParseDecimal(typedValue.String()); err == nil {
+			return NewDecimal(d)
+		}
+		f, _ := typedValue.Float64()
+		return NewDouble(f)
 	case bool:
 		return NewBoolean(typedValue)
 	case []interface{}:
-		classType := CreateListType(ObjectType)
-		newObj := ast.CreateObject(classType)
 		records := make([]*ast.Object, len(typedValue))
 		for i, record := range typedValue {
 			records[i] = deserializeJson(record)
 		}
-		newObj.Extra["records"] = records
-		return newObj
-	case map[string]interface{}:
-		classType := CreateMapType(StringType, ObjectType)
-		newObj := ast.CreateObject(classType)
-		values := map[string]*ast.Object{}
-		for key, value := range typedValue {
-			values[key] = deserializeJson(value)
+		return CreateListObject(CreateListType(ObjectType), records)
+	case *jsonObject:
+		values := NewOrderedMap()
+		for _, key := range typedValue.keys {
+			values.Put(NewString(key), deserializeJson(typedValue.values[key]))
 		}
-		newObj.Extra["values"] = values
-		return newObj
+		return CreateMapObject(CreateMapType(StringType, ObjectType), values)
 	}
 	panic(fmt.Sprintf("no expected type %v", value))
 }
+
+// jsonKind returns the token name of the parsed value for error messages
+func jsonKind(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return jsonString
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return jsonNumberInt
+		}
+		return jsonNumberFloat
+	case bool:
+		if v {
+			return jsonTrue
+		}
+		return jsonFalse
+	case []interface{}:
+		return jsonStartArray
+	case *jsonObject:
+		return jsonStartObject
+	}
+	return jsonNull
+}
+
+// jsonText returns the text of the scalar value, numbers in string are accepted as numbers as Salesforce does
+func jsonText(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
+// jsonToObject converts the parsed value to the object of the type as JSON.deserialize does.
+// Apex objects and sObjects are populated by their field types, unknown fields are error if strict is true.
+func jsonToObject(value interface{}, classType *ast.ClassType, strict bool) (*ast.Object, error) {
+	if value == nil {
+		return Null, nil
+	}
+	if classType == nil || classType == ObjectType {
+		return deserializeJson(value), nil
+	}
+	illegal := fmt.Errorf("Cannot deserialize instance of %s from %s value", classType.String(), jsonKind(value))
+	text, isScalar := jsonText(value)
+	switch classType {
+	case StringType:
+		if !isScalar {
+			return nil, illegal
+		}
+		return NewString(text), nil
+	case IntegerType, LongType:
+		if !isScalar {
+			return nil, illegal
+		}
+		i, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			f, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, illegal
+			}
+			i = int64(f)
+		}
+		if classType == LongType {
+			return NewLong(int(i)), nil
+		}
+		return NewInteger(int(i)), nil
+	case DoubleType:
+		if !isScalar {
+			return nil, illegal
+		}
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, illegal
+		}
+		return NewDouble(f), nil
+	case DecimalType:
+		if !isScalar {
+			return nil, illegal
+		}
+		d, err := ParseDecimal(text)
+		if err != nil {
+			return nil, illegal
+		}
+		return NewDecimal(d), nil
+	case BooleanType:
+		if b, ok := value.(bool); ok {
+			return NewBoolean(b), nil
+		}
+		b, err := strconv.ParseBool(text)
+		if !isScalar || err != nil {
+			return nil, illegal
+		}
+		return NewBoolean(b), nil
+	case DateType, DatetimeType, timeType:
+		s, ok := value.(string)
+		if !ok {
+			return nil, illegal
+		}
+		t, err := parseJsonTime(s, classType)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot deserialize instance of %s from value %s", classType.String(), s)
+		}
+		switch classType {
+		case DateType:
+			return newDateOf(t), nil
+		case DatetimeType:
+			return NewDatetime(t), nil
+		}
+		return newTimeOf(t), nil
+	case BlobType:
+		s, ok := value.(string)
+		if !ok {
+			return nil, illegal
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, illegal
+		}
+		return NewBlob(b), nil
+	}
+	if IsEnum(classType) {
+		s, ok := value.(string)
+		if !ok {
+			return nil, illegal
+		}
+		if e, ok := EnumValueOf(classType, s); ok {
+			return e, nil
+		}
+		return nil, fmt.Errorf("No enum constant %s.%s", classType.Name, s)
+	}
+	switch classType.Name {
+	case "List", "Set":
+		values, ok := value.([]interface{})
+		if !ok {
+			return nil, illegal
+		}
+		records := make([]*ast.Object, len(values))
+		for i, v := range values {
+			record, err := jsonToObject(v, classType.Generics[0], strict)
+			if err != nil {
+				return nil, err
+			}
+			records[i] = record
+		}
+		if classType.Name == "Set" {
+			return CreateSetObject(classType, records), nil
+		}
+		return CreateListObject(classType, records), nil
+	case "Map":
+		object, ok := value.(*jsonObject)
+		if !ok {
+			return nil, illegal
+		}
+		values := NewOrderedMap()
+		for _, key := range object.keys {
+			k, err := jsonToObject(key, classType.Generics[0], strict)
+			if err != nil {
+				return nil, err
+			}
+			v, err := jsonToObject(object.values[key], classType.Generics[1], strict)
+			if err != nil {
+				return nil, err
+			}
+			values.Put(k, v)
+		}
+		return CreateMapObject(classType, values), nil
+	}
+	object, ok := value.(*jsonObject)
+	if !ok {
+		return nil, illegal
+	}
+	if classType.IsInterface() || classType.IsAbstract() {
+		return nil, fmt.Errorf("Abstract type %s can not be deserialized", classType.String())
+	}
+	isSObject := classType == SObjectType || classType.SuperClass == SObjectType
+	if classType == SObjectType {
+		concreteType, err := jsonSObjectType(object)
+		if err != nil {
+			return nil, err
+		}
+		classType = concreteType
+	}
+	obj := ast.CreateObject(classType)
+	for _, f := range classType.InstanceFields.Data {
+		obj.InstanceFields.Set(f.Name, Null)
+	}
+	for _, key := range object.keys {
+		if isSObject && key == "attributes" {
+			continue
+		}
+		field, ok := classType.InstanceFields.Get(key)
+		if !ok {
+			if !strict {
+				continue
+			}
+			if isSObject {
+				return nil, fmt.Errorf("No such column '%s' on sobject of type %s", key, classType.Name)
+			}
+			return nil, fmt.Errorf("Unknown field: %s.%s", classType.String(), key)
+		}
+		v, err := jsonToObject(object.values[key], field.Type, strict)
+		if err != nil {
+			return nil, err
+		}
+		obj.InstanceFields.Set(field.Name, v)
+	}
+	return obj, nil
+}
+
+// jsonSObjectType returns sObject type by the type of attributes, such as {"attributes":{"type":"Account"}}
+func jsonSObjectType(object *jsonObject) (*ast.ClassType, error) {
+	if attributes, ok := object.values["attributes"].(*jsonObject); ok {
+		if name, ok := attributes.values["type"].(string); ok {
+			if classType, ok := primitiveClassMap.Get(name); ok && classType.SuperClass == SObjectType {
+				return classType, nil
+			}
+			return nil, fmt.Errorf("Invalid sobject type: %s", name)
+		}
+	}
+	return nil, fmt.Errorf("Cannot deserialize instance of SObject without attributes.type")
+}
+
+// parseJsonTime parses Date, Datetime or Time value in the format written by JSON.serialize, Datetime without milliseconds or with offset is also accepted
+func parseJsonTime(value string, classType *ast.ClassType) (time.Time, error) {
+	layouts := []string{jsonTimeLayout, "15:04:05Z", "15:04:05.000", "15:04:05"}
+	switch classType {
+	case DateType:
+		layouts = []string{jsonDateLayout}
+	case DatetimeType:
+		layouts = []string{jsonDatetimeLayout, "2006-01-02T15:04:05.000Z0700", "2006-01-02T15:04:05.000Z07:00", time.RFC3339, "2006-01-02T15:04:05Z0700"}
+	}
+	var err error
+	for _, layout := range layouts {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
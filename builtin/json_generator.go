@@ -0,0 +1,526 @@
+package builtin
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// jsonWriter writes JSON text incrementally.
+// Pretty output is indented as Salesforce does, object entries are on their own lines and array elements are on one line.
+type jsonWriter struct {
+	buf    bytes.Buffer
+	pretty bool
+	stack  []*jsonContext
+	done   bool
+	closed bool
+	// check is called by writeObject with the bytes written, it stops writing by an error, such as the interruption of the interpreter
+	check func(size int) error
+	// depth is the nesting of writeObject, it is limited because the object may refer itself
+	depth int
+}
+
+// jsonMaxDepth is the maximum nesting of the objects written by writeObject
+const jsonMaxDepth = 1000
+
+// jsonContext is an open object or array of jsonWriter
+type jsonContext struct {
+	array bool
+	count int
+	named bool
+}
+
+func newJsonWriter(pretty bool) *jsonWriter {
+	return &jsonWriter{pretty: pretty}
+}
+
+func (w *jsonWriter) top() *jsonContext {
+	if len(w.stack) == 0 {
+		return nil
+	}
+	return w.stack[len(w.stack)-1]
+}
+
+// indent writes line feed and the indentation of the nesting level, only objects are nested.
+func (w *jsonWriter) indent() {
+	w.buf.WriteString("\n")
+	for _, ctx := range w.stack {
+		if !ctx.array {
+			w.buf.WriteString("  ")
+		}
+	}
+}
+
+func (w *jsonWriter) beforeValue() error {
+	if w.closed {
+		return errors.New("Generator is closed")
+	}
+	ctx := w.top()
+	if ctx == nil {
+		if w.done {
+			return errors.New("Can not write a value, expecting end of document")
+		}
+		return nil
+	}
+	if ctx.array {
+		if ctx.count > 0 {
+			w.buf.WriteString(",")
+		}
+		if w.pretty {
+			w.buf.WriteString(" ")
+		}
+		ctx.count++
+		return nil
+	}
+	if !ctx.named {
+		return errors.New("Can not write a value, expecting a field name")
+	}
+	ctx.named = false
+	return nil
+}
+
+func (w *jsonWriter) afterValue() {
+	if len(w.stack) == 0 {
+		w.done = true
+	}
+}
+
+func (w *jsonWriter) writeRaw(text string) error {
+	if err := w.beforeValue(); err != nil {
+		return err
+	}
+	w.buf.WriteString(text)
+	w.afterValue()
+	return nil
+}
+
+func (w *jsonWriter) writeFieldName(name string) error {
+	if w.closed {
+		return errors.New("Generator is closed")
+	}
+	ctx := w.top()
+	if ctx == nil || ctx.array || ctx.named {
+		return errors.New("Can not write a field name, expecting a value")
+	}
+	if ctx.count > 0 {
+		w.buf.WriteString(",")
+	}
+	if w.pretty {
+		w.indent()
+	}
+	w.buf.WriteString(jsonQuote(name))
+	if w.pretty {
+		w.buf.WriteString(" : ")
+	} else {
+		w.buf.WriteString(":")
+	}
+	ctx.count++
+	ctx.named = true
+	return nil
+}
+
+func (w *jsonWriter) writeStartObject() error {
+	if err := w.beforeValue(); err != nil {
+		return err
+	}
+	w.buf.WriteString("{")
+	w.stack = append(w.stack, &jsonContext{})
+	return nil
+}
+
+func (w *jsonWriter) writeEndObject() error {
+	ctx := w.top()
+	if w.closed || ctx == nil || ctx.array || ctx.named {
+		return errors.New("Current context not an object")
+	}
+	w.stack = w.stack[:len(w.stack)-1]
+	if w.pretty {
+		if ctx.count > 0 {
+			w.indent()
+		} else {
+			w.buf.WriteString(" ")
+		}
+	}
+	w.buf.WriteString("}")
+	w.afterValue()
+	return nil
+}
+
+func (w *jsonWriter) writeStartArray() error {
+	if err := w.beforeValue(); err != nil {
+		return err
+	}
+	w.buf.WriteString("[")
+	w.stack = append(w.stack, &jsonContext{array: true})
+	return nil
+}
+
+func (w *jsonWriter) writeEndArray() error {
+	ctx := w.top()
+	if w.closed || ctx == nil || !ctx.array {
+		return errors.New("Current context not an array")
+	}
+	w.stack = w.stack[:len(w.stack)-1]
+	if w.pretty {
+		w.buf.WriteString(" ")
+	}
+	w.buf.WriteString("]")
+	w.afterValue()
+	return nil
+}
+
+// writeObject writes the value as JSON.serialize does, null fields of Apex objects are omitted if suppressApexObjectNulls is true
+func (w *jsonWriter) writeObject(object *ast.Object, suppressApexObjectNulls bool) error {
+	if w.depth >= jsonMaxDepth {
+		return NewExceptionError(NewException(LimitExceptionType, fmt.Sprintf("Maximum stack depth reached: %d", w.depth+1)))
+	}
+	if w.check != nil {
+		if err := w.check(w.buf.Len()); err != nil {
+			return err
+		}
+	}
+	w.depth++
+	defer func() {
+		w.depth--
+	}()
+	if object == nil || object == Null {
+		return w.writeRaw("null")
+	}
+	classType := object.ClassType
+	switch classType {
+	case StringType:
+		return w.writeRaw(jsonQuote(object.StringValue()))
+	case IntegerType, LongType:
+		return w.writeRaw(strconv.Itoa(object.IntegerValue()))
+	case DoubleType:
+		return w.writeRaw(formatJsonDouble(object.DoubleValue()))
+	case DecimalType:
+		return w.writeRaw(DecimalValue(object).String())
+	case BooleanType:
+		return w.writeRaw(strconv.FormatBool(object.BoolValue()))
+	case DateType:
+		return w.writeRaw(jsonQuote(datetimeValue(object).Format(jsonDateLayout)))
+	case DatetimeType:
+		return w.writeRaw(jsonQuote(datetimeValue(object).UTC().Format(jsonDatetimeLayout)))
+	case timeType:
+		return w.writeRaw(jsonQuote(datetimeValue(object).Format(jsonTimeLayout)))
+	case BlobType:
+		return w.writeRaw(jsonQuote(base64.StdEncoding.EncodeToString(object.Extra["value"].([]byte))))
+	}
+	if IsEnum(classType) {
+		return w.writeRaw(jsonQuote(object.StringValue()))
+	}
+	switch classType.Name {
+	case "List", "Set":
+		if err := w.writeStartArray(); err != nil {
+			return err
+		}
+		for _, record := range iterableElements(object) {
+			if err := w.writeObject(record, suppressApexObjectNulls); err != nil {
+				return err
+			}
+		}
+		return w.writeEndArray()
+	case "Map":
+		if err := w.writeStartObject(); err != nil {
+			return err
+		}
+		values := mapValues(object)
+		for _, key := range values.Keys() {
+			value, _ := values.Get(key)
+			w.writeFieldName(String(key))
+			if err := w.writeObject(value, suppressApexObjectNulls); err != nil {
+				return err
+			}
+		}
+		return w.writeEndObject()
+	}
+	if err := w.writeStartObject(); err != nil {
+		return err
+	}
+	isSObject := classType == SObjectType || classType.SuperClass == SObjectType
+	if isSObject {
+		w.writeFieldName("attributes")
+		w.writeStartObject()
+		w.writeFieldName("type")
+		w.writeRaw(jsonQuote(classType.Name))
+		w.writeEndObject()
+	}
+	for _, field := range jsonFields(object) {
+		value, _ := object.InstanceFields.Get(field.Name)
+		// fields of sObject which are not set are omitted
+		if value == Null && (isSObject || suppressApexObjectNulls) {
+			continue
+		}
+		w.writeFieldName(field.Name)
+		if err := w.writeObject(value, suppressApexObjectNulls); err != nil {
+			return err
+		}
+	}
+	return w.writeEndObject()
+}
+
+func (w *jsonWriter) String() string {
+	return w.buf.String()
+}
+
+const (
+	jsonDateLayout     = "2006-01-02"
+	jsonDatetimeLayout = "2006-01-02T15:04:05.000Z"
+	jsonTimeLayout     = "15:04:05.000Z"
+)
+
+// jsonFields returns fields of the object to be serialized, in declared order for Apex objects and in name order for sObjects.
+// Transient fields are not serialized.
+func jsonFields(object *ast.Object) []*ast.Field {
+	fields := []*ast.Field{}
+	for key := range object.InstanceFields.All() {
+		field, ok := object.ClassType.InstanceFields.Get(key)
+		if !ok {
+			field = &ast.Field{Name: key}
+		}
+		if field.Is("transient") {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		l := fields[i].Location
+		r := fields[j].Location
+		if l == nil || r == nil {
+			if l != r {
+				return l != nil
+			}
+			return fields[i].Name < fields[j].Name
+		}
+		if l.Line != r.Line {
+			return l.Line < r.Line
+		}
+		return l.Column < r.Column
+	})
+	return fields
+}
+
+// jsonQuote returns JSON string literal of the value, HTML characters are not escaped
+func jsonQuote(value string) string {
+	buf := new(bytes.Buffer)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(value)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// formatJsonDouble formats Double as Java does, such as 1.0 and 1.0E10
+func formatJsonDouble(value float64) string {
+	abs := value
+	if abs < 0 {
+		abs = -abs
+	}
+	if abs != 0 && (abs < 1e-3 || abs >= 1e7) {
+		text := strconv.FormatFloat(value, 'E', -1, 64)
+		index := strings.Index(text, "E")
+		mantissa := text[:index]
+		if !strings.Contains(mantissa, ".") {
+			mantissa += ".0"
+		}
+		exponent, _ := strconv.Atoi(text[index+1:])
+		return mantissa + "E" + strconv.Itoa(exponent)
+	}
+	text := strconv.FormatFloat(value, 'f', -1, 64)
+	if !strings.Contains(text, ".") {
+		text += ".0"
+	}
+	return text
+}
+
+var JSONGeneratorType = ast.CreateClass(
+	"JSONGenerator",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+func newJsonGenerator(pretty bool) *ast.Object {
+	obj := ast.CreateObject(JSONGeneratorType)
+	obj.Extra["value"] = newJsonWriter(pretty)
+	return obj
+}
+
+func jsonWriterOf(o *ast.Object) *jsonWriter {
+	return o.Extra["value"].(*jsonWriter)
+}
+
+// generatorMethod creates JSONGenerator method which writes with the writer, write error is thrown as JSONException
+func generatorMethod(name string, parameters []*ast.Parameter, write func(w *jsonWriter, params []*ast.Object) error) *ast.Method {
+	return ast.CreateMethod(
+		name,
+		nil,
+		parameters,
+		func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+			w := jsonWriterOf(this)
+			w.check = nativeCheck(extra)
+			if err := write(w, params); err != nil {
+				return jsonWriteError(err)
+			}
+			return nil
+		},
+	)
+}
+
+// jsonWriteError returns the error of jsonWriter as JSONException,
+// except the interruption and LimitException which stop the execution
+func jsonWriteError(err error) interface{} {
+	if _, ok := err.(*ExceptionError); ok || err == ErrInterrupted {
+		return err
+	}
+	return Raise(JSONExceptionType, err.Error())
+}
+
+// writeValue writes the first parameter
+func writeValue(w *jsonWriter, params []*ast.Object) error {
+	return w.writeObject(params[0], false)
+}
+
+// writeField writes the first parameter as field name and the second parameter as its value
+func writeField(w *jsonWriter, params []*ast.Object) error {
+	if params[0] == Null {
+		return errors.New("Field name must not be null")
+	}
+	if err := w.writeFieldName(params[0].StringValue()); err != nil {
+		return err
+	}
+	return w.writeObject(params[1], false)
+}
+
+func init() {
+	instanceMethods := JSONGeneratorType.InstanceMethods
+	noParams := []*ast.Parameter{}
+	valueMethods := map[string][]*ast.ClassType{
+		"writeString":   {StringType},
+		"writeNumber":   {IntegerType, LongType, DoubleType, DecimalType},
+		"writeBoolean":  {BooleanType},
+		"writeDate":     {DateType},
+		"writeDateTime": {DatetimeType},
+		"writeTime":     {timeType},
+		"writeBlob":     {BlobType},
+		"writeId":       {StringType},
+		"writeObject":   {ObjectType},
+	}
+	for name, types := range valueMethods {
+		methods := []*ast.Method{}
+		fieldMethods := []*ast.Method{}
+		for _, t := range types {
+			methods = append(methods, generatorMethod(name, []*ast.Parameter{{Type: t, Name: "_"}}, writeValue))
+			fieldMethods = append(
+				fieldMethods,
+				generatorMethod(name+"Field", []*ast.Parameter{stringTypeParameter, {Type: t, Name: "_"}}, writeField),
+			)
+		}
+		instanceMethods.Set(name, methods)
+		instanceMethods.Set(name+"Field", fieldMethods)
+	}
+	instanceMethods.Set(
+		"writeNull",
+		[]*ast.Method{
+			generatorMethod("writeNull", noParams, func(w *jsonWriter, params []*ast.Object) error {
+				return w.writeRaw("null")
+			}),
+		},
+	)
+	instanceMethods.Set(
+		"writeNullField",
+		[]*ast.Method{
+			generatorMethod("writeNullField", []*ast.Parameter{stringTypeParameter}, func(w *jsonWriter, params []*ast.Object) error {
+				return writeField(w, []*ast.Object{params[0], Null})
+			}),
+		},
+	)
+	instanceMethods.Set(
+		"writeFieldName",
+		[]*ast.Method{
+			generatorMethod("writeFieldName", []*ast.Parameter{stringTypeParameter}, func(w *jsonWriter, params []*ast.Object) error {
+				if params[0] == Null {
+					return errors.New("Field name must not be null")
+				}
+				return w.writeFieldName(params[0].StringValue())
+			}),
+		},
+	)
+	instanceMethods.Set(
+		"writeStartObject",
+		[]*ast.Method{
+			generatorMethod("writeStartObject", noParams, func(w *jsonWriter, params []*ast.Object) error {
+				return w.writeStartObject()
+			}),
+		},
+	)
+	instanceMethods.Set(
+		"writeEndObject",
+		[]*ast.Method{
+			generatorMethod("writeEndObject", noParams, func(w *jsonWriter, params []*ast.Object) error {
+				return w.writeEndObject()
+			}),
+		},
+	)
+	instanceMethods.Set(
+		"writeStartArray",
+		[]*ast.Method{
+			generatorMethod("writeStartArray", noParams, func(w *jsonWriter, params []*ast.Object) error {
+				return w.writeStartArray()
+			}),
+		},
+	)
+	instanceMethods.Set(
+		"writeEndArray",
+		[]*ast.Method{
+			generatorMethod("writeEndArray", noParams, func(w *jsonWriter, params []*ast.Object) error {
+				return w.writeEndArray()
+			}),
+		},
+	)
+	instanceMethods.Set(
+		"close",
+		[]*ast.Method{
+			generatorMethod("close", noParams, func(w *jsonWriter, params []*ast.Object) error {
+				w.closed = true
+				return nil
+			}),
+		},
+	)
+	instanceMethods.Set(
+		"isClosed",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isClosed",
+				BooleanType,
+				noParams,
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(jsonWriterOf(this).closed)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getAsString",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getAsString",
+				StringType,
+				noParams,
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					w := jsonWriterOf(this)
+					w.closed = true
+					return NewString(w.String())
+				},
+			),
+		},
+	)
+	primitiveClassMap.Set("JSONGenerator", JSONGeneratorType)
+}
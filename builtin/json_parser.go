@@ -0,0 +1,471 @@
+package builtin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// Tokens of System.JSONToken
+const (
+	jsonStartObject = "START_OBJECT"
+	jsonEndObject   = "END_OBJECT"
+	jsonStartArray  = "START_ARRAY"
+	jsonEndArray    = "END_ARRAY"
+	jsonFieldName   = "FIELD_NAME"
+	jsonString      = "VALUE_STRING"
+	jsonNumberInt   = "VALUE_NUMBER_INT"
+	jsonNumberFloat = "VALUE_NUMBER_FLOAT"
+	jsonTrue        = "VALUE_TRUE"
+	jsonFalse       = "VALUE_FALSE"
+	jsonNull        = "VALUE_NULL"
+)
+
+var JSONTokenType = NewEnumType("JSONToken", []string{
+	jsonEndArray,
+	jsonEndObject,
+	jsonFieldName,
+	"NOT_AVAILABLE",
+	jsonStartArray,
+	jsonStartObject,
+	"VALUE_EMBEDDED_OBJECT",
+	jsonFalse,
+	jsonNull,
+	jsonNumberFloat,
+	jsonNumberInt,
+	jsonString,
+	jsonTrue,
+})
+
+// jsonToken is a token of JSON text.
+// name is the field name which the token belongs to, value is string, json.Number, bool or nil for scalar tokens.
+type jsonToken struct {
+	kind  string
+	text  string
+	name  string
+	named bool
+	value interface{}
+}
+
+// tokenizeJson splits JSON text into tokens.
+// The tokens before the syntax error are returned with the error.
+func tokenizeJson(text string) ([]*jsonToken, error) {
+	type frame struct {
+		object bool
+		token  *jsonToken
+		name   string
+		named  bool
+	}
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	tokens := []*jsonToken{}
+	stack := []*frame{}
+	for {
+		t, err := decoder.Token()
+		if err == io.EOF {
+			if len(stack) > 0 || len(tokens) == 0 {
+				return tokens, errors.New("Unexpected end-of-input")
+			}
+			return tokens, nil
+		}
+		if err != nil {
+			return tokens, err
+		}
+		if delim, ok := t.(json.Delim); ok && (delim == '}' || delim == ']') {
+			start := stack[len(stack)-1].token
+			stack = stack[:len(stack)-1]
+			kind := jsonEndObject
+			if delim == ']' {
+				kind = jsonEndArray
+			}
+			tokens = append(tokens, &jsonToken{kind: kind, text: delim.String(), name: start.name, named: start.named})
+			continue
+		}
+		token := &jsonToken{}
+		if len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if top.object {
+				if !top.named {
+					top.name = t.(string)
+					top.named = true
+					tokens = append(tokens, &jsonToken{kind: jsonFieldName, text: top.name, name: top.name, named: true})
+					continue
+				}
+				token.name = top.name
+				token.named = true
+				top.named = false
+			}
+		}
+		switch value := t.(type) {
+		case json.Delim:
+			token.text = value.String()
+			token.kind = jsonStartObject
+			if value == '[' {
+				token.kind = jsonStartArray
+			}
+			stack = append(stack, &frame{object: value == '{', token: token})
+		case string:
+			token.kind = jsonString
+			token.text = value
+		case json.Number:
+			token.kind = jsonNumberInt
+			if strings.ContainsAny(value.String(), ".eE") {
+				token.kind = jsonNumberFloat
+			}
+			token.text = value.String()
+		case bool:
+			token.kind = jsonFalse
+			if value {
+				token.kind = jsonTrue
+			}
+			token.text = fmt.Sprint(value)
+		case nil:
+			token.kind = jsonNull
+			token.text = "null"
+		}
+		token.value = t
+		tokens = append(tokens, token)
+	}
+}
+
+// jsonObject is JSON object which keeps the order of the keys
+type jsonObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// buildJsonValue builds the value which starts at the index of the tokens, and returns the index next to the value.
+// Objects are built as *jsonObject and arrays are built as []interface{}.
+func buildJsonValue(tokens []*jsonToken, index int) (interface{}, int, error) {
+	if index >= len(tokens) {
+		return nil, index, errors.New("Unexpected end-of-input")
+	}
+	token := tokens[index]
+	switch token.kind {
+	case jsonStartObject:
+		object := &jsonObject{keys: []string{}, values: map[string]interface{}{}}
+		index++
+		for index < len(tokens) && tokens[index].kind == jsonFieldName {
+			key := tokens[index].text
+			value, next, err := buildJsonValue(tokens, index+1)
+			if err != nil {
+				return nil, next, err
+			}
+			if _, ok := object.values[key]; !ok {
+				object.keys = append(object.keys, key)
+			}
+			object.values[key] = value
+			index = next
+		}
+		if index >= len(tokens) {
+			return nil, index, errors.New("Unexpected end-of-input")
+		}
+		return object, index + 1, nil
+	case jsonStartArray:
+		values := []interface{}{}
+		index++
+		for index < len(tokens) && tokens[index].kind != jsonEndArray {
+			value, next, err := buildJsonValue(tokens, index)
+			if err != nil {
+				return nil, next, err
+			}
+			values = append(values, value)
+			index = next
+		}
+		if index >= len(tokens) {
+			return nil, index, errors.New("Unexpected end-of-input")
+		}
+		return values, index + 1, nil
+	case jsonEndObject, jsonEndArray, jsonFieldName:
+		return nil, index, fmt.Errorf("Unexpected token (%s)", token.kind)
+	}
+	return token.value, index + 1, nil
+}
+
+// parseJson parses JSON text into string, json.Number, bool, nil, []interface{} or *jsonObject
+func parseJson(text string) (interface{}, error) {
+	tokens, err := tokenizeJson(text)
+	if err != nil {
+		return nil, err
+	}
+	value, _, err := buildJsonValue(tokens, 0)
+	return value, err
+}
+
+// jsonParser reads tokens of JSON text one by one as System.JSONParser
+type jsonParser struct {
+	tokens  []*jsonToken
+	err     error
+	index   int
+	current *jsonToken
+}
+
+var JSONParserType = ast.CreateClass(
+	"JSONParser",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+func newJsonParser(text string) *ast.Object {
+	tokens, err := tokenizeJson(text)
+	obj := ast.CreateObject(JSONParserType)
+	obj.Extra["value"] = &jsonParser{tokens: tokens, err: err, index: -1}
+	return obj
+}
+
+func jsonParserOf(o *ast.Object) *jsonParser {
+	return o.Extra["value"].(*jsonParser)
+}
+
+// nextToken advances to the next token, it returns nil at the end of input, and the syntax error after the last valid token
+func (p *jsonParser) nextToken() (*jsonToken, error) {
+	if p.index < len(p.tokens) {
+		p.index++
+	}
+	if p.index >= len(p.tokens) {
+		p.current = nil
+		return nil, p.err
+	}
+	p.current = p.tokens[p.index]
+	return p.current, nil
+}
+
+// skipChildren moves to the end token of the current object or array
+func (p *jsonParser) skipChildren() {
+	if p.current == nil || (p.current.kind != jsonStartObject && p.current.kind != jsonStartArray) {
+		return
+	}
+	depth := 0
+	for ; p.index < len(p.tokens); p.index++ {
+		switch p.tokens[p.index].kind {
+		case jsonStartObject, jsonStartArray:
+			depth++
+		case jsonEndObject, jsonEndArray:
+			depth--
+		}
+		if depth == 0 {
+			p.current = p.tokens[p.index]
+			return
+		}
+	}
+	p.current = nil
+}
+
+// readValue builds the value at the current token, and moves to the last token of the value
+func (p *jsonParser) readValue() (interface{}, error) {
+	if p.current == nil {
+		if _, err := p.nextToken(); err != nil {
+			return nil, err
+		}
+	}
+	if p.current == nil {
+		return nil, nil
+	}
+	if p.current.kind == jsonFieldName {
+		if _, err := p.nextToken(); err != nil {
+			return nil, err
+		}
+	}
+	value, next, err := buildJsonValue(p.tokens, p.index)
+	if err != nil {
+		if p.err != nil {
+			return nil, p.err
+		}
+		return nil, err
+	}
+	p.index = next - 1
+	p.current = p.tokens[p.index]
+	return value, nil
+}
+
+func jsonTokenObject(token *jsonToken) *ast.Object {
+	if token == nil {
+		return Null
+	}
+	value, _ := EnumValueOf(JSONTokenType, token.kind)
+	return value
+}
+
+// parserValueMethod creates a method which returns the current value converted to the type
+func parserValueMethod(name string, returnType *ast.ClassType, kinds ...string) *ast.Method {
+	return ast.CreateMethod(
+		name,
+		returnType,
+		[]*ast.Parameter{},
+		func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+			p := jsonParserOf(this)
+			if p.current == nil {
+				return Raise(JSONExceptionType, "No current token")
+			}
+			for _, kind := range kinds {
+				if p.current.kind == kind {
+					value, err := jsonToObject(p.current.value, returnType, false)
+					if err != nil {
+						return Raise(JSONExceptionType, err.Error())
+					}
+					return value
+				}
+			}
+			return Raise(JSONExceptionType, fmt.Sprintf("Current token (%s) can not be read as %s", p.current.kind, returnType.Name))
+		},
+	)
+}
+
+// parserReadMethod creates readValueAs or readValueAsStrict method
+func parserReadMethod(name string, strict bool) *ast.Method {
+	return ast.CreateMethod(
+		name,
+		ObjectType,
+		[]*ast.Parameter{typeTypeParameter},
+		func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+			if params[0] == Null {
+				return NewNullPointerException("Type")
+			}
+			value, err := jsonParserOf(this).readValue()
+			if err != nil {
+				return Raise(JSONExceptionType, err.Error())
+			}
+			obj, err := jsonToObject(value, params[0].Extra["value"].(*ast.ClassType), strict)
+			if err != nil {
+				return Raise(JSONExceptionType, err.Error())
+			}
+			return obj
+		},
+	)
+}
+
+func init() {
+	instanceMethods := JSONParserType.InstanceMethods
+	instanceMethods.Set(
+		"nextToken",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"nextToken",
+				JSONTokenType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					token, err := jsonParserOf(this).nextToken()
+					if err != nil {
+						return Raise(JSONExceptionType, err.Error())
+					}
+					return jsonTokenObject(token)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"nextValue",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"nextValue",
+				JSONTokenType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					p := jsonParserOf(this)
+					token, err := p.nextToken()
+					if err == nil && token != nil && token.kind == jsonFieldName {
+						token, err = p.nextToken()
+					}
+					if err != nil {
+						return Raise(JSONExceptionType, err.Error())
+					}
+					return jsonTokenObject(token)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getCurrentToken",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getCurrentToken",
+				JSONTokenType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return jsonTokenObject(jsonParserOf(this).current)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"hasCurrentToken",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"hasCurrentToken",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(jsonParserOf(this).current != nil)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getCurrentName",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getCurrentName",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					current := jsonParserOf(this).current
+					if current == nil || !current.named {
+						return Null
+					}
+					return NewString(current.name)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"getText",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getText",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					current := jsonParserOf(this).current
+					if current == nil {
+						return Null
+					}
+					return NewString(current.text)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"skipChildren",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"skipChildren",
+				nil,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					jsonParserOf(this).skipChildren()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set("getIntegerValue", []*ast.Method{parserValueMethod("getIntegerValue", IntegerType, jsonNumberInt, jsonNumberFloat)})
+	instanceMethods.Set("getLongValue", []*ast.Method{parserValueMethod("getLongValue", LongType, jsonNumberInt, jsonNumberFloat)})
+	instanceMethods.Set("getDoubleValue", []*ast.Method{parserValueMethod("getDoubleValue", DoubleType, jsonNumberInt, jsonNumberFloat)})
+	instanceMethods.Set("getDecimalValue", []*ast.Method{parserValueMethod("getDecimalValue", DecimalType, jsonNumberInt, jsonNumberFloat)})
+	instanceMethods.Set("getBooleanValue", []*ast.Method{parserValueMethod("getBooleanValue", BooleanType, jsonTrue, jsonFalse)})
+	instanceMethods.Set("getDateValue", []*ast.Method{parserValueMethod("getDateValue", DateType, jsonString)})
+	instanceMethods.Set("getDatetimeValue", []*ast.Method{parserValueMethod("getDatetimeValue", DatetimeType, jsonString)})
+	instanceMethods.Set("getTimeValue", []*ast.Method{parserValueMethod("getTimeValue", timeType, jsonString)})
+	instanceMethods.Set("getBlobValue", []*ast.Method{parserValueMethod("getBlobValue", BlobType, jsonString)})
+	instanceMethods.Set("getIdValue", []*ast.Method{parserValueMethod("getIdValue", StringType, jsonString)})
+	instanceMethods.Set("readValueAs", []*ast.Method{parserReadMethod("readValueAs", false)})
+	instanceMethods.Set("readValueAsStrict", []*ast.Method{parserReadMethod("readValueAsStrict", true)})
+
+	primitiveClassMap.Set("JSONParser", JSONParserType)
+	systemNameSpace.Set("JSONToken", JSONTokenType)
+}
@@ -0,0 +1,243 @@
+package builtin
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+func TestTokenizeJson(t *testing.T) {
+	tokens, err := tokenizeJson(`{"a":[1,2.5,"x"],"b":{"c":true,"d":null},"e":false}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	expected := []struct {
+		Kind string
+		Text string
+		Name string
+	}{
+		{jsonStartObject, "{", ""},
+		{jsonFieldName, "a", "a"},
+		{jsonStartArray, "[", "a"},
+		{jsonNumberInt, "1", ""},
+		{jsonNumberFloat, "2.5", ""},
+		{jsonString, "x", ""},
+		{jsonEndArray, "]", "a"},
+		{jsonFieldName, "b", "b"},
+		{jsonStartObject, "{", "b"},
+		{jsonFieldName, "c", "c"},
+		{jsonTrue, "true", "c"},
+		{jsonFieldName, "d", "d"},
+		{jsonNull, "null", "d"},
+		{jsonEndObject, "}", "b"},
+		{jsonFieldName, "e", "e"},
+		{jsonFalse, "false", "e"},
+		{jsonEndObject, "}", ""},
+	}
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, actual %d", len(expected), len(tokens))
+	}
+	for i, token := range tokens {
+		if token.kind != expected[i].Kind || token.text != expected[i].Text || token.name != expected[i].Name {
+			t.Errorf("%d: expected %v, actual {%s %s %s}", i, expected[i], token.kind, token.text, token.name)
+		}
+	}
+}
+
+func TestParseJsonError(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected string
+	}{
+		{``, "Unexpected end-of-input"},
+		{`{"a":1`, "Unexpected end-of-input"},
+		{`[1,`, "Unexpected end-of-input"},
+		{`{"a" 1}`, "invalid character '1' after object key"},
+		{`[1 2]`, "invalid character '2' after array element"},
+	}
+	for i, testCase := range testCases {
+		_, err := parseJson(testCase.Input)
+		if err == nil {
+			t.Errorf("%d: %s: expected error", i, testCase.Input)
+			continue
+		}
+		if err.Error() != testCase.Expected {
+			t.Errorf("%d: expected: %s, actual: %s", i, testCase.Expected, err.Error())
+		}
+	}
+}
+
+func TestJsonParser(t *testing.T) {
+	p := jsonParserOf(newJsonParser(`{"skip":{"x":[1,{}]},"value":{"a":1},"last":"z"} `))
+	kinds := []string{}
+	for {
+		token, err := p.nextToken()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err.Error())
+		}
+		if token == nil {
+			break
+		}
+		kinds = append(kinds, token.kind)
+		switch {
+		case token.kind == jsonStartObject && token.name == "skip":
+			p.skipChildren()
+			if p.current.kind != jsonEndObject || p.current.name != "skip" {
+				t.Errorf("expected end of skip, actual %s %s", p.current.kind, p.current.name)
+			}
+		case token.kind == jsonFieldName && token.text == "value":
+			value, err := p.readValue()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err.Error())
+			}
+			object, ok := value.(*jsonObject)
+			if !ok || len(object.keys) != 1 || object.keys[0] != "a" {
+				t.Errorf("expected {a:1}, actual %v", value)
+			}
+			if p.current.kind != jsonEndObject || p.current.name != "value" {
+				t.Errorf("expected end of value, actual %s %s", p.current.kind, p.current.name)
+			}
+		}
+	}
+	expected := []string{jsonStartObject, jsonFieldName, jsonStartObject, jsonFieldName, jsonFieldName, jsonString, jsonEndObject}
+	if strings.Join(kinds, " ") != strings.Join(expected, " ") {
+		t.Errorf("expected %v, actual %v", expected, kinds)
+	}
+
+	// tokens before the syntax error are read, and the error is returned after them
+	p = jsonParserOf(newJsonParser(`[1, 2 x]`))
+	for i := 0; i < 3; i++ {
+		if _, err := p.nextToken(); err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err.Error())
+		}
+	}
+	if _, err := p.nextToken(); err == nil {
+		t.Errorf("expected error")
+	}
+}
+
+func TestJsonWriter(t *testing.T) {
+	values := NewOrderedMap()
+	values.Put(NewString("name"), NewString("a\"<b>"))
+	values.Put(NewString("list"), CreateListObject(CreateListType(ObjectType), []*ast.Object{
+		NewInteger(1), NewDouble(1), NewBoolean(true), Null,
+	}))
+	values.Put(NewString("empty"), CreateMapObject(CreateMapType(StringType, ObjectType), NewOrderedMap()))
+	values.Put(NewString("date"), NewDatetime(time.Date(2020, 1, 5, 13, 4, 5, 123000000, time.UTC)))
+	object := CreateMapObject(CreateMapType(StringType, ObjectType), values)
+
+	testCases := []struct {
+		Pretty   bool
+		Expected string
+	}{
+		{false, `{"name":"a\"<b>","list":[1,1.0,true,null],"empty":{},"date":"2020-01-05T13:04:05.123Z"}`},
+		{true, "{\n  \"name\" : \"a\\\"<b>\",\n  \"list\" : [ 1, 1.0, true, null ],\n  \"empty\" : { },\n  \"date\" : \"2020-01-05T13:04:05.123Z\"\n}"},
+	}
+	for i, testCase := range testCases {
+		w := newJsonWriter(testCase.Pretty)
+		if err := w.writeObject(object, false); err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err.Error())
+			continue
+		}
+		if w.String() != testCase.Expected {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Expected, w.String())
+		}
+	}
+
+	w := newJsonWriter(false)
+	w.writeStartObject()
+	if err := w.writeRaw("1"); err == nil || err.Error() != "Can not write a value, expecting a field name" {
+		t.Errorf("expected field name error, actual %v", err)
+	}
+	if err := w.writeEndArray(); err == nil || err.Error() != "Current context not an array" {
+		t.Errorf("expected context error, actual %v", err)
+	}
+	w.writeEndObject()
+	if err := w.writeStartArray(); err == nil || err.Error() != "Can not write a value, expecting end of document" {
+		t.Errorf("expected end of document error, actual %v", err)
+	}
+}
+
+func TestFormatJsonDouble(t *testing.T) {
+	testCases := []struct {
+		Input    float64
+		Expected string
+	}{
+		{0, "0.0"},
+		{1, "1.0"},
+		{-2.5, "-2.5"},
+		{0.001, "0.001"},
+		{0.0001, "1.0E-4"},
+		{1234567, "1234567.0"},
+		{1e7, "1.0E7"},
+		{1.5e10, "1.5E10"},
+	}
+	for _, testCase := range testCases {
+		if actual := formatJsonDouble(testCase.Input); actual != testCase.Expected {
+			t.Errorf("%v: expected %s, actual %s", testCase.Input, testCase.Expected, actual)
+		}
+	}
+}
+
+func TestJsonToObject(t *testing.T) {
+	testCases := []struct {
+		Input     string
+		ClassType *ast.ClassType
+		Expected  string
+	}{
+		{`"12"`, IntegerType, "12"},
+		{`1.9`, IntegerType, "1"},
+		{`"1.50"`, DecimalType, "1.50"},
+		{`"true"`, BooleanType, "true"},
+		{`123`, StringType, "123"},
+		{`"2020-01-05T13:04:05.000+0900"`, DatetimeType, "2020-01-05T04:04:05.000Z"},
+		{`["a","b"]`, CreateListType(StringType), `["a","b"]`},
+		{`{"1":"a"}`, CreateMapType(IntegerType, StringType), `{"1":"a"}`},
+		{`null`, IntegerType, "null"},
+	}
+	for i, testCase := range testCases {
+		value, err := parseJson(testCase.Input)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err.Error())
+			continue
+		}
+		obj, err := jsonToObject(value, testCase.ClassType, false)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err.Error())
+			continue
+		}
+		w := newJsonWriter(false)
+		w.writeObject(obj, false)
+		actual := strings.Trim(w.String(), `"`)
+		if testCase.ClassType.Name == "List" || testCase.ClassType.Name == "Map" {
+			actual = w.String()
+		}
+		if actual != testCase.Expected {
+			t.Errorf("%d: %s: expected %s, actual %s", i, testCase.Input, testCase.Expected, actual)
+		}
+	}
+
+	errorCases := []struct {
+		Input     string
+		ClassType *ast.ClassType
+		Expected  string
+	}{
+		{`"abc"`, IntegerType, "Cannot deserialize instance of Integer from VALUE_STRING value"},
+		{`[1]`, StringType, "Cannot deserialize instance of String from START_ARRAY value"},
+		{`{"a":1}`, CreateListType(StringType), "Cannot deserialize instance of List<String> from START_OBJECT value"},
+		{`"2020-13-01"`, DateType, "Cannot deserialize instance of Date from value 2020-13-01"},
+	}
+	for i, testCase := range errorCases {
+		value, _ := parseJson(testCase.Input)
+		_, err := jsonToObject(value, testCase.ClassType, false)
+		if err == nil {
+			t.Errorf("%d: %s: expected error", i, testCase.Input)
+			continue
+		}
+		if err.Error() != testCase.Expected {
+			t.Errorf("%d: expected: %s, actual: %s", i, testCase.Expected, err.Error())
+		}
+	}
+}
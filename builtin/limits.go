@@ -0,0 +1,131 @@
+package builtin
+
+import (
+	"fmt"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// Limits holds governor limit usage of a transaction.
+// Each async job runs with its own Limits, and Test.startTest also starts fresh one.
+type Limits struct {
+	Async         bool
+	Queries       int
+	DmlStatements int
+	DmlRows       int
+	FutureCalls   int
+	QueueableJobs int
+}
+
+func NewLimits(async bool) *Limits {
+	return &Limits{Async: async}
+}
+
+func (l *Limits) LimitQueries() int {
+	if l.Async {
+		return 200
+	}
+	return 100
+}
+
+func (l *Limits) LimitDmlStatements() int {
+	return 150
+}
+
+func (l *Limits) LimitDmlRows() int {
+	return 10000
+}
+
+func (l *Limits) LimitFutureCalls() int {
+	return 50
+}
+
+func (l *Limits) LimitQueueableJobs() int {
+	if l.Async {
+		return 1
+	}
+	return 50
+}
+
+// AddQuery counts a SOQL query, and returns LimitException if it exceeds the limit
+func (l *Limits) AddQuery() *ast.Object {
+	l.Queries++
+	if l.Queries > l.LimitQueries() {
+		return NewException(LimitExceptionType, fmt.Sprintf("Too many SOQL queries: %d", l.Queries))
+	}
+	return nil
+}
+
+// AddDml counts a DML statement and its rows, and returns LimitException if it exceeds the limit
+func (l *Limits) AddDml(rows int) *ast.Object {
+	l.DmlStatements++
+	l.DmlRows += rows
+	if l.DmlStatements > l.LimitDmlStatements() {
+		return NewException(LimitExceptionType, fmt.Sprintf("Too many DML statements: %d", l.DmlStatements))
+	}
+	if l.DmlRows > l.LimitDmlRows() {
+		return NewException(LimitExceptionType, fmt.Sprintf("Too many DML rows: %d", l.DmlRows))
+	}
+	return nil
+}
+
+func (l *Limits) AddFutureCall() *ast.Object {
+	l.FutureCalls++
+	if l.FutureCalls > l.LimitFutureCalls() {
+		return NewException(LimitExceptionType, fmt.Sprintf("Too many future calls: %d", l.FutureCalls))
+	}
+	return nil
+}
+
+func (l *Limits) AddQueueableJob() *ast.Object {
+	l.QueueableJobs++
+	if l.QueueableJobs > l.LimitQueueableJobs() {
+		return NewException(LimitExceptionType, fmt.Sprintf("Too many queueable jobs added to the queue: %d", l.QueueableJobs))
+	}
+	return nil
+}
+
+// LimitExceptionType can not be caught by catch clause
+var LimitExceptionType = CreateExceptionType("LimitException")
+
+func init() {
+	staticMethods := ast.NewMethodMap()
+	limitsType := ast.CreateClass(
+		"Limits",
+		[]*ast.Method{},
+		ast.NewMethodMap(),
+		staticMethods,
+	)
+
+	counters := map[string]func(l *Limits) int{
+		"getQueries":            func(l *Limits) int { return l.Queries },
+		"getLimitQueries":       func(l *Limits) int { return l.LimitQueries() },
+		"getDmlStatements":      func(l *Limits) int { return l.DmlStatements },
+		"getLimitDmlStatements": func(l *Limits) int { return l.LimitDmlStatements() },
+		"getDmlRows":            func(l *Limits) int { return l.DmlRows },
+		"getLimitDmlRows":       func(l *Limits) int { return l.LimitDmlRows() },
+		"getFutureCalls":        func(l *Limits) int { return l.FutureCalls },
+		"getLimitFutureCalls":   func(l *Limits) int { return l.LimitFutureCalls() },
+		"getQueueableJobs":      func(l *Limits) int { return l.QueueableJobs },
+		"getLimitQueueableJobs": func(l *Limits) int { return l.LimitQueueableJobs() },
+	}
+	for name, counter := range counters {
+		counter := counter
+		staticMethods.Set(
+			name,
+			[]*ast.Method{
+				ast.CreateMethod(
+					name,
+					IntegerType,
+					[]*ast.Parameter{},
+					func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+						return NewInteger(counter(extra["limits"].(*Limits)))
+					},
+				),
+			},
+		)
+	}
+
+	primitiveClassMap.Set("Limits", limitsType)
+	primitiveClassMap.Set("LimitException", LimitExceptionType)
+}
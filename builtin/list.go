@@ -1,7 +1,7 @@
 package builtin
 
 import (
-	"sort"
+	"fmt"
 
 	"github.com/tzmfreedom/land/ast"
 )
@@ -14,6 +14,7 @@ var ListTypeParameter = &ast.Parameter{
 }
 
 func CreateListType(classType *ast.ClassType) *ast.ClassType {
+	ensureListType()
 	return &ast.ClassType{
 		Name:            "List",
 		Modifiers:       ListType.Modifiers,
@@ -41,8 +42,9 @@ func CreateListTypeParameter(classType *ast.ClassType) *ast.Parameter {
 	}
 }
 
+// CreateListObject creates list object of the list type, such as CreateListType(StringType)
 func CreateListObject(classType *ast.ClassType, records []*ast.Object) *ast.Object {
-	listObj := ast.CreateObject(ListType)
+	listObj := ast.CreateObject(classType)
 	listObj.Extra["records"] = records
 	return listObj
 }
@@ -70,6 +72,18 @@ var t2TypeRef = &ast.TypeRef{
 	Parameters: []*ast.TypeRef{},
 }
 
+var listTypeCreated = false
+
+// ensureListType creates methods of List before use,
+// list types may be created by other builtin types before init function of this file.
+func ensureListType() {
+	if listTypeCreated {
+		return
+	}
+	listTypeCreated = true
+	createListType()
+}
+
 func createListType() {
 	instanceMethods := ast.NewMethodMap()
 	instanceMethods.Set(
@@ -86,6 +100,226 @@ func createListType() {
 					return nil
 				},
 			),
+			ast.CreateMethod(
+				"add",
+				nil,
+				[]*ast.Parameter{IntegerTypeParameter, t1Parameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := this.Extra["records"].([]*ast.Object)
+					index := params[0].IntegerValue()
+					if index < 0 || index > len(records) {
+						return listIndexOutOfBounds(index)
+					}
+					newRecords := make([]*ast.Object, 0, len(records)+1)
+					newRecords = append(newRecords, records[:index]...)
+					newRecords = append(newRecords, params[1])
+					this.Extra["records"] = append(newRecords, records[index:]...)
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"addAll",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"addAll",
+				nil,
+				[]*ast.Parameter{CreateListTypeParameter(T1type)},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := this.Extra["records"].([]*ast.Object)
+					this.Extra["records"] = append(records, iterableElements(params[0])...)
+					return nil
+				},
+			),
+			ast.CreateMethod(
+				"addAll",
+				nil,
+				[]*ast.Parameter{CreateSetTypeParameter(T1type)},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := this.Extra["records"].([]*ast.Object)
+					this.Extra["records"] = append(records, iterableElements(params[0])...)
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"get",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"get",
+				T1type,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := this.Extra["records"].([]*ast.Object)
+					index := params[0].IntegerValue()
+					if index < 0 || index >= len(records) {
+						return listIndexOutOfBounds(index)
+					}
+					return records[index]
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"set",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"set",
+				nil,
+				[]*ast.Parameter{IntegerTypeParameter, t1Parameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := this.Extra["records"].([]*ast.Object)
+					index := params[0].IntegerValue()
+					if index < 0 || index >= len(records) {
+						return listIndexOutOfBounds(index)
+					}
+					records[index] = params[1]
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"remove",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"remove",
+				T1type,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := this.Extra["records"].([]*ast.Object)
+					index := params[0].IntegerValue()
+					if index < 0 || index >= len(records) {
+						return listIndexOutOfBounds(index)
+					}
+					removed := records[index]
+					newRecords := make([]*ast.Object, 0, len(records)-1)
+					newRecords = append(newRecords, records[:index]...)
+					this.Extra["records"] = append(newRecords, records[index+1:]...)
+					return removed
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"indexOf",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"indexOf",
+				IntegerType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					checker := extra["interpreter"].(EqualChecker)
+					for i, record := range this.Extra["records"].([]*ast.Object) {
+						if checker.Equals(record, params[0]) {
+							return NewInteger(i)
+						}
+					}
+					return NewInteger(-1)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"isEmpty",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isEmpty",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(len(this.Extra["records"].([]*ast.Object)) == 0)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"clear",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"clear",
+				nil,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					this.Extra["records"] = []*ast.Object{}
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"clone",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"clone",
+				CreateListType(T1type),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := this.Extra["records"].([]*ast.Object)
+					newRecords := make([]*ast.Object, len(records))
+					copy(newRecords, records)
+					return CreateListObject(this.ClassType, newRecords)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"deepClone",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"deepClone",
+				CreateListType(T1type),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return deepCloneList(this, false, false)
+				},
+			),
+			ast.CreateMethod(
+				"deepClone",
+				CreateListType(T1type),
+				[]*ast.Parameter{booleanTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return deepCloneList(this, params[0].BoolValue(), false)
+				},
+			),
+			ast.CreateMethod(
+				"deepClone",
+				CreateListType(T1type),
+				[]*ast.Parameter{booleanTypeParameter, booleanTypeParameter, booleanTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return deepCloneList(this, params[0].BoolValue(), params[1].BoolValue())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"equals",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"equals",
+				BooleanType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					other := params[0]
+					if other == Null || other.ClassType.Name != "List" {
+						return NewBoolean(false)
+					}
+					records := this.Extra["records"].([]*ast.Object)
+					otherRecords := other.Extra["records"].([]*ast.Object)
+					if len(records) != len(otherRecords) {
+						return NewBoolean(false)
+					}
+					checker := extra["interpreter"].(EqualChecker)
+					for i, record := range records {
+						if !checker.Equals(record, otherRecords[i]) {
+							return NewBoolean(false)
+						}
+					}
+					return NewBoolean(true)
+				},
+			),
 		},
 	)
 	instanceMethods.Set(
@@ -97,9 +331,43 @@ func createListType() {
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
 					records := this.Extra["records"].([]*ast.Object)
-					sort.SliceStable(records, func(i, j int) bool {
-						return String(records[i]) < String(records[j])
-					})
+					err := sortRecords(records, func(o, other *ast.Object) (int, error) {
+						return compareObjects(o, other, extra)
+					}, extra)
+					if err != nil {
+						return err
+					}
+					return nil
+				},
+			),
+			ast.CreateMethod(
+				"sort",
+				nil,
+				[]*ast.Parameter{
+					{
+						Type: CreateComparatorType(T1type),
+						Name: "_",
+					},
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					records := this.Extra["records"].([]*ast.Object)
+					if params[0] == Null {
+						return NewNullPointerException("Comparator")
+					}
+					caller := extra["interpreter"].(MethodCaller)
+					err := sortRecords(records, func(o, other *ast.Object) (int, error) {
+						r, err := caller.CallMethod(params[0], "compare", []*ast.Object{o, other})
+						if err != nil {
+							return 0, err
+						}
+						if r == Null {
+							return 0, NewExceptionError(NewException(ListExceptionType, "compare must return Integer"))
+						}
+						return r.IntegerValue(), nil
+					}, extra)
+					if err != nil {
+						return err
+					}
 					return nil
 				},
 			),
@@ -159,17 +427,18 @@ func createListType() {
 				},
 			},
 			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				listObj := params[0]
-				listParams := listObj.Extra["records"].([]*ast.Object)
-				newListParams := make([]*ast.Object, len(listParams))
-				for i, listParam := range listParams {
-					newListParams[i] = &ast.Object{
-						ClassType: listParam.ClassType,
-					}
-				}
-				this.Extra = map[string]interface{}{
-					"records": newListParams,
-				}
+				records := iterableElements(params[0])
+				newRecords := make([]*ast.Object, len(records))
+				copy(newRecords, records)
+				this.Extra["records"] = newRecords
+				return nil
+			},
+		},
+		{
+			Modifiers:  []*ast.Modifier{ast.PublicModifier()},
+			Parameters: []*ast.Parameter{CreateSetTypeParameter(T1type)},
+			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				this.Extra["records"] = iterableElements(params[0])
 				return nil
 			},
 		},
@@ -180,7 +449,43 @@ func createListType() {
 	ListType.StaticMethods = ast.NewMethodMap()
 }
 
+func listIndexOutOfBounds(index int) *ast.Object {
+	return Raise(ListExceptionType, fmt.Sprintf("List index out of bounds: %d", index))
+}
+
+// deepCloneList copies the list and its SObject records.
+// Id and audit fields of the records are cleared unless they are preserved.
+func deepCloneList(list *ast.Object, preserveId, preserveTimestamps bool) *ast.Object {
+	records := list.Extra["records"].([]*ast.Object)
+	newRecords := make([]*ast.Object, len(records))
+	for i, record := range records {
+		if record == Null || record.ClassType.SuperClass != SObjectType {
+			newRecords[i] = record
+			continue
+		}
+		newRecord := ast.CreateObject(record.ClassType)
+		for name, value := range record.InstanceFields.All() {
+			newRecord.InstanceFields.Set(name, value)
+		}
+		for key, value := range record.Extra {
+			newRecord.Extra[key] = value
+		}
+		if !preserveId {
+			newRecord.InstanceFields.Set("Id", Null)
+		}
+		if !preserveTimestamps {
+			for _, name := range []string{"CreatedDate", "CreatedById", "LastModifiedDate", "LastModifiedById", "SystemModstamp"} {
+				if _, ok := newRecord.InstanceFields.Get(name); ok {
+					newRecord.InstanceFields.Set(name, Null)
+				}
+			}
+		}
+		newRecords[i] = newRecord
+	}
+	return CreateListObject(list.ClassType, newRecords)
+}
+
 func init() {
-	createListType()
+	ensureListType()
 	primitiveClassMap.Set("list", ListType)
 }
@@ -58,11 +58,11 @@ func init() {
 				LongType,
 				[]*ast.Parameter{stringTypeParameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					value, err := strconv.Atoi(params[0].StringValue())
+					value, err := strconv.ParseInt(params[0].StringValue(), 10, 64)
 					if err != nil {
-						panic(err)
+						return Raise(TypeExceptionType, fmt.Sprintf("Invalid long: %s", params[0].StringValue()))
 					}
-					return NewLong(value)
+					return NewLong(int(value))
 				},
 			),
 		},
@@ -7,7 +7,15 @@ import (
 	"github.com/tzmfreedom/land/ast"
 )
 
-var MapType = createMapType()
+// MapType is allocated before init because Map types are copied by other builtin types,
+// they share methods with this type.
+var MapType = &ast.ClassType{
+	Name:            "Map",
+	InstanceFields:  ast.NewFieldMap(),
+	InstanceMethods: ast.NewMethodMap(),
+	StaticFields:    ast.NewFieldMap(),
+	StaticMethods:   ast.NewMethodMap(),
+}
 
 func CreateMapType(keyClass, valueClass *ast.ClassType) *ast.ClassType {
 	return &ast.ClassType{
@@ -23,8 +31,31 @@ func CreateMapType(keyClass, valueClass *ast.ClassType) *ast.ClassType {
 	}
 }
 
-func createMapType() *ast.ClassType {
-	instanceMethods := ast.NewMethodMap()
+// CreateMapObject creates map object of the map type with the entries
+func CreateMapObject(classType *ast.ClassType, values *OrderedMap) *ast.Object {
+	mapObj := ast.CreateObject(classType)
+	mapObj.Extra["values"] = values
+	return mapObj
+}
+
+func mapValues(o *ast.Object) *OrderedMap {
+	return o.Extra["values"].(*OrderedMap)
+}
+
+// putSObjects puts the records to the map by their Id, such as new Map<Id, Account>(accounts)
+func putSObjects(values *OrderedMap, records []*ast.Object) interface{} {
+	for i, record := range records {
+		id, ok := record.InstanceFields.Get("Id")
+		if !ok || id == Null {
+			return Raise(ListExceptionType, fmt.Sprintf("Row with null Id at index: %d", i))
+		}
+		values.Put(id, record)
+	}
+	return nil
+}
+
+func createMapType() {
+	instanceMethods := MapType.InstanceMethods
 	instanceMethods.Set(
 		"get",
 		[]*ast.Method{
@@ -33,9 +64,7 @@ func createMapType() *ast.ClassType {
 				T2type,
 				[]*ast.Parameter{t1Parameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					key := params[0].StringValue()
-					values := this.Extra["values"].(map[string]*ast.Object)
-					if v := values[key]; v != nil {
+					if v, ok := mapValues(this).Get(params[0]); ok {
 						return v
 					}
 					return Null
@@ -51,12 +80,73 @@ func createMapType() *ast.ClassType {
 				T2type,
 				[]*ast.Parameter{t1Parameter, t2Parameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					key := params[0].StringValue()
-					values := this.Extra["values"].(map[string]*ast.Object)
-					values[key] = params[1]
+					if prev, ok := mapValues(this).Put(params[0], params[1]); ok {
+						return prev
+					}
+					return Null
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"putAll",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"putAll",
+				nil,
+				[]*ast.Parameter{
+					{
+						Type: CreateMapType(T1type, T2type),
+						Name: "_",
+					},
+				},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					values := mapValues(this)
+					other := mapValues(params[0])
+					for _, key := range other.Keys() {
+						value, _ := other.Get(key)
+						values.Put(key, value)
+					}
 					return nil
 				},
 			),
+			ast.CreateMethod(
+				"putAll",
+				nil,
+				[]*ast.Parameter{CreateListTypeParameter(SObjectType)},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return putSObjects(mapValues(this), params[0].Extra["records"].([]*ast.Object))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"remove",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"remove",
+				T2type,
+				[]*ast.Parameter{t1Parameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if removed, ok := mapValues(this).Remove(params[0]); ok {
+						return removed
+					}
+					return Null
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"containsKey",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"containsKey",
+				BooleanType,
+				[]*ast.Parameter{t1Parameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(mapValues(this).ContainsKey(params[0]))
+				},
+			),
 		},
 	)
 	instanceMethods.Set(
@@ -64,10 +154,23 @@ func createMapType() *ast.ClassType {
 		[]*ast.Method{
 			ast.CreateMethod(
 				"size",
-				nil,
+				IntegerType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					return NewInteger(len(this.Extra["values"].(map[string]*ast.Object)))
+					return NewInteger(mapValues(this).Size())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"isEmpty",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isEmpty",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(mapValues(this).Size() == 0)
 				},
 			),
 		},
@@ -77,48 +180,131 @@ func createMapType() *ast.ClassType {
 		[]*ast.Method{
 			ast.CreateMethod(
 				"keySet",
+				CreateSetType(T1type),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return CreateSetObject(CreateSetType(this.ClassType.Generics[0]), mapValues(this).Keys())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"values",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"values",
+				CreateListType(T2type),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return CreateListObject(CreateListType(this.ClassType.Generics[1]), mapValues(this).Values())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"clear",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"clear",
 				nil,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					keySets := map[string]struct{}{}
-					for key, _ := range this.Extra["values"].(map[string]*ast.Object) {
-						keySets[key] = struct{}{}
+					this.Extra["values"] = NewOrderedMap()
+					return nil
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"clone",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"clone",
+				CreateMapType(T1type, T2type),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return CreateMapObject(this.ClassType, mapValues(this).Clone())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"equals",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"equals",
+				BooleanType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					other := params[0]
+					if other == Null || other.ClassType.Name != "Map" {
+						return NewBoolean(false)
+					}
+					values := mapValues(this)
+					otherValues := mapValues(other)
+					if values.Size() != otherValues.Size() {
+						return NewBoolean(false)
 					}
-					setClass, ok := PrimitiveClassMap().Get("Set")
-					if !ok {
-						panic("Set is not defined")
+					checker := extra["interpreter"].(EqualChecker)
+					for _, key := range values.Keys() {
+						value, _ := values.Get(key)
+						otherValue, ok := otherValues.Get(key)
+						if !ok || !checker.Equals(value, otherValue) {
+							return NewBoolean(false)
+						}
 					}
-					object := ast.CreateObject(setClass)
-					object.Extra["values"] = keySets
-					object.Extra["generices"] = nil // TODO: implement
-					return object
+					return NewBoolean(true)
 				},
 			),
 		},
 	)
 
-	classType := ast.CreateClass(
-		"Map",
-		[]*ast.Method{},
-		instanceMethods,
-		nil,
-	)
-	classType.ToString = func(o *ast.Object) string {
-		values := o.Extra["values"].(map[string]*ast.Object)
-		parameters := make([]string, len(values))
-		i := 0
-		for k, v := range values {
-			parameters[i] = fmt.Sprintf("%s => %s", k, String(v))
-			i++
+	MapType.Constructors = []*ast.Method{
+		{
+			Modifiers:  []*ast.Modifier{ast.PublicModifier()},
+			Parameters: []*ast.Parameter{},
+			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return nil
+			},
+		},
+		{
+			Modifiers: []*ast.Modifier{ast.PublicModifier()},
+			Parameters: []*ast.Parameter{
+				{
+					Type: CreateMapType(T1type, T2type),
+					Name: "_",
+				},
+			},
+			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				this.Extra["values"] = mapValues(params[0]).Clone()
+				return nil
+			},
+		},
+		{
+			Modifiers:  []*ast.Modifier{ast.PublicModifier()},
+			Parameters: []*ast.Parameter{CreateListTypeParameter(SObjectType)},
+			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				values := NewOrderedMap()
+				this.Extra["values"] = values
+				return putSObjects(values, params[0].Extra["records"].([]*ast.Object))
+			},
+		},
+	}
+	MapType.ToString = func(o *ast.Object) string {
+		values := mapValues(o)
+		parameters := make([]string, values.Size())
+		for i, k := range values.Keys() {
+			v, _ := values.Get(k)
+			parameters[i] = fmt.Sprintf("%s => %s", String(k), String(v))
 		}
 		if len(parameters) > 0 {
 			return fmt.Sprintf("<Map> { %s }", strings.Join(parameters, ", "))
 		}
 		return fmt.Sprintf("<Map> {}")
 	}
-	return classType
 }
 
 func init() {
+	createMapType()
 	primitiveClassMap.Set("Map", MapType)
 }
@@ -0,0 +1,75 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// valueEqualChecker compares primitive values for Map.equals
+type valueEqualChecker struct{}
+
+func (c *valueEqualChecker) Equals(o, other *ast.Object) bool {
+	return o.Value() == other.Value()
+}
+
+func TestMap(t *testing.T) {
+	newMap := func(keysAndValues ...*ast.Object) *ast.Object {
+		values := NewOrderedMap()
+		for i := 0; i < len(keysAndValues); i += 2 {
+			values.Put(keysAndValues[i], keysAndValues[i+1])
+		}
+		return CreateMapObject(CreateMapType(ObjectType, ObjectType), values)
+	}
+	account := &ast.ClassType{Name: "Account", SuperClass: SObjectType}
+	record := func(id *ast.Object, name string) *ast.Object {
+		obj := ast.CreateObject(account)
+		obj.InstanceFields.Set("Id", id)
+		obj.InstanceFields.Set("Name", NewString(name))
+		return obj
+	}
+	records := func(records ...*ast.Object) *ast.Object {
+		return CreateListObject(CreateListType(account), records)
+	}
+	one, two := NewInteger(1), NewInteger(2)
+	a, b := NewString("a"), NewString("b")
+	testCases := []struct {
+		Receiver *ast.Object
+		Method   string
+		Params   []*ast.Object
+		Expected string
+		Entries  string
+	}{
+		{newMap(one, a), "get", []*ast.Object{NewInteger(1)}, "a", "<Map> { 1 => a }"},
+		{newMap(one, a), "get", []*ast.Object{NewLong(1)}, "null", "<Map> { 1 => a }"},
+		{newMap(one, a), "get", []*ast.Object{NewString("1")}, "null", "<Map> { 1 => a }"},
+		{newMap(one, a), "put", []*ast.Object{one, b}, "a", "<Map> { 1 => b }"},
+		{newMap(two, a), "put", []*ast.Object{one, b}, "null", "<Map> { 2 => a, 1 => b }"},
+		{newMap(two, a), "put", []*ast.Object{Null, b}, "null", "<Map> { 2 => a, null => b }"},
+		{newMap(two, a, one, b), "putAll", []*ast.Object{newMap(NewInteger(3), a, two, b)}, "", "<Map> { 2 => b, 1 => b, 3 => a }"},
+		{newMap(), "putAll", []*ast.Object{records(record(NewString("001"), "x"))}, "", "<Map> { 001 => " + String(record(NewString("001"), "x")) + " }"},
+		{newMap(), "putAll", []*ast.Object{records(record(Null, "x"))}, "ListException: Row with null Id at index: 0", "<Map> {}"},
+		{newMap(one, a, two, b), "remove", []*ast.Object{one}, "a", "<Map> { 2 => b }"},
+		{newMap(one, a, two, b), "remove", []*ast.Object{NewLong(1)}, "null", "<Map> { 1 => a, 2 => b }"},
+		{newMap(one, a), "containsKey", []*ast.Object{one}, "true", "<Map> { 1 => a }"},
+		{newMap(record(Null, "x"), a), "containsKey", []*ast.Object{record(Null, "x")}, "true", "<Map> { " + String(record(Null, "x")) + " => a }"},
+		{newMap(record(Null, "x"), a), "containsKey", []*ast.Object{record(Null, "y")}, "false", "<Map> { " + String(record(Null, "x")) + " => a }"},
+		{newMap(one, a, two, b), "size", []*ast.Object{}, "2", "<Map> { 1 => a, 2 => b }"},
+		{newMap(), "isEmpty", []*ast.Object{}, "true", "<Map> {}"},
+		{newMap(two, a, one, b), "keySet", []*ast.Object{}, "<Set> { 2, 1 }", "<Map> { 2 => a, 1 => b }"},
+		{newMap(two, b, one, a), "values", []*ast.Object{}, String(CreateListObject(CreateListType(ObjectType), []*ast.Object{b, a})), "<Map> { 2 => b, 1 => a }"},
+		{newMap(one, a), "clear", []*ast.Object{}, "", "<Map> {}"},
+		{newMap(one, a, two, b), "equals", []*ast.Object{newMap(two, b, one, a)}, "true", "<Map> { 1 => a, 2 => b }"},
+		{newMap(one, a, two, b), "equals", []*ast.Object{newMap(one, a, two, a)}, "false", "<Map> { 1 => a, 2 => b }"},
+		{newMap(one, a), "equals", []*ast.Object{newMap(NewLong(1), a)}, "false", "<Map> { 1 => a }"},
+	}
+	for i, testCase := range testCases {
+		r := callNativeMethod(testCase.Receiver, testCase.Method, testCase.Params, &valueEqualChecker{})
+		if actual := resultString(r); actual != testCase.Expected {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Expected, actual)
+		}
+		if actual := String(testCase.Receiver); actual != testCase.Entries {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Entries, actual)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// OrderedMap holds entries of Map and elements of Set in insertion order, as the platform iterates them.
+// Primitive keys are compared by their values, SObjects by their fields, and other objects are compared by identity.
+type OrderedMap struct {
+	keys    []string
+	entries map[string]*orderedMapEntry
+}
+
+type orderedMapEntry struct {
+	key   *ast.Object
+	value *ast.Object
+}
+
+func NewOrderedMap() *OrderedMap {
+	return &OrderedMap{
+		keys:    []string{},
+		entries: map[string]*orderedMapEntry{},
+	}
+}
+
+// hashKey returns the key of the entry. The type of the key is a part of it, so Integer 1 and Long 1 are different keys.
+// SObjects are compared by their fields, and unset fields are equal to null fields.
+func hashKey(o *ast.Object) string {
+	if o == nil || o == Null {
+		return "\x00null"
+	}
+	if value := o.Value(); value != nil {
+		return fmt.Sprintf("%p\x00%v", o.ClassType, value)
+	}
+	if o.ClassType == SObjectType || o.ClassType.SuperClass == SObjectType {
+		fields := o.InstanceFields.All()
+		names := make([]string, 0, len(fields))
+		for name, field := range fields {
+			if field != nil && field != Null {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		key := fmt.Sprintf("%p", o.ClassType)
+		for _, name := range names {
+			key += fmt.Sprintf("\x00%s=%s", name, hashKey(fields[name]))
+		}
+		return key
+	}
+	return fmt.Sprintf("\x00%p", o)
+}
+
+func (m *OrderedMap) Get(key *ast.Object) (*ast.Object, bool) {
+	entry, ok := m.entries[hashKey(key)]
+	if !ok {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// Put sets the value for the key, and returns the previous value if exists.
+// The position of the existing key is not changed.
+func (m *OrderedMap) Put(key, value *ast.Object) (*ast.Object, bool) {
+	hash := hashKey(key)
+	if entry, ok := m.entries[hash]; ok {
+		prev := entry.value
+		entry.value = value
+		return prev, true
+	}
+	m.keys = append(m.keys, hash)
+	m.entries[hash] = &orderedMapEntry{key: key, value: value}
+	return nil, false
+}
+
+// Remove deletes the entry for the key, and returns the removed value if exists
+func (m *OrderedMap) Remove(key *ast.Object) (*ast.Object, bool) {
+	hash := hashKey(key)
+	entry, ok := m.entries[hash]
+	if !ok {
+		return nil, false
+	}
+	delete(m.entries, hash)
+	for i, k := range m.keys {
+		if k == hash {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			break
+		}
+	}
+	return entry.value, true
+}
+
+func (m *OrderedMap) ContainsKey(key *ast.Object) bool {
+	_, ok := m.entries[hashKey(key)]
+	return ok
+}
+
+func (m *OrderedMap) Keys() []*ast.Object {
+	keys := make([]*ast.Object, len(m.keys))
+	for i, hash := range m.keys {
+		keys[i] = m.entries[hash].key
+	}
+	return keys
+}
+
+func (m *OrderedMap) Values() []*ast.Object {
+	values := make([]*ast.Object, len(m.keys))
+	for i, hash := range m.keys {
+		values[i] = m.entries[hash].value
+	}
+	return values
+}
+
+func (m *OrderedMap) Size() int {
+	return len(m.keys)
+}
+
+// Clone returns shallow copy of the map
+func (m *OrderedMap) Clone() *OrderedMap {
+	c := NewOrderedMap()
+	for _, hash := range m.keys {
+		entry := m.entries[hash]
+		c.Put(entry.key, entry.value)
+	}
+	return c
+}
@@ -0,0 +1,89 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+func TestOrderedMapKey(t *testing.T) {
+	season := NewEnumType("Season", []string{"WINTER"})
+	month := NewEnumType("Month", []string{"WINTER"})
+	seasonWinter, _ := EnumValueOf(season, "WINTER")
+	monthWinter, _ := EnumValueOf(month, "WINTER")
+	account := &ast.ClassType{Name: "Account", SuperClass: SObjectType}
+	contact := &ast.ClassType{Name: "Contact", SuperClass: SObjectType}
+	record := func(classType *ast.ClassType, fields map[string]*ast.Object) *ast.Object {
+		obj := ast.CreateObject(classType)
+		for k, v := range fields {
+			obj.InstanceFields.Set(k, v)
+		}
+		return obj
+	}
+	fooType := &ast.ClassType{Name: "Foo"}
+	foo := ast.CreateObject(fooType)
+	testCases := []struct {
+		Key      *ast.Object
+		Other    *ast.Object
+		Expected bool
+	}{
+		{NewInteger(1), NewInteger(1), true},
+		{NewInteger(1), NewLong(1), false},
+		{NewInteger(1), NewString("1"), false},
+		{NewString("a"), NewString("A"), false},
+		{Null, Null, true},
+		{NewString("null"), Null, false},
+		{seasonWinter, seasonWinter, true},
+		{seasonWinter, monthWinter, false},
+		{seasonWinter, NewString("WINTER"), false},
+		// SObjects are compared by fields
+		{
+			record(account, map[string]*ast.Object{"Name": NewString("a"), "Amount": NewInteger(1)}),
+			record(account, map[string]*ast.Object{"amount": NewInteger(1), "Name": NewString("a")}),
+			true,
+		},
+		{record(account, map[string]*ast.Object{"Name": NewString("a")}), record(account, map[string]*ast.Object{"Name": NewString("b")}), false},
+		{record(account, map[string]*ast.Object{"Name": NewString("a"), "Amount": Null}), record(account, map[string]*ast.Object{"Name": NewString("a")}), true},
+		{record(account, map[string]*ast.Object{"Amount": NewInteger(1)}), record(account, map[string]*ast.Object{"Amount": NewLong(1)}), false},
+		{record(account, map[string]*ast.Object{"Name": NewString("a")}), record(contact, map[string]*ast.Object{"Name": NewString("a")}), false},
+		// other objects are compared by identity
+		{foo, foo, true},
+		{foo, ast.CreateObject(fooType), false},
+	}
+	for i, testCase := range testCases {
+		m := NewOrderedMap()
+		m.Put(testCase.Key, NewString("value"))
+		if actual := m.ContainsKey(testCase.Other); actual != testCase.Expected {
+			t.Errorf("%d: expected %t, actual %t", i, testCase.Expected, actual)
+		}
+	}
+}
+
+func TestOrderedMapOrder(t *testing.T) {
+	m := NewOrderedMap()
+	m.Put(NewString("c"), NewInteger(1))
+	m.Put(NewString("a"), NewInteger(2))
+	m.Put(NewString("b"), NewInteger(3))
+	if prev, ok := m.Put(NewString("a"), NewInteger(4)); !ok || prev.IntegerValue() != 2 {
+		t.Errorf("expected previous value 2, actual %v", prev)
+	}
+	if value, ok := m.Remove(NewString("c")); !ok || value.IntegerValue() != 1 {
+		t.Errorf("expected removed value 1, actual %v", value)
+	}
+	if _, ok := m.Remove(NewString("c")); ok {
+		t.Errorf("expected c is removed")
+	}
+	m.Put(NewString("c"), NewInteger(5))
+	expectedKeys := []string{"a", "b", "c"}
+	expectedValues := []int{4, 3, 5}
+	keys := m.Keys()
+	values := m.Values()
+	if m.Size() != len(expectedKeys) || len(keys) != len(expectedKeys) {
+		t.Fatalf("expected size %d, actual %d", len(expectedKeys), m.Size())
+	}
+	for i, key := range keys {
+		if key.StringValue() != expectedKeys[i] || values[i].IntegerValue() != expectedValues[i] {
+			t.Errorf("%d: expected %s => %d, actual %s => %d", i, expectedKeys[i], expectedValues[i], key.StringValue(), values[i].IntegerValue())
+		}
+	}
+}
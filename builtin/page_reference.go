@@ -69,8 +69,7 @@ func createPageReferenceType() {
 			Modifiers:  []*ast.Modifier{ast.PublicModifier()},
 			Parameters: []*ast.Parameter{stringTypeParameter},
 			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				parameters := ast.CreateObject(CreateMapType(StringType, StringType))
-				parameters.Extra["values"] = map[string]*ast.Object{}
+				parameters := CreateMapObject(CreateMapType(StringType, StringType), NewOrderedMap())
 				this.Extra = map[string]interface{}{
 					"url":        params[0],
 					"parameters": parameters,
@@ -1,30 +1,143 @@
 package builtin
 
 import (
-	"regexp"
+	"fmt"
 
 	"github.com/tzmfreedom/land/ast"
 )
 
+var PatternType = ast.CreateClass(
+	"Pattern",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var MatcherType = ast.CreateClass(
+	"Matcher",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+// compilePattern compiles the regex, invalid pattern raises StringException
+func compilePattern(pattern string) (*regex, *ast.Object) {
+	re, err := compileRegex(pattern)
+	if err != nil {
+		return nil, Raise(StringExceptionType, "Invalid regex: "+err.Error())
+	}
+	return re, nil
+}
+
+func newPattern(re *regex) *ast.Object {
+	obj := ast.CreateObject(PatternType)
+	obj.Extra["value"] = re
+	return obj
+}
+
+func patternOf(o *ast.Object) *regex {
+	return o.Extra["value"].(*regex)
+}
+
+func newMatcher(re *regex, input string) *ast.Object {
+	obj := ast.CreateObject(MatcherType)
+	obj.Extra["value"] = newRegexMatcher(re, input)
+	return obj
+}
+
+func matcherOf(o *ast.Object) *regexMatcher {
+	return o.Extra["value"].(*regexMatcher)
+}
+
+// checkGroup raises StringException if the matcher has no match or the group does not exist
+func checkGroup(m *regexMatcher, index int) *ast.Object {
+	if !m.matched {
+		return Raise(StringExceptionType, "No match found")
+	}
+	if index < 0 || index > m.re.groupCount {
+		return Raise(StringExceptionType, fmt.Sprintf("No group %d", index))
+	}
+	return nil
+}
+
+func matcherGroup(m *regexMatcher, index int) *ast.Object {
+	if err := checkGroup(m, index); err != nil {
+		return err
+	}
+	if text, ok := m.group(index); ok {
+		return NewString(text)
+	}
+	return Null
+}
+
+// matcherPosition returns start or end of the group, -1 if the group did not participate in the match
+func matcherPosition(m *regexMatcher, index int, end bool) *ast.Object {
+	if err := checkGroup(m, index); err != nil {
+		return err
+	}
+	if end {
+		return NewInteger(m.groups[index*2+1])
+	}
+	return NewInteger(m.groups[index*2])
+}
+
+func matcherReplace(this *ast.Object, params []*ast.Object, all bool, extra map[string]interface{}) interface{} {
+	if params[0] == Null {
+		return NewNullPointerException("String")
+	}
+	m := matcherOf(this)
+	m.check = nativeCheck(extra)
+	replaced, err := m.replace(params[0].StringValue(), all)
+	if err == nil {
+		return NewString(replaced)
+	}
+	if err == errRegexTooComplicated {
+		return Raise(LimitExceptionType, err.Error())
+	}
+	// the interruption and LimitException of the sandbox
+	if _, ok := err.(*ExceptionError); ok || err == ErrInterrupted {
+		return err
+	}
+	return Raise(StringExceptionType, err.Error())
+}
+
+// nativeCheck returns checkNative bound to the interpreter, for the operations which run without extra
+func nativeCheck(extra map[string]interface{}) func(size int) error {
+	return func(size int) error {
+		return checkNative(extra, size)
+	}
+}
+
+// matcherResult returns the result of the match operation, LimitException is raised if it exceeds the step budget
+func matcherResult(m *regexMatcher, matched bool) interface{} {
+	if m.exceeded {
+		return Raise(LimitExceptionType, errRegexTooComplicated.Error())
+	}
+	return NewBoolean(matched)
+}
+
 func init() {
-	instanceMethods := ast.NewMethodMap()
-	staticMethods := ast.NewMethodMap()
-	patternType := ast.CreateClass(
-		"Pattern",
+	staticMethods := PatternType.StaticMethods
+	staticMethods.Set(
+		"compile",
 		[]*ast.Method{
 			ast.CreateMethod(
-				"Pattern",
-				nil,
-				[]*ast.Parameter{},
+				"compile",
+				PatternType,
+				[]*ast.Parameter{stringTypeParameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					return nil
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
+					re, err := compilePattern(params[0].StringValue())
+					if err != nil {
+						return err
+					}
+					return newPattern(re)
 				},
 			),
 		},
-		instanceMethods,
-		staticMethods,
 	)
-
 	staticMethods.Set(
 		"matches",
 		[]*ast.Method{
@@ -36,17 +149,355 @@ func init() {
 					stringTypeParameter,
 				},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					regExp := params[0].StringValue()
-					stringtoMatch := params[1].StringValue()
-					m, err := regexp.MatchString(regExp, stringtoMatch)
+					if params[0] == Null || params[1] == Null {
+						return NewNullPointerException("String")
+					}
+					re, err := compilePattern(params[0].StringValue())
 					if err != nil {
-						panic(err) // TODO: impl
+						return err
+					}
+					m := newRegexMatcher(re, params[1].StringValue())
+					return matcherResult(m, m.matches())
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"quote",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"quote",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("String")
 					}
-					return NewBoolean(m)
+					return NewString(quoteRegex(params[0].StringValue()))
+				},
+			),
+		},
+	)
+
+	instanceMethods := PatternType.InstanceMethods
+	instanceMethods.Set(
+		"matcher",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"matcher",
+				MatcherType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
+					return newMatcher(patternOf(this), params[0].StringValue())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"pattern",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"pattern",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(patternOf(this).pattern)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"split",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"split",
+				CreateListType(StringType),
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
+					m := newRegexMatcher(patternOf(this), params[0].StringValue())
+					m.check = nativeCheck(extra)
+					parts, err := m.split()
+					if err == errRegexTooComplicated {
+						return Raise(LimitExceptionType, err.Error())
+					}
+					if err != nil {
+						return err
+					}
+					records := make([]*ast.Object, len(parts))
+					for i, part := range parts {
+						records[i] = NewString(part)
+					}
+					return CreateListObject(CreateListType(StringType), records)
+				},
+			),
+		},
+	)
+
+	staticMethods = MatcherType.StaticMethods
+	staticMethods.Set(
+		"quoteReplacement",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"quoteReplacement",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
+					return NewString(quoteRegexReplacement(params[0].StringValue()))
+				},
+			),
+		},
+	)
+
+	instanceMethods = MatcherType.InstanceMethods
+	instanceMethods.Set(
+		"matches",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"matches",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					m := matcherOf(this)
+					return matcherResult(m, m.matches())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"lookingAt",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"lookingAt",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					m := matcherOf(this)
+					return matcherResult(m, m.lookingAt())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"find",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"find",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					m := matcherOf(this)
+					return matcherResult(m, m.find())
+				},
+			),
+			ast.CreateMethod(
+				"find",
+				BooleanType,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					m := matcherOf(this)
+					start := params[0].IntegerValue()
+					if start < 0 || start > len(m.input) {
+						return Raise(StringExceptionType, fmt.Sprintf("Illegal start index: %d", start))
+					}
+					m.reset(m.input)
+					return matcherResult(m, m.search(start))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"group",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"group",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return matcherGroup(matcherOf(this), 0)
+				},
+			),
+			ast.CreateMethod(
+				"group",
+				StringType,
+				[]*ast.Parameter{IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return matcherGroup(matcherOf(this), params[0].IntegerValue())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"groupCount",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"groupCount",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(matcherOf(this).re.groupCount)
+				},
+			),
+		},
+	)
+	for _, name := range []string{"start", "end"} {
+		end := name == "end"
+		instanceMethods.Set(
+			name,
+			[]*ast.Method{
+				ast.CreateMethod(
+					name,
+					IntegerType,
+					[]*ast.Parameter{},
+					func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+						return matcherPosition(matcherOf(this), 0, end)
+					},
+				),
+				ast.CreateMethod(
+					name,
+					IntegerType,
+					[]*ast.Parameter{IntegerTypeParameter},
+					func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+						return matcherPosition(matcherOf(this), params[0].IntegerValue(), end)
+					},
+				),
+			},
+		)
+	}
+	instanceMethods.Set(
+		"replaceAll",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"replaceAll",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return matcherReplace(this, params, true, extra)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"replaceFirst",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"replaceFirst",
+				StringType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return matcherReplace(this, params, false, extra)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"hitEnd",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"hitEnd",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(matcherOf(this).hitEnd)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"reset",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"reset",
+				MatcherType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					m := matcherOf(this)
+					m.reset(m.input)
+					return this
+				},
+			),
+			ast.CreateMethod(
+				"reset",
+				MatcherType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("String")
+					}
+					matcherOf(this).reset(params[0].StringValue())
+					return this
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"region",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"region",
+				MatcherType,
+				[]*ast.Parameter{IntegerTypeParameter, IntegerTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					m := matcherOf(this)
+					start, end := params[0].IntegerValue(), params[1].IntegerValue()
+					if start < 0 || end < start || end > len(m.input) {
+						return Raise(StringExceptionType, fmt.Sprintf("Illegal region: %d, %d", start, end))
+					}
+					m.reset(m.input)
+					m.from, m.to, m.last = start, end, start
+					return this
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"regionStart",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"regionStart",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(matcherOf(this).from)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"regionEnd",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"regionEnd",
+				IntegerType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewInteger(matcherOf(this).to)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"pattern",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"pattern",
+				PatternType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return newPattern(matcherOf(this).re)
 				},
 			),
 		},
 	)
 
-	primitiveClassMap.Set("Pattern", patternType)
+	primitiveClassMap.Set("Pattern", PatternType)
+	primitiveClassMap.Set("Matcher", MatcherType)
 }
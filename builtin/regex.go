@@ -0,0 +1,1544 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// regex is a backtracking regular expression engine of the Java dialect which Apex Pattern and Matcher use.
+// Go regexp (RE2) does not support backreferences, lookaround, atomic groups and possessive quantifiers,
+// so the pattern is compiled into nodes which match with continuations.
+// Positions are byte offsets of the input as other String methods of land.
+type regex struct {
+	pattern    string
+	root       regexNode
+	groupCount int
+	groupNames map[string]int
+}
+
+// Flags of the pattern, which are specified by embedded flag expressions such as (?i)
+const (
+	regexCaseInsensitive = 1 << iota
+	regexMultiline
+	regexDotAll
+	regexComments
+	regexUnicodeCase
+	regexUnixLines
+)
+
+var regexFlagChars = map[rune]int{
+	'i': regexCaseInsensitive,
+	'm': regexMultiline,
+	's': regexDotAll,
+	'x': regexComments,
+	'u': regexUnicodeCase,
+	'd': regexUnixLines,
+}
+
+// regexSyntaxError is the error of the pattern, its message is formatted as java.util.regex.PatternSyntaxException
+type regexSyntaxError struct {
+	description string
+	pattern     string
+	index       int
+}
+
+func (e *regexSyntaxError) Error() string {
+	message := e.description
+	if e.index >= 0 {
+		message += fmt.Sprintf(" near index %d", e.index)
+	}
+	message += "\n" + e.pattern
+	if e.index >= 0 {
+		message += "\n" + strings.Repeat(" ", e.index) + "^"
+	}
+	return message
+}
+
+// compileRegex compiles the pattern of Java regex syntax
+func compileRegex(pattern string) (*regex, error) {
+	p := &regexParser{
+		src:     []rune(pattern),
+		pattern: pattern,
+		names:   map[string]int{},
+	}
+	root, err := p.parseAlternation()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.src) {
+		// alternation stops only at unmatched ')'
+		return nil, p.error("Unmatched closing ')'", p.pos-1)
+	}
+	return &regex{
+		pattern:    pattern,
+		root:       root,
+		groupCount: p.groupCount,
+		groupNames: p.names,
+	}, nil
+}
+
+// quoteRegex returns the literal pattern of the string as Pattern.quote does
+func quoteRegex(s string) string {
+	if !strings.Contains(s, `\E`) {
+		return `\Q` + s + `\E`
+	}
+	return `\Q` + strings.Replace(s, `\E`, `\E\\E\Q`, -1) + `\E`
+}
+
+// regexStepLimit is the number of steps which a match operation can take, as Salesforce limits regex with catastrophic backtracking
+const regexStepLimit = 1000000
+
+// errRegexTooComplicated is returned if a match operation exceeds regexStepLimit
+var errRegexTooComplicated = errors.New("Regex too complicated")
+
+// regexMatcher holds the input and the state of matching
+type regexMatcher struct {
+	re     *regex
+	input  string
+	from   int
+	to     int
+	groups []int
+	// matched is true if the last match operation succeeded
+	matched bool
+	// last is the end of the previous match, where \G matches
+	last   int
+	hitEnd bool
+	// steps counts the steps of the current match operation, exceeded is true if it exceeds regexStepLimit
+	steps    int
+	exceeded bool
+	// check is called between the matches of replace and split with the bytes of the result, it stops them by an error,
+	// such as the interruption of the interpreter
+	check func(size int) error
+}
+
+func newRegexMatcher(re *regex, input string) *regexMatcher {
+	m := &regexMatcher{re: re}
+	m.reset(input)
+	return m
+}
+
+func (m *regexMatcher) reset(input string) {
+	m.input = input
+	m.from = 0
+	m.to = len(input)
+	m.matched = false
+	m.last = 0
+	m.hitEnd = false
+	m.groups = nil
+	m.exceeded = false
+}
+
+// start begins a match operation with the step budget
+func (m *regexMatcher) start() {
+	m.hitEnd = false
+	m.steps = 0
+	m.exceeded = false
+}
+
+// step counts a step of matching, it returns false if the operation exceeds the budget and the match must fail
+func (m *regexMatcher) step() bool {
+	m.steps++
+	if m.steps > regexStepLimit {
+		m.exceeded = true
+	}
+	return !m.exceeded
+}
+
+func (m *regexMatcher) clearGroups() {
+	m.groups = make([]int, (m.re.groupCount+1)*2)
+	for i := range m.groups {
+		m.groups[i] = -1
+	}
+}
+
+// matchAt matches the pattern at the position, end is called with the end of the match
+func (m *regexMatcher) matchAt(start int, end func(int) bool) bool {
+	m.clearGroups()
+	if m.re.root.match(m, start, func(j int) bool {
+		if !end(j) {
+			return false
+		}
+		m.groups[0] = start
+		m.groups[1] = j
+		return true
+	}) {
+		m.matched = true
+		m.last = m.groups[1]
+		return true
+	}
+	m.matched = false
+	m.groups = nil
+	return false
+}
+
+// matches matches the entire region
+func (m *regexMatcher) matches() bool {
+	m.start()
+	return m.matchAt(m.from, func(j int) bool { return j == m.to })
+}
+
+// lookingAt matches the beginning of the region
+func (m *regexMatcher) lookingAt() bool {
+	m.start()
+	return m.matchAt(m.from, func(j int) bool { return true })
+}
+
+// find searches the next match, it starts after the previous match.
+// The search after an empty match starts at the next character.
+func (m *regexMatcher) find() bool {
+	next := m.last
+	if m.matched && m.groups[0] == m.groups[1] {
+		if next >= m.to {
+			m.matched = false
+			m.groups = nil
+			return false
+		}
+		_, width := utf8.DecodeRuneInString(m.input[next:m.to])
+		next += width
+	}
+	if next < m.from {
+		next = m.from
+	}
+	return m.search(next)
+}
+
+// search searches a match from the position, it fails if the search exceeds the step budget
+func (m *regexMatcher) search(start int) bool {
+	m.start()
+	g := m.last
+	for i := start; i <= m.to; {
+		m.last = g
+		if m.matchAt(i, func(j int) bool { return true }) {
+			return true
+		}
+		if i == m.to || m.exceeded {
+			break
+		}
+		_, width := utf8.DecodeRuneInString(m.input[i:m.to])
+		i += width
+	}
+	m.last = g
+	m.matched = false
+	m.groups = nil
+	return false
+}
+
+// group returns the matched text of the group, ok is false if the group did not participate in the match
+func (m *regexMatcher) group(index int) (string, bool) {
+	start, end := m.groups[index*2], m.groups[index*2+1]
+	if start < 0 {
+		return "", false
+	}
+	return m.input[start:end], true
+}
+
+// replace replaces matches by the replacement, which refers groups as $n or ${name}.
+// Only the first match is replaced if all is false.
+// errRegexTooComplicated is returned if a search exceeds the step budget.
+func (m *regexMatcher) replace(replacement string, all bool) (string, error) {
+	m.reset(m.input)
+	var buf strings.Builder
+	appended := 0
+	for m.find() {
+		text, err := m.expandReplacement(replacement)
+		if err != nil {
+			return "", err
+		}
+		if err := m.checkResult(buf.Len() + len(text)); err != nil {
+			return "", err
+		}
+		buf.WriteString(m.input[appended:m.groups[0]])
+		buf.WriteString(text)
+		appended = m.groups[1]
+		if !all {
+			break
+		}
+	}
+	if m.exceeded {
+		return "", errRegexTooComplicated
+	}
+	buf.WriteString(m.input[appended:])
+	return buf.String(), nil
+}
+
+func (m *regexMatcher) expandReplacement(replacement string) (string, error) {
+	var buf strings.Builder
+	src := []rune(replacement)
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		switch c {
+		case '\\':
+			i++
+			if i >= len(src) {
+				return "", fmt.Errorf("character to be escaped is missing")
+			}
+			buf.WriteRune(src[i])
+		case '$':
+			i++
+			if i >= len(src) {
+				return "", fmt.Errorf("Illegal group reference: group index is missing")
+			}
+			index := 0
+			if src[i] == '{' {
+				end := i + 1
+				for end < len(src) && src[end] != '}' {
+					end++
+				}
+				if end >= len(src) {
+					return "", fmt.Errorf("named capturing group is missing trailing '}'")
+				}
+				name := string(src[i+1 : end])
+				var ok bool
+				if index, ok = m.re.groupNames[name]; !ok {
+					return "", fmt.Errorf("No group with name {%s}", name)
+				}
+				i = end
+			} else {
+				if src[i] < '0' || src[i] > '9' {
+					return "", fmt.Errorf("Illegal group reference")
+				}
+				index = int(src[i] - '0')
+				if index > m.re.groupCount {
+					return "", fmt.Errorf("No group %d", index)
+				}
+				// more digits are consumed while the group exists
+				for i+1 < len(src) && src[i+1] >= '0' && src[i+1] <= '9' {
+					next := index*10 + int(src[i+1]-'0')
+					if next > m.re.groupCount {
+						break
+					}
+					index = next
+					i++
+				}
+			}
+			if text, ok := m.group(index); ok {
+				buf.WriteString(text)
+			}
+		default:
+			buf.WriteRune(c)
+		}
+	}
+	return buf.String(), nil
+}
+
+// split splits the input around matches as Pattern.split does, trailing empty strings are removed
+func (m *regexMatcher) split() ([]string, error) {
+	m.reset(m.input)
+	parts := []string{}
+	index := 0
+	for m.find() {
+		if err := m.checkResult(len(parts) * SlotSize); err != nil {
+			return nil, err
+		}
+		// zero-width match at the beginning never produces empty leading substring
+		if index == 0 && m.groups[0] == 0 && m.groups[1] == 0 {
+			continue
+		}
+		parts = append(parts, m.input[index:m.groups[0]])
+		index = m.groups[1]
+	}
+	if m.exceeded {
+		return nil, errRegexTooComplicated
+	}
+	if index == 0 {
+		return []string{m.input}, nil
+	}
+	parts = append(parts, m.input[index:])
+	for len(parts) > 0 && parts[len(parts)-1] == "" {
+		parts = parts[:len(parts)-1]
+	}
+	return parts, nil
+}
+
+func (m *regexMatcher) checkResult(size int) error {
+	if m.check == nil {
+		return nil
+	}
+	return m.check(size)
+}
+
+// quoteRegexReplacement returns the literal replacement string as Matcher.quoteReplacement does
+func quoteRegexReplacement(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `$`, `\$`).Replace(s)
+}
+
+func (m *regexMatcher) runeAt(i int) (rune, int) {
+	if i >= m.to {
+		m.hitEnd = true
+		return 0, 0
+	}
+	return utf8.DecodeRuneInString(m.input[i:m.to])
+}
+
+func (m *regexMatcher) runeBefore(i int) (rune, int) {
+	if i <= m.from {
+		return 0, 0
+	}
+	return utf8.DecodeLastRuneInString(m.input[m.from:i])
+}
+
+// regexNode is a compiled node of the pattern.
+// match calls k with the position after the node for each way to match, and returns true if k returns true.
+type regexNode interface {
+	match(m *regexMatcher, i int, k func(int) bool) bool
+	// width returns the minimum and maximum length in bytes, max is -1 if unbounded
+	width() (int, int)
+}
+
+// regexChar matches a character which satisfies the predicate, such as literal, class and dot
+type regexChar struct {
+	pred     func(rune) bool
+	min, max int
+}
+
+func (n *regexChar) match(m *regexMatcher, i int, k func(int) bool) bool {
+	if !m.step() {
+		return false
+	}
+	r, width := m.runeAt(i)
+	if width == 0 || !n.pred(r) {
+		return false
+	}
+	return k(i + width)
+}
+
+func (n *regexChar) width() (int, int) {
+	return n.min, n.max
+}
+
+type regexSequence struct {
+	nodes []regexNode
+}
+
+func (n *regexSequence) match(m *regexMatcher, i int, k func(int) bool) bool {
+	return n.matchFrom(0, m, i, k)
+}
+
+func (n *regexSequence) matchFrom(index int, m *regexMatcher, i int, k func(int) bool) bool {
+	if index == len(n.nodes) {
+		return k(i)
+	}
+	return n.nodes[index].match(m, i, func(j int) bool {
+		return n.matchFrom(index+1, m, j, k)
+	})
+}
+
+func (n *regexSequence) width() (int, int) {
+	min, max := 0, 0
+	for _, node := range n.nodes {
+		nmin, nmax := node.width()
+		min += nmin
+		if max >= 0 {
+			if nmax < 0 {
+				max = -1
+			} else {
+				max += nmax
+			}
+		}
+	}
+	return min, max
+}
+
+type regexAlternation struct {
+	alternatives []regexNode
+}
+
+func (n *regexAlternation) match(m *regexMatcher, i int, k func(int) bool) bool {
+	for _, alternative := range n.alternatives {
+		if alternative.match(m, i, k) {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *regexAlternation) width() (int, int) {
+	min, max := -1, 0
+	for _, alternative := range n.alternatives {
+		amin, amax := alternative.width()
+		if min < 0 || amin < min {
+			min = amin
+		}
+		if max >= 0 && (amax < 0 || amax > max) {
+			max = amax
+		}
+	}
+	return min, max
+}
+
+// regexGroup is a capturing group
+type regexGroup struct {
+	index int
+	node  regexNode
+}
+
+func (n *regexGroup) match(m *regexMatcher, i int, k func(int) bool) bool {
+	return n.node.match(m, i, func(j int) bool {
+		start, end := m.groups[n.index*2], m.groups[n.index*2+1]
+		m.groups[n.index*2], m.groups[n.index*2+1] = i, j
+		if k(j) {
+			return true
+		}
+		m.groups[n.index*2], m.groups[n.index*2+1] = start, end
+		return false
+	})
+}
+
+func (n *regexGroup) width() (int, int) {
+	return n.node.width()
+}
+
+// regexRepeat is a greedy or reluctant quantifier, possessive quantifier is compiled into atomic group of greedy one
+type regexRepeat struct {
+	node     regexNode
+	min, max int
+	greedy   bool
+}
+
+func (n *regexRepeat) match(m *regexMatcher, i int, k func(int) bool) bool {
+	return n.matchCount(m, i, 0, k)
+}
+
+func (n *regexRepeat) matchCount(m *regexMatcher, i int, count int, k func(int) bool) bool {
+	if !m.step() {
+		return false
+	}
+	canRepeat := n.max < 0 || count < n.max
+	next := func(j int) bool {
+		// an empty iteration after the minimum never progresses
+		if j == i && count >= n.min {
+			return false
+		}
+		return n.matchCount(m, j, count+1, k)
+	}
+	if n.greedy {
+		if canRepeat && n.node.match(m, i, next) {
+			return true
+		}
+		return count >= n.min && k(i)
+	}
+	if count >= n.min && k(i) {
+		return true
+	}
+	return canRepeat && n.node.match(m, i, next)
+}
+
+func (n *regexRepeat) width() (int, int) {
+	min, max := n.node.width()
+	if n.max < 0 || max < 0 {
+		return min * n.min, -1
+	}
+	return min * n.min, max * n.max
+}
+
+// regexAtomic is an atomic group (?>X), backtracking into the group is not allowed
+type regexAtomic struct {
+	node regexNode
+}
+
+func (n *regexAtomic) match(m *regexMatcher, i int, k func(int) bool) bool {
+	saved := append([]int{}, m.groups...)
+	end := -1
+	if !n.node.match(m, i, func(j int) bool {
+		end = j
+		return true
+	}) {
+		return false
+	}
+	if k(end) {
+		return true
+	}
+	copy(m.groups, saved)
+	return false
+}
+
+func (n *regexAtomic) width() (int, int) {
+	return n.node.width()
+}
+
+// regexLook is lookahead or lookbehind
+type regexLook struct {
+	node   regexNode
+	behind bool
+	negate bool
+}
+
+func (n *regexLook) match(m *regexMatcher, i int, k func(int) bool) bool {
+	saved := append([]int{}, m.groups...)
+	found := false
+	if n.behind {
+		min, max := n.node.width()
+		for start := i - min; start >= m.from && start >= i-max; start-- {
+			if start < len(m.input) && !utf8.RuneStart(m.input[start]) {
+				continue
+			}
+			if n.node.match(m, start, func(j int) bool { return j == i }) {
+				found = true
+				break
+			}
+		}
+	} else {
+		found = n.node.match(m, i, func(j int) bool { return true })
+	}
+	if found == n.negate {
+		copy(m.groups, saved)
+		return false
+	}
+	if n.negate {
+		copy(m.groups, saved)
+	}
+	if k(i) {
+		return true
+	}
+	copy(m.groups, saved)
+	return false
+}
+
+func (n *regexLook) width() (int, int) {
+	return 0, 0
+}
+
+type regexBackReference struct {
+	index int
+	fold  func(a, b rune) bool
+}
+
+func (n *regexBackReference) match(m *regexMatcher, i int, k func(int) bool) bool {
+	if !m.step() {
+		return false
+	}
+	text, ok := m.group(n.index)
+	if !ok {
+		return false
+	}
+	j := i
+	for _, r := range text {
+		c, width := m.runeAt(j)
+		if width == 0 || !n.fold(r, c) {
+			return false
+		}
+		j += width
+	}
+	return k(j)
+}
+
+func (n *regexBackReference) width() (int, int) {
+	return 0, -1
+}
+
+// regexAssertion is zero-width assertion such as ^, $, \b and \G
+type regexAssertion struct {
+	test func(m *regexMatcher, i int) bool
+}
+
+func (n *regexAssertion) match(m *regexMatcher, i int, k func(int) bool) bool {
+	return n.test(m, i) && k(i)
+}
+
+func (n *regexAssertion) width() (int, int) {
+	return 0, 0
+}
+
+// isLineTerminator returns true for line terminators of Java regex, only \n is a line terminator in UNIX_LINES mode
+func isLineTerminator(r rune, flags int) bool {
+	if flags&regexUnixLines != 0 {
+		return r == '\n'
+	}
+	switch r {
+	case '\n', '\r', '\u0085', ' ', ' ':
+		return true
+	}
+	return false
+}
+
+func isRegexWord(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// atLineEnd returns true if the position is at the end, or before the line terminator at the end
+func atLineEnd(m *regexMatcher, i int, flags int, multiline bool) bool {
+	r, width := m.runeAt(i)
+	if width == 0 {
+		return true
+	}
+	if !isLineTerminator(r, flags) {
+		return false
+	}
+	if multiline {
+		// between \r and \n is not the end of line
+		prev, _ := m.runeBefore(i)
+		return !(r == '\n' && prev == '\r' && flags&regexUnixLines == 0)
+	}
+	rest := m.input[i+width : m.to]
+	if r == '\r' && flags&regexUnixLines == 0 && strings.HasPrefix(rest, "\n") {
+		rest = rest[1:]
+	}
+	if rest == "" {
+		m.hitEnd = true
+		return true
+	}
+	return false
+}
+
+func newRegexAnchor(c rune, flags int) *regexAssertion {
+	switch c {
+	case '^':
+		if flags&regexMultiline == 0 {
+			return &regexAssertion{test: func(m *regexMatcher, i int) bool { return i == m.from }}
+		}
+		return &regexAssertion{test: func(m *regexMatcher, i int) bool {
+			if i == m.from {
+				return true
+			}
+			if i == m.to {
+				return false
+			}
+			prev, _ := m.runeBefore(i)
+			next, _ := m.runeAt(i)
+			if prev == '\r' && next == '\n' && flags&regexUnixLines == 0 {
+				return false
+			}
+			return isLineTerminator(prev, flags)
+		}}
+	case '$':
+		multiline := flags&regexMultiline != 0
+		return &regexAssertion{test: func(m *regexMatcher, i int) bool { return atLineEnd(m, i, flags, multiline) }}
+	case 'A':
+		return &regexAssertion{test: func(m *regexMatcher, i int) bool { return i == m.from }}
+	case 'Z':
+		return &regexAssertion{test: func(m *regexMatcher, i int) bool { return atLineEnd(m, i, flags, false) }}
+	case 'z':
+		return &regexAssertion{test: func(m *regexMatcher, i int) bool {
+			if i == m.to {
+				m.hitEnd = true
+				return true
+			}
+			return false
+		}}
+	case 'G':
+		return &regexAssertion{test: func(m *regexMatcher, i int) bool { return i == m.last }}
+	}
+	wordBoundary := func(m *regexMatcher, i int) bool {
+		prev, pw := m.runeBefore(i)
+		next, nw := m.runeAt(i)
+		return (pw > 0 && isRegexWord(prev)) != (nw > 0 && isRegexWord(next))
+	}
+	if c == 'b' {
+		return &regexAssertion{test: wordBoundary}
+	}
+	return &regexAssertion{test: func(m *regexMatcher, i int) bool { return !wordBoundary(m, i) }}
+}
+
+// regexParser parses the pattern into nodes
+type regexParser struct {
+	src        []rune
+	pattern    string
+	pos        int
+	flags      int
+	groupCount int
+	names      map[string]int
+}
+
+func (p *regexParser) error(description string, index int) error {
+	return &regexSyntaxError{description: description, pattern: p.pattern, index: index}
+}
+
+func (p *regexParser) more() bool {
+	return p.pos < len(p.src)
+}
+
+func (p *regexParser) peek() rune {
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return 0
+}
+
+func (p *regexParser) hasPrefix(s string) bool {
+	return strings.HasPrefix(string(p.src[p.pos:]), s)
+}
+
+// skipComments skips whitespace and comments in COMMENTS mode
+func (p *regexParser) skipComments() {
+	if p.flags&regexComments == 0 {
+		return
+	}
+	for p.more() {
+		c := p.peek()
+		if unicode.IsSpace(c) {
+			p.pos++
+		} else if c == '#' {
+			for p.more() && !isLineTerminator(p.peek(), p.flags) {
+				p.pos++
+			}
+		} else {
+			return
+		}
+	}
+}
+
+func (p *regexParser) parseAlternation() (regexNode, error) {
+	alternatives := []regexNode{}
+	for {
+		seq, err := p.parseSequence()
+		if err != nil {
+			return nil, err
+		}
+		alternatives = append(alternatives, seq)
+		if p.peek() != '|' || !p.more() {
+			break
+		}
+		p.pos++
+	}
+	if len(alternatives) == 1 {
+		return alternatives[0], nil
+	}
+	return &regexAlternation{alternatives: alternatives}, nil
+}
+
+func (p *regexParser) parseSequence() (regexNode, error) {
+	nodes := []regexNode{}
+	for {
+		p.skipComments()
+		if !p.more() || p.peek() == '|' || p.peek() == ')' {
+			break
+		}
+		atom, err := p.parseAtom()
+		if err != nil {
+			return nil, err
+		}
+		if atom == nil {
+			continue
+		}
+		atom, err = p.parseQuantifier(atom)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, atom)
+	}
+	if len(nodes) == 1 {
+		return nodes[0], nil
+	}
+	return &regexSequence{nodes: nodes}, nil
+}
+
+func (p *regexParser) parseQuantifier(atom regexNode) (regexNode, error) {
+	for {
+		p.skipComments()
+		if !p.more() {
+			return atom, nil
+		}
+		start := p.pos
+		min, max := 0, 0
+		switch p.peek() {
+		case '*':
+			min, max = 0, -1
+			p.pos++
+		case '+':
+			min, max = 1, -1
+			p.pos++
+		case '?':
+			min, max = 0, 1
+			p.pos++
+		case '{':
+			end := p.pos + 1
+			for end < len(p.src) && p.src[end] != '}' {
+				end++
+			}
+			if end >= len(p.src) {
+				return nil, p.error("Unclosed counted closure", len(p.src))
+			}
+			bounds := strings.SplitN(string(p.src[p.pos+1:end]), ",", 2)
+			var err error
+			if min, err = strconv.Atoi(bounds[0]); err != nil || min < 0 {
+				return nil, p.error("Illegal repetition", start)
+			}
+			max = min
+			if len(bounds) == 2 {
+				if bounds[1] == "" {
+					max = -1
+				} else if max, err = strconv.Atoi(bounds[1]); err != nil || max < min {
+					return nil, p.error("Illegal repetition range", start)
+				}
+			}
+			p.pos = end + 1
+		default:
+			return atom, nil
+		}
+		greedy, possessive := true, false
+		if p.peek() == '?' && p.more() {
+			greedy = false
+			p.pos++
+		} else if p.peek() == '+' && p.more() {
+			possessive = true
+			p.pos++
+		}
+		var node regexNode = &regexRepeat{node: atom, min: min, max: max, greedy: greedy}
+		if possessive {
+			node = &regexAtomic{node: node}
+		}
+		atom = node
+	}
+}
+
+func (p *regexParser) parseAtom() (regexNode, error) {
+	c := p.peek()
+	switch c {
+	case '(':
+		return p.parseGroup()
+	case '[':
+		p.pos++
+		pred, err := p.parseClass()
+		if err != nil {
+			return nil, err
+		}
+		return p.charNode(p.foldPredicate(pred)), nil
+	case '.':
+		p.pos++
+		flags := p.flags
+		if flags&regexDotAll != 0 {
+			return p.charNode(func(r rune) bool { return true }), nil
+		}
+		return p.charNode(func(r rune) bool { return !isLineTerminator(r, flags) }), nil
+	case '^', '$':
+		p.pos++
+		return newRegexAnchor(c, p.flags), nil
+	case '*', '+', '?':
+		return nil, p.error(fmt.Sprintf("Dangling meta character '%c'", c), p.pos)
+	case '{':
+		return nil, p.error("Illegal repetition", p.pos)
+	case '\\':
+		return p.parseEscape()
+	}
+	p.pos++
+	return p.literal(c), nil
+}
+
+func (p *regexParser) charNode(pred func(rune) bool) *regexChar {
+	return &regexChar{pred: pred, min: 1, max: utf8.UTFMax}
+}
+
+// literal returns the node which matches the character, case-insensitively in CASE_INSENSITIVE mode
+func (p *regexParser) literal(c rune) regexNode {
+	if p.flags&regexCaseInsensitive == 0 {
+		width := utf8.RuneLen(c)
+		return &regexChar{pred: func(r rune) bool { return r == c }, min: width, max: width}
+	}
+	fold := p.foldFunc()
+	return p.charNode(func(r rune) bool { return fold(c, r) })
+}
+
+// foldFunc returns the comparison of characters, which ignores case of ASCII or all characters in UNICODE_CASE mode
+func (p *regexParser) foldFunc() func(a, b rune) bool {
+	if p.flags&regexCaseInsensitive == 0 {
+		return func(a, b rune) bool { return a == b }
+	}
+	unicodeCase := p.flags&regexUnicodeCase != 0
+	return func(a, b rune) bool {
+		if a == b {
+			return true
+		}
+		if !unicodeCase && (a >= utf8.RuneSelf || b >= utf8.RuneSelf) {
+			return false
+		}
+		for f := unicode.SimpleFold(a); f != a; f = unicode.SimpleFold(f) {
+			if f == b {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// foldPredicate makes the class predicate case-insensitive in CASE_INSENSITIVE mode
+func (p *regexParser) foldPredicate(pred func(rune) bool) func(rune) bool {
+	if p.flags&regexCaseInsensitive == 0 {
+		return pred
+	}
+	unicodeCase := p.flags&regexUnicodeCase != 0
+	return func(r rune) bool {
+		if pred(r) {
+			return true
+		}
+		if !unicodeCase && r >= utf8.RuneSelf {
+			return false
+		}
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if (unicodeCase || f < utf8.RuneSelf) && pred(f) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (p *regexParser) parseGroup() (regexNode, error) {
+	start := p.pos
+	p.pos++
+	savedFlags := p.flags
+	defer func() { p.flags = savedFlags }()
+	var wrap func(regexNode) regexNode
+	if p.peek() == '?' {
+		p.pos++
+		switch {
+		case p.hasPrefix(":"):
+			p.pos++
+			wrap = func(n regexNode) regexNode { return n }
+		case p.hasPrefix("="), p.hasPrefix("!"):
+			negate := p.peek() == '!'
+			p.pos++
+			wrap = func(n regexNode) regexNode { return &regexLook{node: n, negate: negate} }
+		case p.hasPrefix("<="), p.hasPrefix("<!"):
+			negate := p.src[p.pos+1] == '!'
+			p.pos += 2
+			wrap = func(n regexNode) regexNode { return &regexLook{node: n, behind: true, negate: negate} }
+		case p.hasPrefix(">"):
+			p.pos++
+			wrap = func(n regexNode) regexNode { return &regexAtomic{node: n} }
+		case p.hasPrefix("<"):
+			p.pos++
+			nameStart := p.pos
+			for p.more() && (unicode.IsLetter(p.peek()) || unicode.IsDigit(p.peek())) {
+				p.pos++
+			}
+			name := string(p.src[nameStart:p.pos])
+			if p.peek() != '>' || name == "" || !unicode.IsLetter([]rune(name)[0]) {
+				return nil, p.error("named capturing group is missing trailing '>'", p.pos)
+			}
+			if _, ok := p.names[name]; ok {
+				return nil, p.error(fmt.Sprintf("Named capturing group <%s> is already defined", name), p.pos)
+			}
+			p.pos++
+			p.groupCount++
+			index := p.groupCount
+			p.names[name] = index
+			wrap = func(n regexNode) regexNode { return &regexGroup{index: index, node: n} }
+		default:
+			// embedded flags such as (?i) or (?i-s:X)
+			on := true
+			flags := p.flags
+			for p.more() && p.peek() != ')' && p.peek() != ':' {
+				c := p.peek()
+				if c == '-' {
+					on = false
+				} else if flag, ok := regexFlagChars[c]; ok {
+					if on {
+						flags |= flag
+					} else {
+						flags &^= flag
+					}
+				} else {
+					return nil, p.error("Unknown inline modifier", p.pos)
+				}
+				p.pos++
+			}
+			if !p.more() {
+				return nil, p.error("Unclosed group", len(p.src))
+			}
+			if p.peek() == ')' {
+				// the flags are effective until the end of the enclosing group
+				p.pos++
+				savedFlags = flags
+				p.flags = flags
+				return nil, nil
+			}
+			p.pos++
+			p.flags = flags
+			wrap = func(n regexNode) regexNode { return n }
+		}
+	} else {
+		p.groupCount++
+		index := p.groupCount
+		wrap = func(n regexNode) regexNode { return &regexGroup{index: index, node: n} }
+	}
+	node, err := p.parseAlternation()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != ')' || !p.more() {
+		return nil, p.error("Unclosed group", len(p.src))
+	}
+	p.pos++
+	node = wrap(node)
+	if look, ok := node.(*regexLook); ok && look.behind {
+		if _, max := look.node.width(); max < 0 {
+			return nil, p.error("Look-behind group does not have an obvious maximum length", start)
+		}
+	}
+	return node, nil
+}
+
+// parseEscape parses the escape sequence outside of character class
+func (p *regexParser) parseEscape() (regexNode, error) {
+	start := p.pos
+	p.pos++
+	if !p.more() {
+		return nil, p.error("Unexpected internal error", len(p.src))
+	}
+	c := p.peek()
+	switch c {
+	case 'b', 'B', 'A', 'z', 'Z', 'G':
+		p.pos++
+		return newRegexAnchor(c, p.flags), nil
+	case 'Q':
+		p.pos++
+		end := strings.Index(string(p.src[p.pos:]), `\E`)
+		quoted := p.src[p.pos:]
+		if end >= 0 {
+			quoted = []rune(string(p.src[p.pos:])[:end])
+			p.pos += len(quoted) + 2
+		} else {
+			p.pos = len(p.src)
+		}
+		nodes := make([]regexNode, len(quoted))
+		for i, r := range quoted {
+			nodes[i] = p.literal(r)
+		}
+		if len(nodes) == 0 {
+			return nil, nil
+		}
+		// the quantifier after \Q...\E applies to the last character
+		if len(nodes) == 1 {
+			return nodes[0], nil
+		}
+		last := nodes[len(nodes)-1]
+		seq := &regexSequence{nodes: nodes[:len(nodes)-1]}
+		quantified, err := p.parseQuantifier(last)
+		if err != nil {
+			return nil, err
+		}
+		seq.nodes = append(seq.nodes, quantified)
+		return seq, nil
+	case 'k':
+		p.pos++
+		if p.peek() != '<' {
+			return nil, p.error("\\k is not followed by '<' for named capturing group", p.pos)
+		}
+		end := p.pos
+		for end < len(p.src) && p.src[end] != '>' {
+			end++
+		}
+		if end >= len(p.src) {
+			return nil, p.error("named capturing group is missing trailing '>'", end)
+		}
+		name := string(p.src[p.pos+1 : end])
+		index, ok := p.names[name]
+		if !ok {
+			return nil, p.error(fmt.Sprintf("named capturing group <%s> does not exist", name), end)
+		}
+		p.pos = end + 1
+		return &regexBackReference{index: index, fold: p.foldFunc()}, nil
+	case 'R':
+		p.pos++
+		return &regexAlternation{alternatives: []regexNode{
+			&regexSequence{nodes: []regexNode{p.literalExact('\r'), p.literalExact('\n')}},
+			p.charNode(func(r rune) bool {
+				return r == '\n' || r == '\x0b' || r == '\f' || r == '\r' || r == '\u0085' || r == ' ' || r == ' '
+			}),
+		}}, nil
+	case 'X':
+		return nil, p.error("Unsupported escape sequence \\X (grapheme cluster)", start)
+	}
+	if c >= '1' && c <= '9' {
+		// back reference consumes digits while the group exists
+		index := int(c - '0')
+		p.pos++
+		for p.more() && p.peek() >= '0' && p.peek() <= '9' {
+			next := index*10 + int(p.peek()-'0')
+			if next > p.groupCount {
+				break
+			}
+			index = next
+			p.pos++
+		}
+		return &regexBackReference{index: index, fold: p.foldFunc()}, nil
+	}
+	pred, r, err := p.parseClassEscape()
+	if err != nil {
+		return nil, err
+	}
+	if pred != nil {
+		return p.charNode(p.foldPredicate(pred)), nil
+	}
+	return p.literal(r), nil
+}
+
+func (p *regexParser) literalExact(c rune) regexNode {
+	return &regexChar{pred: func(r rune) bool { return r == c }, min: 1, max: 1}
+}
+
+// parseClassEscape parses the escape sequence after backslash which is valid in character class.
+// It returns the predicate for predefined classes, or the character.
+func (p *regexParser) parseClassEscape() (func(rune) bool, rune, error) {
+	start := p.pos - 1
+	c := p.peek()
+	p.pos++
+	switch c {
+	case 't':
+		return nil, '\t', nil
+	case 'n':
+		return nil, '\n', nil
+	case 'r':
+		return nil, '\r', nil
+	case 'f':
+		return nil, '\f', nil
+	case 'a':
+		return nil, '\a', nil
+	case 'e':
+		return nil, '\x1b', nil
+	case '0':
+		value := 0
+		digits := 0
+		for p.more() && digits < 3 && p.peek() >= '0' && p.peek() <= '7' {
+			next := value*8 + int(p.peek()-'0')
+			if next > 0377 {
+				break
+			}
+			value = next
+			digits++
+			p.pos++
+		}
+		if digits == 0 {
+			return nil, 0, p.error("Illegal octal escape sequence", p.pos)
+		}
+		return nil, rune(value), nil
+	case 'x':
+		if p.peek() == '{' {
+			end := p.pos
+			for end < len(p.src) && p.src[end] != '}' {
+				end++
+			}
+			value, err := strconv.ParseUint(string(p.src[p.pos+1:end]), 16, 32)
+			if end >= len(p.src) || err != nil || value > unicode.MaxRune {
+				return nil, 0, p.error("Illegal hexadecimal escape sequence", p.pos)
+			}
+			p.pos = end + 1
+			return nil, rune(value), nil
+		}
+		return p.parseHex(2)
+	case 'u':
+		return p.parseHex(4)
+	case 'c':
+		if !p.more() {
+			return nil, 0, p.error("Illegal control escape sequence", p.pos)
+		}
+		r := p.peek() ^ 64
+		p.pos++
+		return nil, r, nil
+	case 'd':
+		return isAsciiDigit, 0, nil
+	case 'D':
+		return not(isAsciiDigit), 0, nil
+	case 'w':
+		return isAsciiWord, 0, nil
+	case 'W':
+		return not(isAsciiWord), 0, nil
+	case 's':
+		return isAsciiSpace, 0, nil
+	case 'S':
+		return not(isAsciiSpace), 0, nil
+	case 'h':
+		return isHorizontalSpace, 0, nil
+	case 'H':
+		return not(isHorizontalSpace), 0, nil
+	case 'v':
+		return isVerticalSpace, 0, nil
+	case 'V':
+		return not(isVerticalSpace), 0, nil
+	case 'p', 'P':
+		var name string
+		if p.peek() == '{' {
+			end := p.pos
+			for end < len(p.src) && p.src[end] != '}' {
+				end++
+			}
+			if end >= len(p.src) {
+				return nil, 0, p.error("Unclosed character family", end)
+			}
+			name = string(p.src[p.pos+1 : end])
+			p.pos = end + 1
+		} else if p.more() {
+			name = string(p.peek())
+			p.pos++
+		}
+		pred, err := regexProperty(name)
+		if err != nil {
+			return nil, 0, p.error(err.Error(), p.pos)
+		}
+		if c == 'P' {
+			pred = not(pred)
+		}
+		return pred, 0, nil
+	}
+	if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
+		return nil, 0, p.error(fmt.Sprintf("Illegal/unsupported escape sequence \\%c", c), start+1)
+	}
+	return nil, c, nil
+}
+
+func (p *regexParser) parseHex(digits int) (func(rune) bool, rune, error) {
+	if p.pos+digits > len(p.src) {
+		return nil, 0, p.error("Illegal hexadecimal escape sequence", p.pos)
+	}
+	value, err := strconv.ParseUint(string(p.src[p.pos:p.pos+digits]), 16, 32)
+	if err != nil {
+		return nil, 0, p.error("Illegal hexadecimal escape sequence", p.pos)
+	}
+	p.pos += digits
+	return nil, rune(value), nil
+}
+
+// parseClass parses the character class after '[', nested classes and intersection with && are supported
+func (p *regexParser) parseClass() (func(rune) bool, error) {
+	negate := false
+	if p.peek() == '^' && p.more() {
+		negate = true
+		p.pos++
+	}
+	var union []func(rune) bool
+	var intersection func(rune) bool
+	first := true
+	for {
+		if !p.more() {
+			return nil, p.error("Unclosed character class", len(p.src)-1)
+		}
+		c := p.peek()
+		if c == ']' && !first {
+			p.pos++
+			break
+		}
+		first = false
+		if c == '&' && p.hasPrefix("&&") {
+			p.pos += 2
+			left := anyOf(union)
+			if intersection != nil {
+				prev := intersection
+				intersection = func(r rune) bool { return prev(r) && left(r) }
+			} else {
+				intersection = left
+			}
+			union = nil
+			continue
+		}
+		if c == '[' {
+			p.pos++
+			nested, err := p.parseClass()
+			if err != nil {
+				return nil, err
+			}
+			union = append(union, nested)
+			continue
+		}
+		pred, lo, err := p.parseClassChar()
+		if err != nil {
+			return nil, err
+		}
+		if pred != nil {
+			union = append(union, pred)
+			continue
+		}
+		// range such as a-z, '-' before ']' is literal
+		if p.peek() == '-' && p.pos+1 < len(p.src) && p.src[p.pos+1] != ']' && p.src[p.pos+1] != '[' {
+			p.pos++
+			pred, hi, err := p.parseClassChar()
+			if err != nil {
+				return nil, err
+			}
+			if pred != nil || hi < lo {
+				return nil, p.error("Illegal character range", p.pos-1)
+			}
+			union = append(union, func(r rune) bool { return lo <= r && r <= hi })
+			continue
+		}
+		union = append(union, func(r rune) bool { return r == lo })
+	}
+	pred := anyOf(union)
+	if intersection != nil {
+		prev := intersection
+		right := pred
+		pred = func(r rune) bool { return prev(r) && right(r) }
+	}
+	if negate {
+		pred = not(pred)
+	}
+	return pred, nil
+}
+
+// parseClassChar parses a character or predefined class in character class
+func (p *regexParser) parseClassChar() (func(rune) bool, rune, error) {
+	c := p.peek()
+	p.pos++
+	if c != '\\' {
+		return nil, c, nil
+	}
+	if !p.more() {
+		return nil, 0, p.error("Unclosed character class", len(p.src)-1)
+	}
+	if p.peek() == 'Q' {
+		p.pos++
+		end := strings.Index(string(p.src[p.pos:]), `\E`)
+		if end < 0 {
+			return nil, 0, p.error("Unclosed character class", len(p.src)-1)
+		}
+		quoted := []rune(string(p.src[p.pos:])[:end])
+		p.pos += len(quoted) + 2
+		return func(r rune) bool {
+			for _, q := range quoted {
+				if q == r {
+					return true
+				}
+			}
+			return false
+		}, 0, nil
+	}
+	return p.parseClassEscape()
+}
+
+func anyOf(preds []func(rune) bool) func(rune) bool {
+	return func(r rune) bool {
+		for _, pred := range preds {
+			if pred(r) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func not(pred func(rune) bool) func(rune) bool {
+	return func(r rune) bool { return !pred(r) }
+}
+
+func isAsciiDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func isAsciiWord(r rune) bool {
+	return r == '_' || isAsciiDigit(r) || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isAsciiSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\x0b' || r == '\f' || r == '\r'
+}
+
+func isHorizontalSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == ' ' || r == ' ' || r == '᠎' ||
+		(r >= ' ' && r <= ' ') || r == ' ' || r == ' ' || r == '　'
+}
+
+func isVerticalSpace(r rune) bool {
+	return r == '\n' || r == '\x0b' || r == '\f' || r == '\r' || r == '\u0085' || r == ' ' || r == ' '
+}
+
+func isAsciiPunct(r rune) bool {
+	return r < utf8.RuneSelf && strings.ContainsRune("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", r)
+}
+
+// regexPosixProperties are POSIX character classes (US-ASCII only) and java.lang.Character classes of \p{name}
+var regexPosixProperties = map[string]func(rune) bool{
+	"Lower":  func(r rune) bool { return r >= 'a' && r <= 'z' },
+	"Upper":  func(r rune) bool { return r >= 'A' && r <= 'Z' },
+	"ASCII":  func(r rune) bool { return r < utf8.RuneSelf },
+	"Alpha":  func(r rune) bool { return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') },
+	"Digit":  isAsciiDigit,
+	"Alnum":  func(r rune) bool { return isAsciiDigit(r) || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') },
+	"Punct":  isAsciiPunct,
+	"Graph":  func(r rune) bool { return r > ' ' && r < 0x7f },
+	"Print":  func(r rune) bool { return r >= ' ' && r < 0x7f },
+	"Blank":  func(r rune) bool { return r == ' ' || r == '\t' },
+	"Cntrl":  func(r rune) bool { return r < ' ' || r == 0x7f },
+	"XDigit": func(r rune) bool { return isAsciiDigit(r) || (r >= 'a' && r <= 'f') || (r >= 'A' && r <= 'F') },
+	"Space":  isAsciiSpace,
+
+	"javaLowerCase":     unicode.IsLower,
+	"javaUpperCase":     unicode.IsUpper,
+	"javaTitleCase":     unicode.IsTitle,
+	"javaWhitespace":    func(r rune) bool { return unicode.IsSpace(r) && r != ' ' && r != ' ' && r != ' ' },
+	"javaSpaceChar":     func(r rune) bool { return unicode.Is(unicode.Z, r) },
+	"javaDigit":         unicode.IsDigit,
+	"javaLetter":        unicode.IsLetter,
+	"javaLetterOrDigit": func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) },
+	"javaAlphabetic":    func(r rune) bool { return unicode.IsLetter(r) || unicode.Is(unicode.Nl, r) },
+	"javaISOControl":    func(r rune) bool { return r <= 0x1f || (r >= 0x7f && r <= 0x9f) },
+	"javaIdeographic":   func(r rune) bool { return unicode.Is(unicode.Ideographic, r) },
+	"javaJavaIdentifierStart": func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.Is(unicode.Nl, r) || unicode.Is(unicode.Sc, r) || unicode.Is(unicode.Pc, r)
+	},
+}
+
+// regexBinaryProperties are binary properties of \p{IsName}
+var regexBinaryProperties = map[string]func(rune) bool{
+	"alphabetic": func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.Is(unicode.Nl, r) || unicode.Is(unicode.Other_Alphabetic, r)
+	},
+	"letter":      unicode.IsLetter,
+	"digit":       unicode.IsDigit,
+	"uppercase":   func(r rune) bool { return unicode.IsUpper(r) || unicode.Is(unicode.Other_Uppercase, r) },
+	"lowercase":   func(r rune) bool { return unicode.IsLower(r) || unicode.Is(unicode.Other_Lowercase, r) },
+	"whitespace":  unicode.IsSpace,
+	"white_space": unicode.IsSpace,
+	"punctuation": unicode.IsPunct,
+	"control":     unicode.IsControl,
+	"ideographic": func(r rune) bool { return unicode.Is(unicode.Ideographic, r) },
+	"hex_digit":   func(r rune) bool { return unicode.Is(unicode.Hex_Digit, r) },
+	"hexdigit":    func(r rune) bool { return unicode.Is(unicode.Hex_Digit, r) },
+	"titlecase":   unicode.IsTitle,
+}
+
+// regexProperty returns the predicate of \p{name}.
+// Unicode blocks such as InGreek are not supported because Go has no block tables.
+func regexProperty(name string) (func(rune) bool, error) {
+	if pred, ok := regexPosixProperties[name]; ok {
+		return pred, nil
+	}
+	if table, ok := unicode.Categories[name]; ok {
+		return func(r rune) bool { return unicode.Is(table, r) }, nil
+	}
+	if name == "L&" || name == "LC" {
+		return func(r rune) bool { return unicode.IsUpper(r) || unicode.IsLower(r) || unicode.IsTitle(r) }, nil
+	}
+	key, value := "", name
+	if i := strings.Index(name, "="); i >= 0 {
+		key, value = strings.ToLower(name[:i]), name[i+1:]
+	} else if strings.HasPrefix(name, "Is") {
+		key, value = "is", name[2:]
+	} else if strings.HasPrefix(name, "In") {
+		key, value = "blk", name[2:]
+	}
+	switch key {
+	case "is":
+		if table, ok := unicode.Categories[value]; ok {
+			return func(r rune) bool { return unicode.Is(table, r) }, nil
+		}
+		if pred, ok := regexBinaryProperties[strings.ToLower(value)]; ok {
+			return pred, nil
+		}
+		if table, ok := lookupUnicodeTable(unicode.Scripts, value); ok {
+			return func(r rune) bool { return unicode.Is(table, r) }, nil
+		}
+	case "script", "sc":
+		if table, ok := lookupUnicodeTable(unicode.Scripts, value); ok {
+			return func(r rune) bool { return unicode.Is(table, r) }, nil
+		}
+	case "general_category", "gc":
+		if table, ok := unicode.Categories[value]; ok {
+			return func(r rune) bool { return unicode.Is(table, r) }, nil
+		}
+	case "block", "blk":
+		return nil, fmt.Errorf("Unsupported Unicode block {%s}", name)
+	}
+	return nil, fmt.Errorf("Unknown character property name {%s}", name)
+}
+
+func lookupUnicodeTable(tables map[string]*unicode.RangeTable, name string) (*unicode.RangeTable, bool) {
+	for key, table := range tables {
+		if strings.EqualFold(key, name) {
+			return table, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,269 @@
+package builtin
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRegexFind(t *testing.T) {
+	testCases := []struct {
+		Pattern string
+		Input   string
+		// Expected is the groups of the first match joined by "|", "-" for the group which did not participate, nil for no match
+		Expected []string
+	}{
+		// groups
+		{`(a)(b)?(c)`, "xacx", []string{"ac", "a", "-", "c"}},
+		{`(a|ab)(c|bcd)(d*)`, "abcd", []string{"abcd", "a", "bcd", ""}},
+		{`(?:ab)+`, "ababx", []string{"abab"}},
+		{`((a)|b)+`, "ab", []string{"ab", "b", "a"}},
+		// backreferences
+		{`(\w)\1`, "abccd", []string{"cc", "c"}},
+		{`(?i)(a)\1`, "aA", []string{"aA", "a"}},
+		{`(a)?\1`, "b", nil},
+		{`(?<q>['"])(.*?)\k<q>`, `say "hi" now`, []string{`"hi"`, `"`, "hi"}},
+		// lookaround
+		{`\w+(?=!)`, "hey you!", []string{"you"}},
+		{`\d+(?!px)\b`, "10px 20em", nil},
+		{`(?<=\$)\d+`, "cost $42", []string{"42"}},
+		{`(?<!\$)\b\d+`, "$1 2", []string{"2"}},
+		{`(?<=a|bc)d`, "bcd", []string{"d"}},
+		// greedy, lazy and possessive quantifiers
+		{`<.+>`, "<a><b>", []string{"<a><b>"}},
+		{`<.+?>`, "<a><b>", []string{"<a>"}},
+		{`a{2,3}`, "aaaa", []string{"aaa"}},
+		{`a{2,3}?`, "aaaa", []string{"aa"}},
+		{`a{2,}`, "aaaaa", []string{"aaaaa"}},
+		{`a++a`, "aaaa", nil},
+		{`a*+b`, "aab", []string{"aab"}},
+		{`(?>a+)a`, "aaa", nil},
+		{`x?+x`, "x", nil},
+		// named groups
+		{`(?<year>\d{4})-(?<month>\d{2})`, "on 2020-01-05", []string{"2020-01", "2020", "01"}},
+		// character classes
+		{`[a-z&&[^aeiou]]+`, "aebcdi", []string{"bcd"}},
+		{`[\w&&[^\d]]+`, "12ab3", []string{"ab"}},
+		{`[^abc]`, "abcd", []string{"d"}},
+		{`[a-c[x-z]]+`, "dbyx", []string{"byx"}},
+		{`\p{Lu}\p{Ll}+`, "hello World", []string{"World"}},
+		{`[\p{Alpha}]+`, "12abc", []string{"abc"}},
+		// flags, anchors and quoting
+		{`(?i)hello`, "HeLLo", []string{"HeLLo"}},
+		{`(?m)^b$`, "a\nb\nc", []string{"b"}},
+		{`(?s)a.b`, "a\nb", []string{"a\nb"}},
+		{`a.b`, "a\nb", nil},
+		{`(?x) a b # comment`, "ab", []string{"ab"}},
+		{`\Qa.b\E`, "axb a.b", []string{"a.b"}},
+		{`\bis\b`, "this is", []string{"is"}},
+		{`\Aab`, "cab", nil},
+		{`ab\z`, "abab", []string{"ab"}},
+	}
+	for i, testCase := range testCases {
+		re, err := compileRegex(testCase.Pattern)
+		if err != nil {
+			t.Errorf("%d: %s: unexpected error: %s", i, testCase.Pattern, err.Error())
+			continue
+		}
+		m := newRegexMatcher(re, testCase.Input)
+		found := m.find()
+		if testCase.Expected == nil {
+			if found {
+				text, _ := m.group(0)
+				t.Errorf("%d: %s: expected no match, actual %s", i, testCase.Pattern, text)
+			}
+			continue
+		}
+		if !found {
+			t.Errorf("%d: %s: expected %v, actual no match", i, testCase.Pattern, testCase.Expected)
+			continue
+		}
+		groups := []string{}
+		for g := 0; g <= re.groupCount; g++ {
+			text, ok := m.group(g)
+			if !ok {
+				text = "-"
+			}
+			groups = append(groups, text)
+		}
+		if strings.Join(groups, "|") != strings.Join(testCase.Expected, "|") {
+			t.Errorf("%d: %s: expected %v, actual %v", i, testCase.Pattern, testCase.Expected, groups)
+		}
+	}
+}
+
+func TestRegexMatches(t *testing.T) {
+	testCases := []struct {
+		Pattern   string
+		Input     string
+		Matches   bool
+		LookingAt bool
+	}{
+		{`\d+`, "123", true, true},
+		{`\d+`, "123a", false, true},
+		{`a|ab`, "ab", true, true},
+		{`(a+)+b`, "aaab", true, true},
+		{`[a-z]+@[a-z]+\.com`, "foo@example.com", true, true},
+		{`.*`, "", true, true},
+		{`x`, "", false, false},
+	}
+	for i, testCase := range testCases {
+		re, err := compileRegex(testCase.Pattern)
+		if err != nil {
+			t.Errorf("%d: %s: unexpected error: %s", i, testCase.Pattern, err.Error())
+			continue
+		}
+		m := newRegexMatcher(re, testCase.Input)
+		if actual := m.matches(); actual != testCase.Matches {
+			t.Errorf("%d: %s: expected matches %t, actual %t", i, testCase.Pattern, testCase.Matches, actual)
+		}
+		if actual := m.lookingAt(); actual != testCase.LookingAt {
+			t.Errorf("%d: %s: expected lookingAt %t, actual %t", i, testCase.Pattern, testCase.LookingAt, actual)
+		}
+	}
+}
+
+func TestRegexSplit(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		Input    string
+		Expected []string
+	}{
+		{`,`, "a,b,c", []string{"a", "b", "c"}},
+		// trailing empty strings are removed, leading ones are kept
+		{`,`, ",a,,b,,", []string{"", "a", "", "b"}},
+		{`,`, ",,,", []string{}},
+		{`,`, "abc", []string{"abc"}},
+		{`,`, "", []string{""}},
+		// zero-width match at the beginning does not produce empty leading string
+		{``, "abc", []string{"a", "b", "c"}},
+		{`(?=[A-Z])`, "HelloWorldFoo", []string{"Hello", "World", "Foo"}},
+		{`\s*;\s*`, "a ; b;c ", []string{"a", "b", "c "}},
+	}
+	for i, testCase := range testCases {
+		re, err := compileRegex(testCase.Pattern)
+		if err != nil {
+			t.Errorf("%d: %s: unexpected error: %s", i, testCase.Pattern, err.Error())
+			continue
+		}
+		actual, err := newRegexMatcher(re, testCase.Input).split()
+		if err != nil {
+			t.Errorf("%d: %s: unexpected error: %s", i, testCase.Pattern, err.Error())
+			continue
+		}
+		if len(actual) != len(testCase.Expected) || strings.Join(actual, "|") != strings.Join(testCase.Expected, "|") {
+			t.Errorf("%d: %s: expected %q, actual %q", i, testCase.Pattern, testCase.Expected, actual)
+		}
+	}
+}
+
+func TestRegexReplace(t *testing.T) {
+	testCases := []struct {
+		Pattern     string
+		Input       string
+		Replacement string
+		All         bool
+		Expected    string
+	}{
+		{`a`, "banana", "o", true, "bonono"},
+		{`a`, "banana", "o", false, "bonana"},
+		{`(\w+)@(\w+)`, "me@host", "$2 at $1", true, "host at me"},
+		{`(?<first>\w+) (?<last>\w+)`, "John Smith", "${last}, ${first}", true, "Smith, John"},
+		{`x`, "axb", `\$1`, true, "a$1b"},
+		// empty matches are replaced at every position
+		{``, "ab", "-", true, "-a-b-"},
+		{`b*`, "abc", "-", true, "-a--c-"},
+		// $10 refers group 1 followed by 0 if there is no group 10
+		{`(a)`, "a", "$10", true, "a0"},
+		{`(a)?b`, "b", "[$1]", true, "[]"},
+	}
+	for i, testCase := range testCases {
+		re, err := compileRegex(testCase.Pattern)
+		if err != nil {
+			t.Errorf("%d: %s: unexpected error: %s", i, testCase.Pattern, err.Error())
+			continue
+		}
+		actual, err := newRegexMatcher(re, testCase.Input).replace(testCase.Replacement, testCase.All)
+		if err != nil {
+			t.Errorf("%d: %s: unexpected error: %s", i, testCase.Pattern, err.Error())
+			continue
+		}
+		if actual != testCase.Expected {
+			t.Errorf("%d: %s: expected %s, actual %s", i, testCase.Pattern, testCase.Expected, actual)
+		}
+	}
+
+	errorCases := []struct {
+		Replacement string
+		Expected    string
+	}{
+		{"$2", "No group 2"},
+		{"${x}", "No group with name {x}"},
+		{"$", "Illegal group reference: group index is missing"},
+		{`\`, "character to be escaped is missing"},
+	}
+	re, _ := compileRegex(`(a)`)
+	for i, testCase := range errorCases {
+		_, err := newRegexMatcher(re, "a").replace(testCase.Replacement, true)
+		if err == nil {
+			t.Errorf("%d: %s: expected error", i, testCase.Replacement)
+			continue
+		}
+		if err.Error() != testCase.Expected {
+			t.Errorf("%d: expected: %s, actual: %s", i, testCase.Expected, err.Error())
+		}
+	}
+}
+
+func TestCompileRegexError(t *testing.T) {
+	testCases := []struct {
+		Pattern  string
+		Expected string
+	}{
+		{`(a`, "Unclosed group near index 2\n(a\n  ^"},
+		{`a)`, "Unmatched closing ')' near index 0\na)\n^"},
+		{`[a`, "Unclosed character class near index 1\n[a\n ^"},
+		{`*a`, "Dangling meta character '*' near index 0\n*a\n^"},
+		{`\k<x>`, "named capturing group <x> does not exist near index 4\n\\k<x>\n    ^"},
+	}
+	for i, testCase := range testCases {
+		_, err := compileRegex(testCase.Pattern)
+		if err == nil {
+			t.Errorf("%d: %s: expected error", i, testCase.Pattern)
+			continue
+		}
+		if err.Error() != testCase.Expected {
+			t.Errorf("%d: expected: %q, actual: %q", i, testCase.Expected, err.Error())
+		}
+	}
+}
+
+func TestRegexStepLimit(t *testing.T) {
+	re, err := compileRegex(`(x+x+)+y`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.Error())
+	}
+	m := newRegexMatcher(re, strings.Repeat("x", 32))
+	started := time.Now()
+	if m.matches() || !m.exceeded {
+		t.Errorf("expected the step limit to be exceeded")
+	}
+	if m.find() || !m.exceeded {
+		t.Errorf("expected the step limit to be exceeded")
+	}
+	if _, err := m.replace("", true); err != errRegexTooComplicated {
+		t.Errorf("expected %v, actual %v", errRegexTooComplicated, err)
+	}
+	if _, err := m.split(); err != errRegexTooComplicated {
+		t.Errorf("expected %v, actual %v", errRegexTooComplicated, err)
+	}
+	if elapsed := time.Since(started); elapsed > 10*time.Second {
+		t.Errorf("expected to stop early, actual %s", elapsed)
+	}
+
+	// the budget is for each operation
+	m.reset(strings.Repeat("x", 8) + "y")
+	if !m.matches() || m.exceeded {
+		t.Errorf("expected match")
+	}
+}
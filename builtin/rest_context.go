@@ -0,0 +1,210 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+var restContextType = ast.CreateClass("RestContext", []*ast.Method{}, ast.NewMethodMap(), ast.NewMethodMap())
+var RestRequestType = ast.CreateClass("RestRequest", nil, ast.NewMethodMap(), ast.NewMethodMap())
+var RestResponseType = ast.CreateClass("RestResponse", nil, ast.NewMethodMap(), ast.NewMethodMap())
+
+var restHeaderMapType = CreateMapType(StringType, StringType)
+
+// NewRestRequest creates RestRequest of the HTTP request served by Apex REST service
+func NewRestRequest(method, requestURI, resourcePath, remoteAddress string, headers, params map[string]string, body []byte) *ast.Object {
+	obj := ast.CreateObject(RestRequestType)
+	obj.InstanceFields.Set("headers", newStringMap(headers))
+	obj.InstanceFields.Set("httpMethod", NewString(method))
+	obj.InstanceFields.Set("params", newStringMap(params))
+	obj.InstanceFields.Set("remoteAddress", NewString(remoteAddress))
+	obj.InstanceFields.Set("requestBody", NewBlob(body))
+	obj.InstanceFields.Set("requestURI", NewString(requestURI))
+	obj.InstanceFields.Set("resourcePath", NewString(resourcePath))
+	return obj
+}
+
+// NewRestResponse creates RestResponse whose status code is 200
+func NewRestResponse() *ast.Object {
+	obj := ast.CreateObject(RestResponseType)
+	obj.InstanceFields.Set("headers", newStringMap(map[string]string{}))
+	obj.InstanceFields.Set("responseBody", Null)
+	obj.InstanceFields.Set("statusCode", NewInteger(200))
+	return obj
+}
+
+// RestResponseOf returns the status code, the headers and the body set on RestResponse by Apex code
+func RestResponseOf(response *ast.Object) (int, map[string]string, []byte) {
+	statusCode := 200
+	if status, ok := response.InstanceFields.Get("statusCode"); ok && status != Null {
+		statusCode = status.IntegerValue()
+	}
+	headers := map[string]string{}
+	if values, ok := response.InstanceFields.Get("headers"); ok && values != Null {
+		m := mapValues(values)
+		for _, key := range m.Keys() {
+			value, _ := m.Get(key)
+			if key != Null && value != Null {
+				headers[key.StringValue()] = value.StringValue()
+			}
+		}
+	}
+	var body []byte
+	if responseBody, ok := response.InstanceFields.Get("responseBody"); ok && responseBody != Null {
+		body = responseBody.Value().([]byte)
+	}
+	return statusCode, headers, body
+}
+
+// RestParameters deserializes parameters of the method from the request body by their declared types.
+// The body is a JSON object whose keys are parameter names, missing parameters are null.
+func RestParameters(m *ast.Method, body []byte) ([]*ast.Object, error) {
+	params := make([]*ast.Object, len(m.Parameters))
+	for i := range params {
+		params[i] = Null
+	}
+	if len(m.Parameters) == 0 || strings.TrimSpace(string(body)) == "" {
+		return params, nil
+	}
+	value, err := parseJson(string(body))
+	if err != nil {
+		return nil, err
+	}
+	object, ok := value.(*jsonObject)
+	if !ok {
+		return nil, fmt.Errorf("Expected a JSON object for the parameters of %s, got %s", m.Name, jsonKind(value))
+	}
+	for _, key := range object.keys {
+		index := -1
+		for i, param := range m.Parameters {
+			if strings.EqualFold(param.Name, key) {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, fmt.Errorf("Unexpected parameter encountered during deserialization: %s", key)
+		}
+		param, err := jsonToObject(object.values[key], m.Parameters[index].Type, false)
+		if err != nil {
+			return nil, err
+		}
+		params[index] = param
+	}
+	return params, nil
+}
+
+// SerializeRestResult serializes the return value of Apex REST method as JSON
+func SerializeRestResult(result *ast.Object) ([]byte, error) {
+	w := newJsonWriter(false)
+	if err := w.writeObject(result, false); err != nil {
+		return nil, err
+	}
+	return []byte(w.String()), nil
+}
+
+func newStringMap(values map[string]string) *ast.Object {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	m := NewOrderedMap()
+	for _, key := range keys {
+		m.Put(NewString(key), NewString(values[key]))
+	}
+	return CreateMapObject(restHeaderMapType, m)
+}
+
+// restMapPut creates the method which puts the key and the value to the map field, such as addHeader
+func restMapPut(name string, field string) *ast.Method {
+	return ast.CreateMethod(
+		name,
+		nil,
+		[]*ast.Parameter{
+			stringTypeParameter,
+			stringTypeParameter,
+		},
+		func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+			values, _ := this.InstanceFields.Get(field)
+			if values == nil || values == Null {
+				return NewNullPointerException(field)
+			}
+			mapValues(values).Put(params[0], params[1])
+			return nil
+		},
+	)
+}
+
+func restField(name string, fieldType *ast.ClassType) *ast.Field {
+	return &ast.Field{
+		Name:      name,
+		Modifiers: []*ast.Modifier{ast.PublicModifier()},
+		Type:      fieldType,
+	}
+}
+
+func init() {
+	RestRequestType.Constructors = []*ast.Method{
+		ast.CreateMethod(
+			"RestRequest",
+			nil,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				this.InstanceFields.Set("headers", newStringMap(map[string]string{}))
+				this.InstanceFields.Set("params", newStringMap(map[string]string{}))
+				return nil
+			},
+		),
+	}
+	for _, field := range []*ast.Field{
+		restField("headers", restHeaderMapType),
+		restField("httpMethod", StringType),
+		restField("params", restHeaderMapType),
+		restField("remoteAddress", StringType),
+		restField("requestBody", BlobType),
+		restField("requestURI", StringType),
+		restField("resourcePath", StringType),
+	} {
+		RestRequestType.InstanceFields.Set(field.Name, field)
+	}
+	RestRequestType.InstanceMethods.Set("addHeader", []*ast.Method{restMapPut("addHeader", "headers")})
+	RestRequestType.InstanceMethods.Set("addParameter", []*ast.Method{restMapPut("addParameter", "params")})
+
+	RestResponseType.Constructors = []*ast.Method{
+		ast.CreateMethod(
+			"RestResponse",
+			nil,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				this.InstanceFields.Set("headers", newStringMap(map[string]string{}))
+				return nil
+			},
+		),
+	}
+	for _, field := range []*ast.Field{
+		restField("headers", restHeaderMapType),
+		restField("responseBody", BlobType),
+		restField("statusCode", IntegerType),
+	} {
+		RestResponseType.InstanceFields.Set(field.Name, field)
+	}
+	RestResponseType.InstanceMethods.Set("addHeader", []*ast.Method{restMapPut("addHeader", "headers")})
+
+	// RestContext.request and RestContext.response are null until the server sets them, or Apex tests assign them
+	for _, field := range []*ast.Field{
+		restField("request", RestRequestType),
+		restField("response", RestResponseType),
+	} {
+		field.Modifiers = append(field.Modifiers, &ast.Modifier{Name: "static"})
+		field.Expression = &ast.NullLiteral{}
+		restContextType.StaticFields.Set(field.Name, field)
+	}
+
+	primitiveClassMap.Set("RestContext", restContextType)
+	primitiveClassMap.Set("RestRequest", RestRequestType)
+	primitiveClassMap.Set("RestResponse", RestResponseType)
+}
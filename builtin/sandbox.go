@@ -0,0 +1,133 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// SlotSize is the estimated bytes of a field of an object, an element of a list or set, and an entry of a map
+const SlotSize = 16
+
+// ErrInterrupted is returned when the execution is interrupted, such as on timeout of the server
+var ErrInterrupted = errors.New("execution is interrupted")
+
+// Interrupter is the interpreter, which is interrupted from other goroutines
+type Interrupter interface {
+	Interrupted() bool
+}
+
+// Sandbox restricts the execution of untrusted code, such as the code sent to eval-server.
+// It is set to Extra["sandbox"] of the interpreter, which checks it on every statement.
+// Zero caps are unlimited.
+type Sandbox struct {
+	// MaxExecutionTime is the wall-clock time of the execution, it is reported as the CPU time limit of Salesforce.
+	MaxExecutionTime time.Duration
+	MaxStatements    int
+	// MaxHeapSize is the bytes allocated by the code, estimated by SizeOf of the strings, collections and objects it creates.
+	// Only the allocations of this execution are counted, and objects freed by the garbage collector are not subtracted.
+	MaxHeapSize uint64
+
+	started    time.Time
+	statements int
+	allocated  uint64
+	exceeded   *ast.Object
+}
+
+func NewSandbox(maxExecutionTime time.Duration, maxStatements int, maxHeapSize uint64) *Sandbox {
+	return &Sandbox{
+		MaxExecutionTime: maxExecutionTime,
+		MaxStatements:    maxStatements,
+		MaxHeapSize:      maxHeapSize,
+	}
+}
+
+// Start starts measuring the execution time and the allocation
+func (s *Sandbox) Start() {
+	s.started = time.Now()
+	s.statements = 0
+	s.allocated = 0
+	s.exceeded = nil
+}
+
+// Step counts a statement, and returns LimitException if the execution exceeds any cap.
+// Once exceeded, it keeps returning the exception, so that finally blocks can not continue the execution.
+func (s *Sandbox) Step() *ast.Object {
+	if s.exceeded != nil {
+		return s.exceeded
+	}
+	if s.started.IsZero() {
+		s.Start()
+	}
+	s.statements++
+	if s.MaxStatements > 0 && s.statements > s.MaxStatements {
+		s.exceeded = NewException(LimitExceptionType, fmt.Sprintf("Too many code statements: %d", s.statements))
+	} else if s.MaxExecutionTime > 0 && time.Since(s.started) > s.MaxExecutionTime {
+		s.exceeded = NewException(LimitExceptionType, "Apex CPU time limit exceeded")
+	}
+	return s.exceeded
+}
+
+// Allocate counts the bytes allocated by the code, and returns LimitException if they exceed MaxHeapSize
+func (s *Sandbox) Allocate(size int) *ast.Object {
+	if s.exceeded != nil || size <= 0 {
+		return s.exceeded
+	}
+	s.allocated += uint64(size)
+	if s.MaxHeapSize > 0 && s.allocated > s.MaxHeapSize {
+		s.exceeded = NewException(LimitExceptionType, fmt.Sprintf("Apex heap size too large: %d", s.allocated))
+	}
+	return s.exceeded
+}
+
+// available reports whether the code can allocate the bytes, natives check it before building large values
+func (s *Sandbox) available(size int) bool {
+	return s.MaxHeapSize == 0 || s.allocated+uint64(size) <= s.MaxHeapSize
+}
+
+// SizeOf estimates the bytes of the object itself, such as the length of a string and the slots of a list.
+// The objects it refers are not included, they are counted when they are created.
+// Primitives other than strings and blobs are not counted.
+func SizeOf(o *ast.Object) int {
+	if o == nil || o == Null {
+		return 0
+	}
+	size := 0
+	if o.InstanceFields != nil {
+		size += len(o.InstanceFields.All()) * SlotSize
+	}
+	switch value := o.Extra["value"].(type) {
+	case string:
+		size += len(value)
+	case []byte:
+		size += len(value)
+	}
+	if records, ok := o.Extra["records"].([]*ast.Object); ok {
+		size += len(records) * SlotSize
+	}
+	if values, ok := o.Extra["values"].(*OrderedMap); ok {
+		size += values.Size() * SlotSize
+	}
+	return size
+}
+
+// checkNative is called by natives which run long or build large values, such as regex replacement and String.repeat.
+// It returns ErrInterrupted if the interpreter is interrupted, and LimitException if the sandbox can not allocate the bytes.
+// The bytes are not counted here, the interpreter counts the value returned by the native.
+func checkNative(extra map[string]interface{}, size int) error {
+	if interrupter, ok := extra["interpreter"].(Interrupter); ok && interrupter.Interrupted() {
+		return ErrInterrupted
+	}
+	if sandbox := sandboxOf(extra); sandbox != nil && !sandbox.available(size) {
+		return NewExceptionError(sandbox.Allocate(size))
+	}
+	return nil
+}
+
+// sandboxOf returns the sandbox of the interpreter, or nil if the code is trusted
+func sandboxOf(extra map[string]interface{}) *Sandbox {
+	sandbox, _ := extra["sandbox"].(*Sandbox)
+	return sandbox
+}
@@ -0,0 +1,103 @@
+package builtin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+type interruptedInterpreter struct{}
+
+func (i *interruptedInterpreter) Interrupted() bool {
+	return true
+}
+
+func TestSandbox(t *testing.T) {
+	sandbox := NewSandbox(0, 2, 100)
+	for i := 0; i < 2; i++ {
+		if exception := sandbox.Step(); exception != nil {
+			t.Fatalf("%d: unexpected exception: %s", i, exception.Extra["message"].(*ast.Object).StringValue())
+		}
+	}
+	exception := sandbox.Step()
+	if exception == nil || exception.Extra["message"].(*ast.Object).StringValue() != "Too many code statements: 3" {
+		t.Errorf("expected LimitException of statements, actual %v", exception)
+	}
+
+	sandbox = NewSandbox(0, 0, 100)
+	sandbox.Start()
+	if exception := sandbox.Allocate(100); exception != nil {
+		t.Errorf("unexpected exception")
+	}
+	exception = sandbox.Allocate(1)
+	if exception == nil || exception.Extra["message"].(*ast.Object).StringValue() != "Apex heap size too large: 101" {
+		t.Errorf("expected LimitException of heap size, actual %v", exception)
+	}
+	// the execution can not continue once exceeded
+	if sandbox.Step() != exception {
+		t.Errorf("expected the same exception")
+	}
+}
+
+func TestSizeOf(t *testing.T) {
+	values := NewOrderedMap()
+	values.Put(NewString("a"), NewInteger(1))
+	testCases := []struct {
+		Object *ast.Object
+		Size   int
+	}{
+		{Null, 0},
+		{NewInteger(1), 0},
+		{NewString("abc"), 3},
+		{CreateListObject(CreateListType(StringType), []*ast.Object{NewString("abc"), Null}), 2 * SlotSize},
+		{CreateMapObject(CreateMapType(StringType, IntegerType), values), SlotSize},
+	}
+	for i, testCase := range testCases {
+		if actual := SizeOf(testCase.Object); actual != testCase.Size {
+			t.Errorf("%d: expected %d, actual %d", i, testCase.Size, actual)
+		}
+	}
+}
+
+func TestCheckNative(t *testing.T) {
+	sandbox := NewSandbox(0, 0, 100)
+	extra := map[string]interface{}{"sandbox": sandbox}
+	if err := checkNative(extra, 100); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+	// the bytes are checked, but not counted
+	if err := checkNative(extra, 100); err != nil {
+		t.Errorf("unexpected error: %s", err.Error())
+	}
+	err := checkNative(extra, 101)
+	if e, ok := err.(*ExceptionError); !ok || e.Exception.ClassType != LimitExceptionType {
+		t.Errorf("expected LimitException, actual %v", err)
+	}
+
+	interrupted := map[string]interface{}{"interpreter": &interruptedInterpreter{}}
+	if err := checkNative(interrupted, 0); err != ErrInterrupted {
+		t.Errorf("expected ErrInterrupted, actual %v", err)
+	}
+	records := []*ast.Object{NewInteger(2), NewInteger(1)}
+	err = sortRecords(records, func(o, other *ast.Object) (int, error) {
+		return compareObjects(o, other, interrupted)
+	}, interrupted)
+	if err != ErrInterrupted {
+		t.Errorf("expected ErrInterrupted, actual %v", err)
+	}
+	re, err := compileRegex("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := newRegexMatcher(re, strings.Repeat("a", 10))
+	m.check = nativeCheck(interrupted)
+	if _, err := m.replace("b", true); err != ErrInterrupted {
+		t.Errorf("expected ErrInterrupted, actual %v", err)
+	}
+	w := newJsonWriter(false)
+	w.check = nativeCheck(interrupted)
+	if err := w.writeObject(NewInteger(1), false); err != ErrInterrupted {
+		t.Errorf("expected ErrInterrupted, actual %v", err)
+	}
+}
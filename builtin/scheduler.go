@@ -0,0 +1,297 @@
+package builtin
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+const AsyncJobTypeScheduledApex = "ScheduledApex"
+
+// CronTrigger is Schedulable object scheduled by System.schedule
+type CronTrigger struct {
+	Id               string
+	Name             string
+	JobDetailId      string
+	Expression       *CronExpression
+	Receiver         *ast.Object
+	Job              *AsyncJob
+	State            string
+	NextFireTime     *time.Time
+	PreviousFireTime *time.Time
+	TimesTriggered   int
+	Node             ast.Node
+}
+
+// Scheduler holds scheduled jobs and their CronTrigger records.
+// Jobs are fired by the interpreter at Test.stopTest, or when the clock reaches next fire time.
+type Scheduler struct {
+	triggers []*CronTrigger
+	clock    *Clock
+}
+
+// NewScheduler creates the scheduler which computes next fire times from the clock
+func NewScheduler(clock *Clock) *Scheduler {
+	return &Scheduler{triggers: []*CronTrigger{}, clock: clock}
+}
+
+// cronTriggerSequence is shared by interpreters running concurrently in the servers, it is incremented atomically
+var cronTriggerSequence int64
+
+func (s *Scheduler) Schedule(trigger *CronTrigger, queue *AsyncJobQueue) error {
+	for _, t := range s.triggers {
+		if t.Name == trigger.Name {
+			return fmt.Errorf("The Apex job named \"%s\" is already scheduled for execution.", trigger.Name)
+		}
+	}
+	now := s.clock.Now()
+	trigger.NextFireTime = trigger.Expression.Next(now)
+	if trigger.NextFireTime == nil {
+		return fmt.Errorf("Based on configured schedule, the given trigger '%s' will never fire.", trigger.Name)
+	}
+	sequence := atomic.AddInt64(&cronTriggerSequence, 1)
+	trigger.Id = fmt.Sprintf("08e%012d", sequence)
+	trigger.JobDetailId = fmt.Sprintf("08a%012d", sequence)
+	trigger.State = "WAITING"
+	trigger.Job = &AsyncJob{
+		Id:       queue.NextId(),
+		JobType:  AsyncJobTypeScheduledApex,
+		Receiver: trigger.Receiver,
+		Node:     trigger.Node,
+	}
+	s.triggers = append(s.triggers, trigger)
+
+	cronJobDetail.Insert(map[string]interface{}{
+		"Id":      trigger.JobDetailId,
+		"Name":    trigger.Name,
+		"JobType": "7", // Scheduled Apex
+	})
+	cronTrigger.Insert(map[string]interface{}{
+		"Id":              trigger.Id,
+		"CronExpression":  trigger.Expression.Expression,
+		"CronJobDetailId": trigger.JobDetailId,
+		"State":           trigger.State,
+		"StartTime":       now.Format(datetimeLayout),
+		"NextFireTime":    trigger.NextFireTime.Format(datetimeLayout),
+		"TimesTriggered":  0,
+		"TimeZoneSidKey":  now.Location().String(),
+	})
+	asyncApexJob.Insert(map[string]interface{}{
+		"Id":                trigger.Job.Id,
+		"JobType":           AsyncJobTypeScheduledApex,
+		"ApexClassName":     trigger.Receiver.ClassType.Name,
+		"Status":            "Queued",
+		"NumberOfErrors":    0,
+		"TotalJobItems":     0,
+		"JobItemsProcessed": 0,
+		"CreatedDate":       now.Format(datetimeLayout),
+	})
+	return nil
+}
+
+// Abort removes the scheduled job by CronTrigger id, returns false if the trigger does not exist
+func (s *Scheduler) Abort(id string) bool {
+	for i, trigger := range s.triggers {
+		if trigger.Id == id {
+			s.triggers = append(s.triggers[:i], s.triggers[i+1:]...)
+			cronTrigger.Delete(trigger.Id)
+			cronJobDetail.Delete(trigger.JobDetailId)
+			asyncApexJob.Update(trigger.Job.Id, map[string]interface{}{
+				"Status":        "Aborted",
+				"CompletedDate": s.clock.Now().Format(datetimeLayout),
+			})
+			return true
+		}
+	}
+	return false
+}
+
+// Waiting returns triggers waiting for next fire time
+func (s *Scheduler) Waiting() []*CronTrigger {
+	triggers := []*CronTrigger{}
+	for _, trigger := range s.triggers {
+		if trigger.State == "WAITING" {
+			triggers = append(triggers, trigger)
+		}
+	}
+	return triggers
+}
+
+// NextDue returns the waiting trigger which fires first until the time, or nil if there is no such trigger
+func (s *Scheduler) NextDue(until time.Time) *CronTrigger {
+	var next *CronTrigger
+	for _, trigger := range s.Waiting() {
+		if trigger.NextFireTime.After(until) {
+			continue
+		}
+		if next == nil || trigger.NextFireTime.Before(*next.NextFireTime) {
+			next = trigger
+		}
+	}
+	return next
+}
+
+// Start marks the trigger as executing
+func (s *Scheduler) Start(trigger *CronTrigger) {
+	trigger.State = "EXECUTING"
+	cronTrigger.Update(trigger.Id, map[string]interface{}{
+		"State": trigger.State,
+	})
+}
+
+// Finish records the execution fired at the time, and computes next fire time.
+// The trigger is completed if the expression never fires again.
+func (s *Scheduler) Finish(trigger *CronTrigger, firedAt time.Time, err error) {
+	trigger.TimesTriggered++
+	trigger.PreviousFireTime = &firedAt
+	trigger.NextFireTime = trigger.Expression.Next(firedAt)
+	var nextFireTime interface{}
+	var endTime interface{}
+	if trigger.NextFireTime != nil {
+		trigger.State = "WAITING"
+		nextFireTime = trigger.NextFireTime.Format(datetimeLayout)
+	} else {
+		trigger.State = "COMPLETE"
+		endTime = firedAt.Format(datetimeLayout)
+	}
+	cronTrigger.Update(trigger.Id, map[string]interface{}{
+		"State":            trigger.State,
+		"NextFireTime":     nextFireTime,
+		"PreviousFireTime": firedAt.Format(datetimeLayout),
+		"EndTime":          endTime,
+		"TimesTriggered":   trigger.TimesTriggered,
+	})
+
+	job := trigger.Job
+	job.JobItemsProcessed++
+	message := ""
+	if err != nil {
+		job.NumberOfErrors++
+		message = err.Error()
+	}
+	status := "Queued"
+	if trigger.State == "COMPLETE" {
+		status = "Completed"
+	}
+	values := map[string]interface{}{
+		"Status":            status,
+		"ExtendedStatus":    message,
+		"NumberOfErrors":    job.NumberOfErrors,
+		"JobItemsProcessed": job.JobItemsProcessed,
+	}
+	if status == "Completed" {
+		values["CompletedDate"] = firedAt.Format(datetimeLayout)
+	}
+	asyncApexJob.Update(job.Id, values)
+}
+
+var cronJobDetail *systemSObject
+var cronTrigger *systemSObject
+
+var schedulableContextType = ast.CreateClass(
+	"SchedulableContext",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var schedulableType = ast.CreateClass(
+	"Schedulable",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+func NewSchedulableContext(triggerId string) *ast.Object {
+	ctx := ast.CreateObject(schedulableContextType)
+	ctx.Extra["triggerId"] = triggerId
+	return ctx
+}
+
+// schedule is native function of System.schedule
+func schedule(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+	expression, err := ParseCronExpression(params[1].StringValue())
+	if err != nil {
+		return Raise(StringExceptionType, err.Error())
+	}
+	trigger := &CronTrigger{
+		Name:       params[0].StringValue(),
+		Expression: expression,
+		Receiver:   params[2],
+	}
+	if node, ok := extra["node"].(ast.Node); ok {
+		trigger.Node = node
+	}
+	err = extra["scheduler"].(*Scheduler).Schedule(trigger, extra["async_jobs"].(*AsyncJobQueue))
+	if err != nil {
+		return Raise(AsyncExceptionType, err.Error())
+	}
+	return NewString(trigger.Id)
+}
+
+// abortJob is native function of System.abortJob, the id is CronTrigger id or AsyncApexJob id
+func abortJob(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+	id := params[0].StringValue()
+	if extra["scheduler"].(*Scheduler).Abort(id) {
+		return nil
+	}
+	if extra["async_jobs"].(*AsyncJobQueue).Abort(id) {
+		return nil
+	}
+	return Raise(StringExceptionType, fmt.Sprintf("Invalid id: %s", id))
+}
+
+func init() {
+	schedulableContextType.Interface = true
+	schedulableContextType.InstanceMethods.Set(
+		"getTriggerId",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getTriggerId",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(this.Extra["triggerId"].(string))
+				},
+			),
+		},
+	)
+
+	schedulableType.Interface = true
+	schedulableType.InstanceMethods.Set(
+		"execute",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"execute",
+				nil,
+				[]*ast.Parameter{
+					{Type: schedulableContextType, Name: "_"},
+				},
+				nil,
+			),
+		},
+	)
+
+	cronJobDetail = newSystemSObject("CronJobDetail", []systemSObjectField{
+		{Name: "Id", Type: StringType},
+		{Name: "Name", Type: StringType},
+		{Name: "JobType", Type: StringType},
+	})
+	cronTrigger = newSystemSObject("CronTrigger", []systemSObjectField{
+		{Name: "Id", Type: StringType},
+		{Name: "CronExpression", Type: StringType},
+		{Name: "CronJobDetailId", Type: StringType, ReferenceTo: cronJobDetail},
+		{Name: "State", Type: StringType},
+		{Name: "StartTime", Type: DatetimeType},
+		{Name: "EndTime", Type: DatetimeType},
+		{Name: "NextFireTime", Type: DatetimeType},
+		{Name: "PreviousFireTime", Type: DatetimeType},
+		{Name: "TimesTriggered", Type: IntegerType},
+		{Name: "TimeZoneSidKey", Type: StringType},
+	})
+
+	systemNameSpace.Set("Schedulable", schedulableType)
+	systemNameSpace.Set("SchedulableContext", schedulableContextType)
+}
@@ -19,16 +19,13 @@ func init() {
 						Parameters: []*ast.Parameter{},
 						ReturnType: CreateMapType(StringType, schemaSObjectType),
 						NativeFunction: func(this *ast.Object, parameter []*ast.Object, extra map[string]interface{}) interface{} {
-							mapType := CreateMapType(StringType, schemaSObjectType)
-							newObj := ast.CreateObject(mapType)
-							values := map[string]*ast.Object{}
+							values := NewOrderedMap()
 							for name, _ := range sObjects {
 								valueObj := ast.CreateObject(schemaSObjectType)
 								valueObj.Extra["type"] = name
-								values[name] = valueObj
+								values.Put(NewString(name), valueObj)
 							}
-							newObj.Extra["values"] = values
-							return newObj
+							return CreateMapObject(CreateMapType(StringType, schemaSObjectType), values)
 						},
 					},
 				},
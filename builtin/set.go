@@ -1,11 +1,60 @@
 package builtin
 
-import "github.com/tzmfreedom/land/ast"
+import (
+	"fmt"
+	"strings"
 
-var setType = createSetType()
+	"github.com/tzmfreedom/land/ast"
+)
 
-func createSetType() *ast.ClassType {
-	instanceMethods := ast.NewMethodMap()
+// setType is allocated before init because Set types are copied by other builtin types,
+// they share methods with this type.
+var setType = &ast.ClassType{
+	Name:            "Set",
+	InstanceFields:  ast.NewFieldMap(),
+	InstanceMethods: ast.NewMethodMap(),
+	StaticFields:    ast.NewFieldMap(),
+	StaticMethods:   ast.NewMethodMap(),
+}
+
+func CreateSetType(classType *ast.ClassType) *ast.ClassType {
+	return &ast.ClassType{
+		Name:            "Set",
+		Modifiers:       setType.Modifiers,
+		Constructors:    setType.Constructors,
+		InstanceFields:  setType.InstanceFields,
+		InstanceMethods: setType.InstanceMethods,
+		StaticFields:    setType.StaticFields,
+		StaticMethods:   setType.StaticMethods,
+		Generics:        []*ast.ClassType{classType},
+		ToString:        setType.ToString,
+	}
+}
+
+func CreateSetTypeParameter(classType *ast.ClassType) *ast.Parameter {
+	return &ast.Parameter{
+		Type: CreateSetType(classType),
+		Name: "_",
+	}
+}
+
+// CreateSetObject creates set object of the set type with the elements, duplicated elements are ignored
+func CreateSetObject(classType *ast.ClassType, elements []*ast.Object) *ast.Object {
+	values := NewOrderedMap()
+	for _, element := range elements {
+		values.Put(element, element)
+	}
+	setObj := ast.CreateObject(classType)
+	setObj.Extra["values"] = values
+	return setObj
+}
+
+func setValues(o *ast.Object) *OrderedMap {
+	return o.Extra["values"].(*OrderedMap)
+}
+
+func createSetType() {
+	instanceMethods := setType.InstanceMethods
 	instanceMethods.Set(
 		"size",
 		[]*ast.Method{
@@ -14,7 +63,20 @@ func createSetType() *ast.ClassType {
 				IntegerType,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					return NewInteger(len(this.Extra["values"].(map[string]struct{})))
+					return NewInteger(setValues(this).Size())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"isEmpty",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"isEmpty",
+				BooleanType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(setValues(this).Size() == 0)
 				},
 			),
 		},
@@ -24,17 +86,110 @@ func createSetType() *ast.ClassType {
 		[]*ast.Method{
 			ast.CreateMethod(
 				"add",
-				IntegerType,
+				BooleanType,
 				[]*ast.Parameter{t1Parameter},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					key := params[0].StringValue()
-					values := this.Extra["values"].(map[string]struct{})
-					values[key] = struct{}{}
-					return nil
+					_, exists := setValues(this).Put(params[0], params[0])
+					return NewBoolean(!exists)
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"addAll",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"addAll",
+				BooleanType,
+				[]*ast.Parameter{CreateListTypeParameter(T1type)},
+				setAddAll,
+			),
+			ast.CreateMethod(
+				"addAll",
+				BooleanType,
+				[]*ast.Parameter{CreateSetTypeParameter(T1type)},
+				setAddAll,
+			),
+		},
+	)
+	instanceMethods.Set(
+		"contains",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"contains",
+				BooleanType,
+				[]*ast.Parameter{t1Parameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewBoolean(setValues(this).ContainsKey(params[0]))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"containsAll",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"containsAll",
+				BooleanType,
+				[]*ast.Parameter{CreateListTypeParameter(T1type)},
+				setContainsAll,
+			),
+			ast.CreateMethod(
+				"containsAll",
+				BooleanType,
+				[]*ast.Parameter{CreateSetTypeParameter(T1type)},
+				setContainsAll,
+			),
+		},
+	)
+	instanceMethods.Set(
+		"remove",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"remove",
+				BooleanType,
+				[]*ast.Parameter{t1Parameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					_, removed := setValues(this).Remove(params[0])
+					return NewBoolean(removed)
 				},
 			),
 		},
 	)
+	instanceMethods.Set(
+		"removeAll",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"removeAll",
+				BooleanType,
+				[]*ast.Parameter{CreateListTypeParameter(T1type)},
+				setRemoveAll,
+			),
+			ast.CreateMethod(
+				"removeAll",
+				BooleanType,
+				[]*ast.Parameter{CreateSetTypeParameter(T1type)},
+				setRemoveAll,
+			),
+		},
+	)
+	instanceMethods.Set(
+		"retainAll",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"retainAll",
+				BooleanType,
+				[]*ast.Parameter{CreateListTypeParameter(T1type)},
+				setRetainAll,
+			),
+			ast.CreateMethod(
+				"retainAll",
+				BooleanType,
+				[]*ast.Parameter{CreateSetTypeParameter(T1type)},
+				setRetainAll,
+			),
+		},
+	)
 	instanceMethods.Set(
 		"clear",
 		[]*ast.Method{
@@ -43,28 +198,137 @@ func createSetType() *ast.ClassType {
 				nil,
 				[]*ast.Parameter{},
 				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					this.Extra["values"] = map[string]struct{}{}
+					this.Extra["values"] = NewOrderedMap()
 					return nil
 				},
 			),
 		},
 	)
-
-	return &ast.ClassType{
-		Name: "Set",
-		Constructors: []*ast.Method{
-			{
-				Modifiers:  []*ast.Modifier{ast.PublicModifier()},
-				Parameters: []*ast.Parameter{},
-				NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-					return nil
+	instanceMethods.Set(
+		"clone",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"clone",
+				CreateSetType(T1type),
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return CreateSetObject(this.ClassType, setValues(this).Keys())
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"equals",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"equals",
+				BooleanType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					other := params[0]
+					if other == Null || other.ClassType.Name != "Set" {
+						return NewBoolean(false)
+					}
+					values := setValues(this)
+					otherValues := setValues(other)
+					if values.Size() != otherValues.Size() {
+						return NewBoolean(false)
+					}
+					for _, value := range otherValues.Keys() {
+						if !values.ContainsKey(value) {
+							return NewBoolean(false)
+						}
+					}
+					return NewBoolean(true)
 				},
+			),
+		},
+	)
+
+	setType.ToString = func(o *ast.Object) string {
+		keys := setValues(o).Keys()
+		elements := make([]string, len(keys))
+		for i, key := range keys {
+			elements[i] = String(key)
+		}
+		if len(elements) > 0 {
+			return fmt.Sprintf("<Set> { %s }", strings.Join(elements, ", "))
+		}
+		return "<Set> {}"
+	}
+	setType.Constructors = []*ast.Method{
+		{
+			Modifiers:  []*ast.Modifier{ast.PublicModifier()},
+			Parameters: []*ast.Parameter{},
+			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return nil
+			},
+		},
+		{
+			Modifiers:  []*ast.Modifier{ast.PublicModifier()},
+			Parameters: []*ast.Parameter{CreateListTypeParameter(T1type)},
+			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				this.Extra["values"] = CreateSetObject(this.ClassType, iterableElements(params[0])).Extra["values"]
+				return nil
 			},
 		},
-		InstanceMethods: instanceMethods,
+		{
+			Modifiers:  []*ast.Modifier{ast.PublicModifier()},
+			Parameters: []*ast.Parameter{CreateSetTypeParameter(T1type)},
+			NativeFunction: func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				this.Extra["values"] = setValues(params[0]).Clone()
+				return nil
+			},
+		},
+	}
+}
+
+func setAddAll(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+	values := setValues(this)
+	changed := false
+	for _, element := range iterableElements(params[0]) {
+		if _, exists := values.Put(element, element); !exists {
+			changed = true
+		}
+	}
+	return NewBoolean(changed)
+}
+
+func setContainsAll(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+	values := setValues(this)
+	for _, element := range iterableElements(params[0]) {
+		if !values.ContainsKey(element) {
+			return NewBoolean(false)
+		}
+	}
+	return NewBoolean(true)
+}
+
+func setRemoveAll(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+	values := setValues(this)
+	changed := false
+	for _, element := range iterableElements(params[0]) {
+		if _, removed := values.Remove(element); removed {
+			changed = true
+		}
+	}
+	return NewBoolean(changed)
+}
+
+func setRetainAll(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+	values := setValues(this)
+	retained := CreateSetObject(this.ClassType, iterableElements(params[0]))
+	changed := false
+	for _, element := range values.Keys() {
+		if !setValues(retained).ContainsKey(element) {
+			values.Remove(element)
+			changed = true
+		}
 	}
+	return NewBoolean(changed)
 }
 
 func init() {
+	createSetType()
 	primitiveClassMap.Set("set", setType)
 }
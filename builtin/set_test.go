@@ -0,0 +1,78 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// resultString returns the string of the return value of native methods to compare in tests
+func resultString(r interface{}) string {
+	switch v := r.(type) {
+	case *ast.Object:
+		if v.ClassType == RaiseType {
+			return NewExceptionError(v.Value().(*ast.Object)).Error()
+		}
+		return String(v)
+	case error:
+		return v.Error()
+	}
+	return ""
+}
+
+func TestSet(t *testing.T) {
+	set := func(elements ...*ast.Object) *ast.Object {
+		return CreateSetObject(CreateSetType(ObjectType), elements)
+	}
+	list := func(elements ...*ast.Object) *ast.Object {
+		return CreateListObject(CreateListType(ObjectType), elements)
+	}
+	one, two, three := NewInteger(1), NewInteger(2), NewInteger(3)
+	testCases := []struct {
+		Receiver *ast.Object
+		Method   string
+		Params   []*ast.Object
+		Expected string
+		Elements string
+	}{
+		{set(one, NewLong(1), NewInteger(1)), "size", []*ast.Object{}, "2", "<Set> { 1, 1 }"},
+		{set(), "isEmpty", []*ast.Object{}, "true", "<Set> {}"},
+		{set(NewString("a")), "add", []*ast.Object{NewString("b")}, "true", "<Set> { a, b }"},
+		{set(NewString("a")), "add", []*ast.Object{NewString("a")}, "false", "<Set> { a }"},
+		{set(NewString("a")), "add", []*ast.Object{NewString("A")}, "true", "<Set> { a, A }"},
+		{set(NewString("b"), NewString("a")), "addAll", []*ast.Object{list(NewString("a"), NewString("c"))}, "true", "<Set> { b, a, c }"},
+		{set(NewString("a")), "addAll", []*ast.Object{set(NewString("a"))}, "false", "<Set> { a }"},
+		{set(one), "contains", []*ast.Object{NewLong(1)}, "false", "<Set> { 1 }"},
+		{set(one), "contains", []*ast.Object{Null}, "false", "<Set> { 1 }"},
+		{set(one, Null), "contains", []*ast.Object{Null}, "true", "<Set> { 1, null }"},
+		{set(one, two, three), "containsAll", []*ast.Object{list(three, one)}, "true", "<Set> { 1, 2, 3 }"},
+		{set(one, two, three), "containsAll", []*ast.Object{set(one, NewInteger(4))}, "false", "<Set> { 1, 2, 3 }"},
+		{set(one, two, three), "remove", []*ast.Object{two}, "true", "<Set> { 1, 3 }"},
+		{set(one, two, three), "remove", []*ast.Object{NewLong(2)}, "false", "<Set> { 1, 2, 3 }"},
+		{set(one, two, three), "removeAll", []*ast.Object{list(three, one)}, "true", "<Set> { 2 }"},
+		{set(one, two, three), "retainAll", []*ast.Object{list(two, NewInteger(4))}, "true", "<Set> { 2 }"},
+		{set(one, two, three), "retainAll", []*ast.Object{set(three, two, one)}, "false", "<Set> { 1, 2, 3 }"},
+		{set(one, two), "equals", []*ast.Object{set(two, one)}, "true", "<Set> { 1, 2 }"},
+		{set(one, two), "equals", []*ast.Object{set(one, NewLong(2))}, "false", "<Set> { 1, 2 }"},
+		{set(one, two), "equals", []*ast.Object{list(one, two)}, "false", "<Set> { 1, 2 }"},
+		{set(one, two), "clear", []*ast.Object{}, "", "<Set> {}"},
+	}
+	for i, testCase := range testCases {
+		r := callNativeMethod(testCase.Receiver, testCase.Method, testCase.Params, nil)
+		if actual := resultString(r); actual != testCase.Expected {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Expected, actual)
+		}
+		if actual := String(testCase.Receiver); actual != testCase.Elements {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Elements, actual)
+		}
+	}
+}
+
+func TestSetClone(t *testing.T) {
+	s := CreateSetObject(CreateSetType(IntegerType), []*ast.Object{NewInteger(1), NewInteger(2)})
+	c := callNativeMethod(s, "clone", []*ast.Object{}, nil).(*ast.Object)
+	callNativeMethod(c, "add", []*ast.Object{NewInteger(3)}, nil)
+	if String(s) != "<Set> { 1, 2 }" || String(c) != "<Set> { 1, 2, 3 }" {
+		t.Errorf("expected the clone is independent, actual %s and %s", String(s), String(c))
+	}
+}
@@ -0,0 +1,566 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// localeData is calendar symbols and default formats of the locale used by SimpleDateFormat
+type localeData struct {
+	months             []string
+	shortMonths        []string
+	weekdays           []string
+	shortWeekdays      []string
+	ampm               []string
+	firstDay           time.Weekday
+	minDays            int
+	datetimeFormat     string
+	longDatetimeFormat string
+	dateFormat         string
+}
+
+var englishMonths = []string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"}
+var englishShortMonths = []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+var englishWeekdays = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+var englishShortWeekdays = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+var locales = map[string]*localeData{
+	"en_US": {
+		months:             englishMonths,
+		shortMonths:        englishShortMonths,
+		weekdays:           englishWeekdays,
+		shortWeekdays:      englishShortWeekdays,
+		ampm:               []string{"AM", "PM"},
+		firstDay:           time.Sunday,
+		minDays:            1,
+		datetimeFormat:     "M/d/yyyy h:mm a",
+		longDatetimeFormat: "M/d/yyyy h:mm:ss a z",
+		dateFormat:         "M/d/yyyy",
+	},
+	"en_GB": {
+		months:             englishMonths,
+		shortMonths:        englishShortMonths,
+		weekdays:           englishWeekdays,
+		shortWeekdays:      englishShortWeekdays,
+		ampm:               []string{"AM", "PM"},
+		firstDay:           time.Monday,
+		minDays:            4,
+		datetimeFormat:     "dd/MM/yyyy HH:mm",
+		longDatetimeFormat: "dd/MM/yyyy HH:mm:ss z",
+		dateFormat:         "dd/MM/yyyy",
+	},
+	"de_DE": {
+		months:             []string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		shortMonths:        []string{"Jan", "Feb", "Mär", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez"},
+		weekdays:           []string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		shortWeekdays:      []string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+		ampm:               []string{"AM", "PM"},
+		firstDay:           time.Monday,
+		minDays:            4,
+		datetimeFormat:     "dd.MM.yyyy HH:mm",
+		longDatetimeFormat: "dd.MM.yyyy HH:mm:ss z",
+		dateFormat:         "dd.MM.yyyy",
+	},
+	"ja_JP": {
+		months:             []string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		shortMonths:        []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+		weekdays:           []string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		shortWeekdays:      []string{"日", "月", "火", "水", "木", "金", "土"},
+		ampm:               []string{"午前", "午後"},
+		firstDay:           time.Sunday,
+		minDays:            1,
+		datetimeFormat:     "yyyy/MM/dd H:mm",
+		longDatetimeFormat: "yyyy/MM/dd H:mm:ss z",
+		dateFormat:         "yyyy/MM/dd",
+	},
+}
+
+// getLocaleData returns symbols of the locale, the locale of same language or en_US is used for unknown locale.
+// If several locales have the language, the first one in alphabetical order is used, such as en_GB for en_AU.
+func getLocaleData(locale string) *localeData {
+	if data, ok := locales[locale]; ok {
+		return data
+	}
+	language := strings.SplitN(locale, "_", 2)[0]
+	names := make([]string, 0, len(locales))
+	for name := range locales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if strings.HasPrefix(name, language+"_") {
+			return locales[name]
+		}
+	}
+	return locales["en_US"]
+}
+
+// dateFormatToken is a pattern letter with count or literal text of SimpleDateFormat pattern
+type dateFormatToken struct {
+	letter  rune
+	count   int
+	literal string
+}
+
+// compileDateFormat splits the pattern into tokens with Java SimpleDateFormat syntax
+func compileDateFormat(pattern string) ([]*dateFormatToken, error) {
+	tokens := []*dateFormatToken{}
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		switch {
+		case c == '\'':
+			if i+1 < len(runes) && runes[i+1] == '\'' {
+				tokens = append(tokens, &dateFormatToken{literal: "'"})
+				i += 2
+				continue
+			}
+			literal := []rune{}
+			i++
+			for ; i < len(runes); i++ {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						literal = append(literal, '\'')
+						i++
+						continue
+					}
+					break
+				}
+				literal = append(literal, runes[i])
+			}
+			if i == len(runes) {
+				return nil, errors.New("Unterminated quote")
+			}
+			i++
+			tokens = append(tokens, &dateFormatToken{literal: string(literal)})
+		case c <= unicode.MaxASCII && unicode.IsLetter(c):
+			if !strings.ContainsRune("GyYMLwWDdFEuaHkKhmsSzZX", c) {
+				return nil, fmt.Errorf("Illegal pattern character '%c'", c)
+			}
+			count := 1
+			for i+count < len(runes) && runes[i+count] == c {
+				count++
+			}
+			tokens = append(tokens, &dateFormatToken{letter: c, count: count})
+			i += count
+		default:
+			tokens = append(tokens, &dateFormatToken{literal: string(c)})
+			i++
+		}
+	}
+	return tokens, nil
+}
+
+// FormatDate formats the time with Java SimpleDateFormat pattern in the time zone and the locale
+func FormatDate(t time.Time, pattern string, loc *time.Location, locale string) (string, error) {
+	tokens, err := compileDateFormat(pattern)
+	if err != nil {
+		return "", err
+	}
+	t = t.In(loc)
+	data := getLocaleData(locale)
+	var b strings.Builder
+	for _, token := range tokens {
+		if token.letter == 0 {
+			b.WriteString(token.literal)
+			continue
+		}
+		count := token.count
+		switch token.letter {
+		case 'G':
+			if t.Year() > 0 {
+				b.WriteString("AD")
+			} else {
+				b.WriteString("BC")
+			}
+		case 'y':
+			writeYear(&b, t.Year(), count)
+		case 'Y':
+			year, _ := weekOfYear(t, data.firstDay, data.minDays)
+			writeYear(&b, year, count)
+		case 'M', 'L':
+			switch {
+			case count >= 4:
+				b.WriteString(data.months[t.Month()-1])
+			case count == 3:
+				b.WriteString(data.shortMonths[t.Month()-1])
+			default:
+				writeNumber(&b, int(t.Month()), count)
+			}
+		case 'w':
+			_, week := weekOfYear(t, data.firstDay, data.minDays)
+			writeNumber(&b, week, count)
+		case 'W':
+			writeNumber(&b, weekOfMonth(t, data.firstDay, data.minDays), count)
+		case 'D':
+			writeNumber(&b, t.YearDay(), count)
+		case 'd':
+			writeNumber(&b, t.Day(), count)
+		case 'F':
+			writeNumber(&b, (t.Day()-1)/7+1, count)
+		case 'E':
+			if count >= 4 {
+				b.WriteString(data.weekdays[t.Weekday()])
+			} else {
+				b.WriteString(data.shortWeekdays[t.Weekday()])
+			}
+		case 'u':
+			day := int(t.Weekday())
+			if day == 0 {
+				day = 7
+			}
+			writeNumber(&b, day, count)
+		case 'a':
+			b.WriteString(data.ampm[t.Hour()/12])
+		case 'H':
+			writeNumber(&b, t.Hour(), count)
+		case 'k':
+			hour := t.Hour()
+			if hour == 0 {
+				hour = 24
+			}
+			writeNumber(&b, hour, count)
+		case 'K':
+			writeNumber(&b, t.Hour()%12, count)
+		case 'h':
+			hour := t.Hour() % 12
+			if hour == 0 {
+				hour = 12
+			}
+			writeNumber(&b, hour, count)
+		case 'm':
+			writeNumber(&b, t.Minute(), count)
+		case 's':
+			writeNumber(&b, t.Second(), count)
+		case 'S':
+			writeNumber(&b, t.Nanosecond()/int(time.Millisecond), count)
+		case 'z':
+			if count >= 4 {
+				b.WriteString(timeZoneLongName(t))
+			} else {
+				b.WriteString(timeZoneShortName(t))
+			}
+		case 'Z':
+			_, offset := t.Zone()
+			b.WriteString(formatOffset(offset, false))
+		case 'X':
+			_, offset := t.Zone()
+			switch {
+			case offset == 0:
+				b.WriteString("Z")
+			case count == 1:
+				b.WriteString(formatOffset(offset, false)[:3])
+			case count == 2:
+				b.WriteString(formatOffset(offset, false))
+			default:
+				b.WriteString(formatOffset(offset, true))
+			}
+		}
+	}
+	return b.String(), nil
+}
+
+func writeYear(b *strings.Builder, year, count int) {
+	if count == 2 {
+		writeNumber(b, year%100, 2)
+		return
+	}
+	writeNumber(b, year, count)
+}
+
+func writeNumber(b *strings.Builder, value, count int) {
+	s := strconv.Itoa(value)
+	if len(s) < count {
+		b.WriteString(strings.Repeat("0", count-len(s)))
+	}
+	b.WriteString(s)
+}
+
+// formatOffset formats the offset seconds such as -0800 or -08:00
+func formatOffset(offset int, colon bool) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	if colon {
+		return fmt.Sprintf("%s%02d:%02d", sign, offset/3600, offset/60%60)
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset/60%60)
+}
+
+// timeZoneShortName returns abbreviation of the zone such as PST, GMT+09:00 is used if the zone has no abbreviation
+func timeZoneShortName(t time.Time) string {
+	name, offset := t.Zone()
+	if name == "" || name[0] == '+' || name[0] == '-' {
+		return "GMT" + formatOffset(offset, true)
+	}
+	return name
+}
+
+// timeZoneLongName returns display name of the zone, daylight saving time name is used in summer time
+func timeZoneLongName(t time.Time) string {
+	name := timeZoneDisplayName(t.Location())
+	if t.IsDST() {
+		return strings.Replace(name, "Standard", "Daylight", 1)
+	}
+	return name
+}
+
+// firstWeekStart returns the first day of week 1 in the year,
+// week 1 is the first week which has at least minDays days in the year
+func firstWeekStart(year int, loc *time.Location, firstDay time.Weekday, minDays int) time.Time {
+	jan1 := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+	offset := (int(jan1.Weekday()) - int(firstDay) + 7) % 7
+	start := jan1.AddDate(0, 0, -offset)
+	if 7-offset < minDays {
+		start = start.AddDate(0, 0, 7)
+	}
+	return start
+}
+
+// weekOfYear returns week year and week number of the time with the week definition of the locale
+func weekOfYear(t time.Time, firstDay time.Weekday, minDays int) (int, int) {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	year := day.Year()
+	start := firstWeekStart(year, day.Location(), firstDay, minDays)
+	if day.Before(start) {
+		year--
+		start = firstWeekStart(year, day.Location(), firstDay, minDays)
+	} else if next := firstWeekStart(year+1, day.Location(), firstDay, minDays); !day.Before(next) {
+		year++
+		start = next
+	}
+	days := int(day.Sub(start).Hours()+12) / 24
+	return year, days/7 + 1
+}
+
+// weekOfMonth returns week number in the month, days before the first week are week 0
+func weekOfMonth(t time.Time, firstDay time.Weekday, minDays int) int {
+	first := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	offset := (int(first.Weekday()) - int(firstDay) + 7) % 7
+	week := (t.Day() - 1 + offset) / 7
+	if 7-offset >= minDays {
+		week++
+	}
+	return week
+}
+
+// ParseDate parses the value with Java SimpleDateFormat pattern, the value is interpreted in the time zone
+// unless the value has time zone of z, Z or X letter
+func ParseDate(value, pattern string, loc *time.Location, locale string) (time.Time, error) {
+	tokens, err := compileDateFormat(pattern)
+	if err != nil {
+		return time.Time{}, err
+	}
+	data := getLocaleData(locale)
+	year, month, day := 1970, 1, 1
+	hour, minute, second, millisecond := 0, 0, 0, 0
+	pm := -1
+	hour12 := false
+	var zone *time.Location
+	input := []rune(value)
+	pos := 0
+	invalid := fmt.Errorf("Invalid date/time: %s", value)
+
+	for i, token := range tokens {
+		if token.letter == 0 {
+			literal := []rune(token.literal)
+			if pos+len(literal) > len(input) || string(input[pos:pos+len(literal)]) != token.literal {
+				return time.Time{}, invalid
+			}
+			pos += len(literal)
+			continue
+		}
+		switch token.letter {
+		case 'M', 'L':
+			if token.count >= 3 {
+				names := data.shortMonths
+				if token.count >= 4 {
+					names = data.months
+				}
+				index, length := matchName(input[pos:], names)
+				if index < 0 {
+					return time.Time{}, invalid
+				}
+				month = index + 1
+				pos += length
+				continue
+			}
+		case 'E':
+			names := data.shortWeekdays
+			if token.count >= 4 {
+				names = data.weekdays
+			}
+			_, length := matchName(input[pos:], names)
+			if length == 0 {
+				return time.Time{}, invalid
+			}
+			pos += length
+			continue
+		case 'a':
+			index, length := matchName(input[pos:], data.ampm)
+			if index < 0 {
+				return time.Time{}, invalid
+			}
+			pm = index
+			pos += length
+			continue
+		case 'G':
+			if pos+2 > len(input) {
+				return time.Time{}, invalid
+			}
+			pos += 2
+			continue
+		case 'z', 'Z', 'X':
+			location, length := parseZone(input[pos:])
+			if location == nil {
+				return time.Time{}, invalid
+			}
+			zone = location
+			pos += length
+			continue
+		}
+
+		// numeric field takes the count of digits if the next token is also a number, otherwise all digits
+		maxDigits := len(input) - pos
+		if i+1 < len(tokens) && isNumericToken(tokens[i+1]) {
+			maxDigits = token.count
+		}
+		start := pos
+		if pos < len(input) && input[pos] == '-' && (token.letter == 'y' || token.letter == 'Y') {
+			pos++
+		}
+		for pos < len(input) && pos-start < maxDigits && input[pos] >= '0' && input[pos] <= '9' {
+			pos++
+		}
+		number, err := strconv.Atoi(string(input[start:pos]))
+		if err != nil {
+			return time.Time{}, invalid
+		}
+		switch token.letter {
+		case 'y', 'Y':
+			year = number
+			if token.count <= 2 && pos-start <= 2 {
+				year += 2000
+			}
+		case 'M', 'L':
+			month = number
+		case 'd':
+			day = number
+		case 'H', 'k':
+			hour = number % 24
+		case 'K', 'h':
+			hour = number % 12
+			hour12 = true
+		case 'm':
+			minute = number
+		case 's':
+			second = number
+		case 'S':
+			millisecond = number
+		}
+	}
+	if pos != len(input) {
+		return time.Time{}, invalid
+	}
+	if pm == 1 {
+		hour = hour%12 + 12
+	} else if pm == 0 && hour12 {
+		hour = hour % 12
+	}
+	if month < 1 || month > 12 || day < 1 || day > 31 || hour > 23 || minute > 59 || second > 59 {
+		return time.Time{}, invalid
+	}
+	if zone == nil {
+		zone = loc
+	}
+	return time.Date(year, time.Month(month), day, hour, minute, second, millisecond*int(time.Millisecond), zone), nil
+}
+
+func isNumericToken(token *dateFormatToken) bool {
+	if token.letter == 0 {
+		return false
+	}
+	if token.letter == 'M' || token.letter == 'L' {
+		return token.count <= 2
+	}
+	return strings.ContainsRune("yYwWDdFuHkKhmsS", token.letter)
+}
+
+// matchName returns index and length of the longest name matched with the head of the input ignoring case
+func matchName(input []rune, names []string) (int, int) {
+	index, length := -1, 0
+	for i, name := range names {
+		n := len([]rune(name))
+		if n > length && n <= len(input) && strings.EqualFold(string(input[:n]), name) {
+			index, length = i, n
+		}
+	}
+	return index, length
+}
+
+// parseZone parses time zone such as Z, +0900, +09:00, GMT+09:00 or PST
+func parseZone(input []rune) (*time.Location, int) {
+	s := string(input)
+	if strings.HasPrefix(s, "Z") {
+		return time.UTC, 1
+	}
+	length := 0
+	if strings.HasPrefix(s, "GMT") || strings.HasPrefix(s, "UTC") {
+		length = 3
+		s = s[3:]
+		if s == "" || (s[0] != '+' && s[0] != '-') {
+			return time.UTC, length
+		}
+	}
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		digits := strings.Replace(s[1:], ":", "", 1)
+		n := 0
+		for n < len(digits) && n < 4 && digits[n] >= '0' && digits[n] <= '9' {
+			n++
+		}
+		if n != 2 && n != 4 {
+			return nil, 0
+		}
+		hours, _ := strconv.Atoi(digits[:2])
+		minutes := 0
+		if n == 4 {
+			minutes, _ = strconv.Atoi(digits[2:4])
+		}
+		offset := hours*3600 + minutes*60
+		if s[0] == '-' {
+			offset = -offset
+		}
+		consumed := 1 + n
+		if n == 4 && strings.Contains(s[:4], ":") {
+			consumed++
+		}
+		return time.FixedZone(formatOffset(offset, true), offset), length + consumed
+	}
+	for abbreviation, id := range zoneAbbreviations {
+		if strings.HasPrefix(s, abbreviation) {
+			if location, err := time.LoadLocation(id); err == nil {
+				return location, length + len(abbreviation)
+			}
+		}
+	}
+	return nil, 0
+}
+
+var zoneAbbreviations = map[string]string{
+	"PST": "America/Los_Angeles",
+	"PDT": "America/Los_Angeles",
+	"MST": "America/Denver",
+	"MDT": "America/Denver",
+	"CST": "America/Chicago",
+	"CDT": "America/Chicago",
+	"EST": "America/New_York",
+	"EDT": "America/New_York",
+	"JST": "Asia/Tokyo",
+}
@@ -0,0 +1,109 @@
+package builtin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatDate(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip("time zone database is not available")
+	}
+	// 2020-01-05 is Sunday
+	date := time.Date(2020, 1, 5, 13, 4, 5, 123000000, time.UTC)
+	testCases := []struct {
+		Pattern  string
+		Location *time.Location
+		Locale   string
+		Expected string
+	}{
+		{"yyyy-MM-dd HH:mm:ss.SSS", time.UTC, "en_US", "2020-01-05 13:04:05.123"},
+		{"yy/M/d h:mm a", time.UTC, "en_US", "20/1/5 1:04 PM"},
+		{"EEEE, MMMM d, yyyy", time.UTC, "en_US", "Sunday, January 5, 2020"},
+		{"EEE d MMM", time.UTC, "de_DE", "So 5 Jan"},
+		{"yyyy年M月d日(E)", time.UTC, "ja_JP", "2020年1月5日(日)"},
+		{"'T'HH'h' h 'o''clock' ''", time.UTC, "en_US", "T13h 1 o'clock '"},
+		{"HH:mm z Z XXX", tokyo, "en_US", "22:04 JST +0900 +09:00"},
+		{"X", time.UTC, "en_US", "Z"},
+		{"w W D F u k K", time.UTC, "en_US", "2 2 5 1 7 13 1"},
+		// weeks start on Monday and the first week has 4 days in en_GB
+		{"Y-ww", time.UTC, "en_GB", "2020-01"},
+		// unknown locale falls back to the locale of same language
+		{"EEE", time.UTC, "de_AT", "So"},
+		{"Y-ww", time.UTC, "en_AU", "2020-01"},
+		{"EEE", time.UTC, "fr_FR", "Sun"},
+	}
+	for i, testCase := range testCases {
+		actual, err := FormatDate(date, testCase.Pattern, testCase.Location, testCase.Locale)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err.Error())
+			continue
+		}
+		if actual != testCase.Expected {
+			t.Errorf("%d: %s: expected %s, actual %s", i, testCase.Pattern, testCase.Expected, actual)
+		}
+	}
+
+	errorCases := []struct {
+		Pattern  string
+		Expected string
+	}{
+		{"yyyy-qq", "Illegal pattern character 'q'"},
+		{"yyyy 'at", "Unterminated quote"},
+	}
+	for i, testCase := range errorCases {
+		_, err := FormatDate(date, testCase.Pattern, time.UTC, "en_US")
+		if err == nil {
+			t.Errorf("%d: %s: expected error", i, testCase.Pattern)
+			continue
+		}
+		if err.Error() != testCase.Expected {
+			t.Errorf("%d: expected: %s, actual: %s", i, testCase.Expected, err.Error())
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	testCases := []struct {
+		Value    string
+		Pattern  string
+		Locale   string
+		Expected time.Time
+	}{
+		{"1/5/2020 1:04 PM", "M/d/yyyy h:mm a", "en_US", time.Date(2020, 1, 5, 13, 4, 0, 0, time.UTC)},
+		{"12:30 AM", "hh:mm a", "en_US", time.Date(1970, 1, 1, 0, 30, 0, 0, time.UTC)},
+		{"20200105", "yyyyMMdd", "en_US", time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"05 Jan 20", "dd MMM yy", "en_US", time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"5. Januar 2020", "d. MMMM yyyy", "de_DE", time.Date(2020, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"2020-01-05T13:04:05+09:00", "yyyy-MM-dd'T'HH:mm:ssXXX", "en_US", time.Date(2020, 1, 5, 4, 4, 5, 0, time.UTC)},
+	}
+	for i, testCase := range testCases {
+		actual, err := ParseDate(testCase.Value, testCase.Pattern, time.UTC, testCase.Locale)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err.Error())
+			continue
+		}
+		if !actual.Equal(testCase.Expected) {
+			t.Errorf("%d: %s: expected %s, actual %s", i, testCase.Value, testCase.Expected, actual)
+		}
+	}
+
+	for _, value := range []string{"13/05/2020", "2020-01-05x", "Jan"} {
+		pattern := "MM/dd/yyyy"
+		switch value {
+		case "2020-01-05x":
+			pattern = "yyyy-MM-dd"
+		case "Jan":
+			pattern = "MMM d"
+		}
+		_, err := ParseDate(value, pattern, time.UTC, "en_US")
+		if err == nil {
+			t.Errorf("%s: expected error", value)
+			continue
+		}
+		if err.Error() != "Invalid date/time: "+value {
+			t.Errorf("expected: Invalid date/time: %s, actual: %s", value, err.Error())
+		}
+	}
+}
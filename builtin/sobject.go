@@ -48,14 +48,14 @@ var typeMapper = map[string]*ast.ClassType{
 	"combobox":      StringType,
 	"reference":     StringType,
 	"boolean":       BooleanType,
-	"currency":      DoubleType,
+	"currency":      DecimalType,
 	"textarea":      StringType,
 	"int":           DoubleType,
 	"double":        DoubleType,
 	"percent":       DoubleType,
 	"id":            StringType,
-	//"date":                       dateType,
-	//"datetime":                   dateType,
+	"date":          DateType,
+	"datetime":      DatetimeType,
 	//"time":                       TimeType,
 	"url":                        StringType,
 	"email":                      StringType,
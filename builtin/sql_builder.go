@@ -24,10 +24,31 @@ func (b *SqlBuilder) Build(n *ast.Soql) (string, [][]string, map[string]Relation
 	if whereClause != "" {
 		whereClause = " WHERE " + whereClause
 	}
-	groupByClause := b.createGroupBy(n.Group.Fields, tmpTableMap)
-	havingClause := b.createHaving(n.Group.Having, tmpTableMap)
-	if havingClause != "" {
-		whereClause = " HAVING " + havingClause
+	groupByClause := ""
+	havingClause := ""
+	if n.Group != nil {
+		groupByClause = b.createGroupBy(n.Group.Fields, tmpTableMap)
+		havingClause = b.createHaving(n.Group.Having, tmpTableMap)
+		if havingClause != "" {
+			havingClause = " HAVING " + havingClause
+		}
+	}
+
+	orderByClause := ""
+	if n.Order != nil {
+		orderByClause = b.createOrderBy(n.Order.(*ast.Order), tmpTableMap)
+	}
+	limitClause := ""
+	if n.Limit != nil {
+		limitClause = fmt.Sprintf(" LIMIT %d", b.evaluateInteger(n.Limit))
+	}
+	offsetClause := ""
+	if n.Offset != nil {
+		if limitClause == "" {
+			// OFFSET requires LIMIT in SQLite
+			limitClause = " LIMIT -1"
+		}
+		offsetClause = fmt.Sprintf(" OFFSET %d", b.evaluateInteger(n.Offset))
 	}
 
 	relations := createRelations(n.FromObject, tmpTableMap)
@@ -35,13 +56,16 @@ func (b *SqlBuilder) Build(n *ast.Soql) (string, [][]string, map[string]Relation
 	leftJoinClause := createLeftJoins(relations)
 
 	sql := fmt.Sprintf(
-		"SELECT %s FROM %s t0%s%s%s%s",
+		"SELECT %s FROM %s t0%s%s%s%s%s%s%s",
 		selectClause,
 		n.FromObject,
 		leftJoinClause,
 		whereClause,
 		groupByClause,
 		havingClause,
+		orderByClause,
+		limitClause,
+		offsetClause,
 	)
 	return sql, selectFields, relations
 }
@@ -70,6 +94,44 @@ func (b *SqlBuilder) createGroupBy(groups []ast.Node, tmpTableMap map[string]str
 	return fmt.Sprintf(" GROUP BY %s", strings.Join(groupFields, ", "))
 }
 
+func (b *SqlBuilder) createOrderBy(order *ast.Order, tmpTableMap map[string]string) string {
+	orderFields := make([]string, len(order.Field))
+	for i, field := range order.Field {
+		v := field.(*ast.SelectField).Value
+		if len(v) == 1 {
+			orderFields[i] = fmt.Sprintf("t0.%s", v[0])
+		} else {
+			tmpTable, ok := tmpTableMap[v[0]]
+			if !ok {
+				tmpTable = fmt.Sprintf("t%d", len(tmpTableMap)+1)
+				tmpTableMap[v[0]] = tmpTable
+			}
+			orderFields[i] = fmt.Sprintf("%s.%s", tmpTable, strings.Join(v[1:], "."))
+		}
+	}
+	direction := "ASC"
+	if !order.Asc {
+		direction = "DESC"
+	}
+	nulls := ""
+	if order.Nulls != "" {
+		nulls = " NULLS " + strings.ToUpper(order.Nulls)
+	}
+	return fmt.Sprintf(" ORDER BY %s %s%s", strings.Join(orderFields, ", "), direction, nulls)
+}
+
+// evaluateInteger evaluates integer literal or bind variable of LIMIT and OFFSET
+func (b *SqlBuilder) evaluateInteger(n ast.Node) int {
+	if literal, ok := n.(*ast.IntegerLiteral); ok {
+		return literal.Value
+	}
+	value, err := n.Accept(b.interpreter)
+	if err != nil {
+		panic(err)
+	}
+	return value.(*ast.Object).IntegerValue()
+}
+
 func (b *SqlBuilder) createHaving(n ast.Node, tmpTableMap map[string]string) string {
 	return b.createWhere(n, tmpTableMap)
 }
@@ -142,7 +204,7 @@ func createSelectClause(n *ast.Soql, tmpTableMap map[string]string) (string, [][
 
 func createRelations(from string, tmpTableMap map[string]string) map[string]Relation {
 	relations := map[string]Relation{}
-	sObject := sObjects[from]
+	sObject, _ := findSObject(from)
 	for relationshipName, tmpTableName := range tmpTableMap {
 		var targetField SobjectField
 		for _, sObjectField := range sObject.Fields {
@@ -1,9 +1,13 @@
 package builtin
 
 import (
-	"strings"
-
+	"fmt"
+	"html"
 	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
 
 	"github.com/tzmfreedom/land/ast"
 )
@@ -29,6 +33,12 @@ func createStringType(c *ast.ClassType) *ast.ClassType {
 				target := params[0].StringValue()
 				replacement := params[1].StringValue()
 				src := this.StringValue()
+				// the result is checked before it is built, a long replacement of many targets makes a huge string
+				if n := strings.Count(src, target); len(replacement) > len(target) {
+					if err := checkNative(extra, len(src)+n*(len(replacement)-len(target))); err != nil {
+						return err
+					}
+				}
 				return NewString(strings.Replace(src, target, replacement, -1))
 			},
 		),
@@ -221,23 +231,632 @@ func createStringType(c *ast.ClassType) *ast.ClassType {
 			},
 		),
 	})
+	instanceMethods.Set("startsWith", []*ast.Method{
+		ast.CreateMethod(
+			"startsWith",
+			BooleanType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(strings.HasPrefix(this.StringValue(), params[0].StringValue()))
+			},
+		),
+	})
+	instanceMethods.Set("endsWith", []*ast.Method{
+		ast.CreateMethod(
+			"endsWith",
+			BooleanType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(strings.HasSuffix(this.StringValue(), params[0].StringValue()))
+			},
+		),
+	})
+	instanceMethods.Set("startsWithIgnoreCase", []*ast.Method{
+		ast.CreateMethod(
+			"startsWithIgnoreCase",
+			BooleanType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(strings.HasPrefix(strings.ToLower(this.StringValue()), strings.ToLower(params[0].StringValue())))
+			},
+		),
+	})
+	instanceMethods.Set("endsWithIgnoreCase", []*ast.Method{
+		ast.CreateMethod(
+			"endsWithIgnoreCase",
+			BooleanType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(strings.HasSuffix(strings.ToLower(this.StringValue()), strings.ToLower(params[0].StringValue())))
+			},
+		),
+	})
+	instanceMethods.Set("trim", []*ast.Method{
+		ast.CreateMethod(
+			"trim",
+			StringType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewString(strings.TrimSpace(this.StringValue()))
+			},
+		),
+	})
+	instanceMethods.Set("capitalize", []*ast.Method{
+		ast.CreateMethod(
+			"capitalize",
+			StringType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				runes := []rune(this.StringValue())
+				if len(runes) == 0 {
+					return NewString("")
+				}
+				return NewString(string(unicode.ToUpper(runes[0])) + string(runes[1:]))
+			},
+		),
+	})
+	instanceMethods.Set("uncapitalize", []*ast.Method{
+		ast.CreateMethod(
+			"uncapitalize",
+			StringType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				runes := []rune(this.StringValue())
+				if len(runes) == 0 {
+					return NewString("")
+				}
+				return NewString(string(unicode.ToLower(runes[0])) + string(runes[1:]))
+			},
+		),
+	})
+	instanceMethods.Set("escapeSingleQuotes", []*ast.Method{
+		ast.CreateMethod(
+			"escapeSingleQuotes",
+			StringType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewString(strings.Replace(this.StringValue(), "'", "\\'", -1))
+			},
+		),
+	})
+	instanceMethods.Set("countMatches", []*ast.Method{
+		ast.CreateMethod(
+			"countMatches",
+			IntegerType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				substring := params[0].StringValue()
+				if substring == "" {
+					return NewInteger(0)
+				}
+				return NewInteger(strings.Count(this.StringValue(), substring))
+			},
+		),
+	})
+	instanceMethods.Set("containsIgnoreCase", []*ast.Method{
+		ast.CreateMethod(
+			"containsIgnoreCase",
+			BooleanType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(strings.Contains(strings.ToLower(this.StringValue()), strings.ToLower(params[0].StringValue())))
+			},
+		),
+	})
+	instanceMethods.Set("equalsIgnoreCase", []*ast.Method{
+		ast.CreateMethod(
+			"equalsIgnoreCase",
+			BooleanType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				if params[0] == Null {
+					return NewBoolean(false)
+				}
+				return NewBoolean(strings.EqualFold(this.StringValue(), params[0].StringValue()))
+			},
+		),
+	})
+	instanceMethods.Set("compareTo", []*ast.Method{
+		ast.CreateMethod(
+			"compareTo",
+			IntegerType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				if params[0] == Null {
+					return NewNullPointerException("compareTo")
+				}
+				// difference of the first different characters, or difference of the lengths as java
+				s := utf16.Encode([]rune(this.StringValue()))
+				other := utf16.Encode([]rune(params[0].StringValue()))
+				for i := 0; i < len(s) && i < len(other); i++ {
+					if s[i] != other[i] {
+						return NewInteger(int(s[i]) - int(other[i]))
+					}
+				}
+				return NewInteger(len(s) - len(other))
+			},
+		),
+	})
+	instanceMethods.Set("normalizeSpace", []*ast.Method{
+		ast.CreateMethod(
+			"normalizeSpace",
+			StringType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewString(strings.Join(strings.Fields(this.StringValue()), " "))
+			},
+		),
+	})
+	instanceMethods.Set("deleteWhitespace", []*ast.Method{
+		ast.CreateMethod(
+			"deleteWhitespace",
+			StringType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewString(strings.Map(func(r rune) rune {
+					if unicode.IsSpace(r) {
+						return -1
+					}
+					return r
+				}, this.StringValue()))
+			},
+		),
+	})
+	instanceMethods.Set("reverse", []*ast.Method{
+		ast.CreateMethod(
+			"reverse",
+			StringType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				runes := []rune(this.StringValue())
+				for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+					runes[i], runes[j] = runes[j], runes[i]
+				}
+				return NewString(string(runes))
+			},
+		),
+	})
+	instanceMethods.Set("remove", []*ast.Method{
+		ast.CreateMethod(
+			"remove",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewString(strings.Replace(this.StringValue(), params[0].StringValue(), "", -1))
+			},
+		),
+	})
+	instanceMethods.Set("removeStart", []*ast.Method{
+		ast.CreateMethod(
+			"removeStart",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewString(strings.TrimPrefix(this.StringValue(), params[0].StringValue()))
+			},
+		),
+	})
+	instanceMethods.Set("removeEnd", []*ast.Method{
+		ast.CreateMethod(
+			"removeEnd",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewString(strings.TrimSuffix(this.StringValue(), params[0].StringValue()))
+			},
+		),
+	})
+	instanceMethods.Set("removeStartIgnoreCase", []*ast.Method{
+		ast.CreateMethod(
+			"removeStartIgnoreCase",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				src := []rune(this.StringValue())
+				prefix := []rune(params[0].StringValue())
+				if len(prefix) <= len(src) && strings.EqualFold(string(src[:len(prefix)]), string(prefix)) {
+					return NewString(string(src[len(prefix):]))
+				}
+				return this
+			},
+		),
+	})
+	instanceMethods.Set("removeEndIgnoreCase", []*ast.Method{
+		ast.CreateMethod(
+			"removeEndIgnoreCase",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				src := []rune(this.StringValue())
+				suffix := []rune(params[0].StringValue())
+				if len(suffix) <= len(src) && strings.EqualFold(string(src[len(src)-len(suffix):]), string(suffix)) {
+					return NewString(string(src[:len(src)-len(suffix)]))
+				}
+				return this
+			},
+		),
+	})
+	instanceMethods.Set("substringBefore", []*ast.Method{
+		ast.CreateMethod(
+			"substringBefore",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				src := this.StringValue()
+				if i := strings.Index(src, params[0].StringValue()); i >= 0 {
+					return NewString(src[:i])
+				}
+				return NewString(src)
+			},
+		),
+	})
+	instanceMethods.Set("substringBeforeLast", []*ast.Method{
+		ast.CreateMethod(
+			"substringBeforeLast",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				src := this.StringValue()
+				if i := strings.LastIndex(src, params[0].StringValue()); i >= 0 {
+					return NewString(src[:i])
+				}
+				return NewString(src)
+			},
+		),
+	})
+	instanceMethods.Set("substringAfter", []*ast.Method{
+		ast.CreateMethod(
+			"substringAfter",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				src := this.StringValue()
+				separator := params[0].StringValue()
+				if i := strings.Index(src, separator); i >= 0 {
+					return NewString(src[i+len(separator):])
+				}
+				return NewString("")
+			},
+		),
+	})
+	instanceMethods.Set("substringAfterLast", []*ast.Method{
+		ast.CreateMethod(
+			"substringAfterLast",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				src := this.StringValue()
+				separator := params[0].StringValue()
+				if i := strings.LastIndex(src, separator); i >= 0 && separator != "" {
+					return NewString(src[i+len(separator):])
+				}
+				return NewString("")
+			},
+		),
+	})
+	instanceMethods.Set("isNumeric", []*ast.Method{
+		ast.CreateMethod(
+			"isNumeric",
+			BooleanType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(isAllOf(this.StringValue(), unicode.IsDigit))
+			},
+		),
+	})
+	instanceMethods.Set("isAlpha", []*ast.Method{
+		ast.CreateMethod(
+			"isAlpha",
+			BooleanType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(isAllOf(this.StringValue(), unicode.IsLetter))
+			},
+		),
+	})
+	instanceMethods.Set("isAlphanumeric", []*ast.Method{
+		ast.CreateMethod(
+			"isAlphanumeric",
+			BooleanType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(isAllOf(this.StringValue(), func(r rune) bool {
+					return unicode.IsLetter(r) || unicode.IsDigit(r)
+				}))
+			},
+		),
+	})
+	instanceMethods.Set("isWhitespace", []*ast.Method{
+		ast.CreateMethod(
+			"isWhitespace",
+			BooleanType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(isAllOf(this.StringValue(), unicode.IsSpace))
+			},
+		),
+	})
+	instanceMethods.Set("charAt", []*ast.Method{
+		ast.CreateMethod(
+			"charAt",
+			IntegerType,
+			[]*ast.Parameter{
+				IntegerTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				runes := []rune(this.StringValue())
+				index := params[0].IntegerValue()
+				if index < 0 || index >= len(runes) {
+					return Raise(StringExceptionType, fmt.Sprintf("Invalid index %d", index))
+				}
+				return NewInteger(int(runes[index]))
+			},
+		),
+	})
+	instanceMethods.Set("getChars", []*ast.Method{
+		ast.CreateMethod(
+			"getChars",
+			CreateListType(IntegerType),
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				runes := []rune(this.StringValue())
+				records := make([]*ast.Object, len(runes))
+				for i, r := range runes {
+					records[i] = NewInteger(int(r))
+				}
+				return CreateListObject(CreateListType(IntegerType), records)
+			},
+		),
+	})
+	instanceMethods.Set("escapeHtml4", []*ast.Method{
+		ast.CreateMethod(
+			"escapeHtml4",
+			StringType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewString(html4Escaper.Replace(this.StringValue()))
+			},
+		),
+	})
+	instanceMethods.Set("unescapeHtml4", []*ast.Method{
+		ast.CreateMethod(
+			"unescapeHtml4",
+			StringType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewString(html.UnescapeString(this.StringValue()))
+			},
+		),
+	})
+	instanceMethods.Set("unescapeJava", []*ast.Method{
+		ast.CreateMethod(
+			"unescapeJava",
+			StringType,
+			[]*ast.Parameter{},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewString(UnescapeJava(this.StringValue()))
+			},
+		),
+	})
+	instanceMethods.Set("lastIndexOf", []*ast.Method{
+		ast.CreateMethod(
+			"lastIndexOf",
+			IntegerType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewInteger(strings.LastIndex(this.StringValue(), params[0].StringValue()))
+			},
+		),
+	})
+	instanceMethods.Set("abbreviate", []*ast.Method{
+		ast.CreateMethod(
+			"abbreviate",
+			StringType,
+			[]*ast.Parameter{
+				IntegerTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				runes := []rune(this.StringValue())
+				maxWidth := params[0].IntegerValue()
+				if len(runes) <= maxWidth {
+					return this
+				}
+				if maxWidth < 4 {
+					return Raise(StringExceptionType, "Minimum abbreviation width is 4")
+				}
+				return NewString(string(runes[:maxWidth-3]) + "...")
+			},
+		),
+	})
+	instanceMethods.Set("leftPad", []*ast.Method{
+		ast.CreateMethod(
+			"leftPad",
+			StringType,
+			[]*ast.Parameter{
+				IntegerTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return padString(this.StringValue(), params[0].IntegerValue(), " ", true, extra)
+			},
+		),
+		ast.CreateMethod(
+			"leftPad",
+			StringType,
+			[]*ast.Parameter{
+				IntegerTypeParameter,
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return padString(this.StringValue(), params[0].IntegerValue(), params[1].StringValue(), true, extra)
+			},
+		),
+	})
+	instanceMethods.Set("rightPad", []*ast.Method{
+		ast.CreateMethod(
+			"rightPad",
+			StringType,
+			[]*ast.Parameter{
+				IntegerTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return padString(this.StringValue(), params[0].IntegerValue(), " ", false, extra)
+			},
+		),
+		ast.CreateMethod(
+			"rightPad",
+			StringType,
+			[]*ast.Parameter{
+				IntegerTypeParameter,
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return padString(this.StringValue(), params[0].IntegerValue(), params[1].StringValue(), false, extra)
+			},
+		),
+	})
+	instanceMethods.Set("substringBetween", []*ast.Method{
+		ast.CreateMethod(
+			"substringBetween",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				tag := params[0].StringValue()
+				return substringBetween(this.StringValue(), tag, tag)
+			},
+		),
+		ast.CreateMethod(
+			"substringBetween",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+				stringTypeParameter,
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return substringBetween(this.StringValue(), params[0].StringValue(), params[1].StringValue())
+			},
+		),
+	})
 	staticMethods := ast.NewMethodMap()
 	staticMethods.Set("join", []*ast.Method{
 		ast.CreateMethod(
 			"join",
 			StringType,
 			[]*ast.Parameter{
-				CreateListTypeParameter(ObjectType),
+				{
+					Type: &ast.ClassType{Name: "Iterable", Generics: []*ast.ClassType{ObjectType}},
+					Name: "_",
+				},
 				stringTypeParameter,
 			},
 			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
-				iterableObj := params[0].Extra["records"].([]*ast.Object)
 				separator := params[1].StringValue()
-				elements := make([]string, len(iterableObj))
-				for i, obj := range iterableObj {
-					elements[i] = String(obj)
+				return NewString(strings.Join(iterableStrings(params[0]), separator))
+			},
+		),
+	})
+	staticMethods.Set("isBlank", []*ast.Method{
+		ast.CreateMethod(
+			"isBlank",
+			BooleanType,
+			[]*ast.Parameter{stringTypeParameter},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(params[0] == Null || strings.TrimSpace(params[0].StringValue()) == "")
+			},
+		),
+	})
+	staticMethods.Set("isNotBlank", []*ast.Method{
+		ast.CreateMethod(
+			"isNotBlank",
+			BooleanType,
+			[]*ast.Parameter{stringTypeParameter},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(params[0] != Null && strings.TrimSpace(params[0].StringValue()) != "")
+			},
+		),
+	})
+	staticMethods.Set("isEmpty", []*ast.Method{
+		ast.CreateMethod(
+			"isEmpty",
+			BooleanType,
+			[]*ast.Parameter{stringTypeParameter},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(params[0] == Null || params[0].StringValue() == "")
+			},
+		),
+	})
+	staticMethods.Set("isNotEmpty", []*ast.Method{
+		ast.CreateMethod(
+			"isNotEmpty",
+			BooleanType,
+			[]*ast.Parameter{stringTypeParameter},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewBoolean(params[0] != Null && params[0].StringValue() != "")
+			},
+		),
+	})
+	staticMethods.Set("format", []*ast.Method{
+		ast.CreateMethod(
+			"format",
+			StringType,
+			[]*ast.Parameter{
+				stringTypeParameter,
+				CreateListTypeParameter(ObjectType),
+			},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				return NewString(formatMessage(params[0].StringValue(), params[1].Extra["records"].([]*ast.Object)))
+			},
+		),
+	})
+	staticMethods.Set("fromCharArray", []*ast.Method{
+		ast.CreateMethod(
+			"fromCharArray",
+			StringType,
+			[]*ast.Parameter{CreateListTypeParameter(IntegerType)},
+			func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+				records := params[0].Extra["records"].([]*ast.Object)
+				runes := make([]rune, len(records))
+				for i, r := range records {
+					runes[i] = rune(r.IntegerValue())
 				}
-				return NewString(strings.Join(elements, separator))
+				return NewString(string(runes))
 			},
 		),
 	})
@@ -274,7 +893,150 @@ var stringTypeParameter = &ast.Parameter{
 	Name: "_",
 }
 
+// html4Escaper escapes characters as String.escapeHtml4, single quote is not escaped
+var html4Escaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	`"`, "&quot;",
+)
+
+func isAllOf(src string, f func(rune) bool) bool {
+	if src == "" {
+		return false
+	}
+	for _, r := range src {
+		if !f(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// padString pads src to the size on the left or on the right as leftPad and rightPad do,
+// the size is checked before the padding is built
+func padString(src string, size int, pad string, left bool, extra map[string]interface{}) interface{} {
+	if err := checkNative(extra, size*len(pad)); err != nil {
+		return err
+	}
+	if left {
+		return NewString(padding(src, size, pad) + src)
+	}
+	return NewString(src + padding(src, size, pad))
+}
+
+// padding returns the pad string repeated to make the length of src to size
+func padding(src string, size int, pad string) string {
+	length := len([]rune(src))
+	if pad == "" || length >= size {
+		return ""
+	}
+	padRunes := []rune(strings.Repeat(pad, size-length))
+	return string(padRunes[:size-length])
+}
+
+func substringBetween(src, open, close string) *ast.Object {
+	start := strings.Index(src, open)
+	if start < 0 {
+		return Null
+	}
+	start += len(open)
+	end := strings.Index(src[start:], close)
+	if end < 0 {
+		return Null
+	}
+	return NewString(src[start : start+end])
+}
+
+// iterableStrings returns string values of elements of List or Set
+func iterableStrings(iterable *ast.Object) []string {
+	elements := iterableElements(iterable)
+	values := make([]string, len(elements))
+	for i, element := range elements {
+		values[i] = String(element)
+	}
+	return values
+}
+
+// formatMessage substitutes {n} in the pattern with the arguments as java.text.MessageFormat,
+// text quoted by single quotes is not substituted, and two single quotes are a single quote
+func formatMessage(pattern string, args []*ast.Object) string {
+	var buf strings.Builder
+	runes := []rune(pattern)
+	quoted := false
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'' && i+1 < len(runes) && runes[i+1] == '\'':
+			buf.WriteRune('\'')
+			i++
+		case r == '\'':
+			quoted = !quoted
+		case r == '{' && !quoted:
+			end := i + 1
+			for end < len(runes) && runes[end] != '}' {
+				end++
+			}
+			if end == len(runes) {
+				buf.WriteString(string(runes[i:]))
+				return buf.String()
+			}
+			element := string(runes[i+1 : end])
+			index, err := strconv.Atoi(strings.TrimSpace(strings.SplitN(element, ",", 2)[0]))
+			if err != nil || index < 0 || index >= len(args) {
+				buf.WriteString("{" + element + "}")
+			} else {
+				buf.WriteString(String(args[index]))
+			}
+			i = end
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
+
+// UnescapeJava unescapes escape sequences of Java string literal, such as \n or \u0041.
+// Apex string literal has the same escape sequences.
+func UnescapeJava(src string) string {
+	var buf strings.Builder
+	runes := []rune(src)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '\\' || i+1 == len(runes) {
+			buf.WriteRune(runes[i])
+			continue
+		}
+		i++
+		switch runes[i] {
+		case 'n':
+			buf.WriteRune('\n')
+		case 't':
+			buf.WriteRune('\t')
+		case 'r':
+			buf.WriteRune('\r')
+		case 'b':
+			buf.WriteRune('\b')
+		case 'f':
+			buf.WriteRune('\f')
+		case 'u':
+			if i+4 < len(runes) {
+				if code, err := strconv.ParseUint(string(runes[i+1:i+5]), 16, 32); err == nil {
+					buf.WriteRune(rune(code))
+					i += 4
+					continue
+				}
+			}
+			buf.WriteString("\\u")
+		default:
+			buf.WriteRune(runes[i])
+		}
+	}
+	return buf.String()
+}
+
 func init() {
 	createStringType(StringType)
 	primitiveClassMap.Set("String", StringType)
+	// Id is String as id fields of SObject, such as Map<Id, Account>
+	primitiveClassMap.Set("Id", StringType)
 }
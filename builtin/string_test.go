@@ -0,0 +1,89 @@
+package builtin
+
+import (
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+func TestStringMethods(t *testing.T) {
+	s := NewString
+	testCases := []struct {
+		Receiver string
+		Method   string
+		Params   []*ast.Object
+		Expected string
+	}{
+		{"Hello World", "abbreviate", []*ast.Object{NewInteger(8)}, "Hello..."},
+		{"Hello", "abbreviate", []*ast.Object{NewInteger(5)}, "Hello"},
+		{"こんにちは世界", "abbreviate", []*ast.Object{NewInteger(6)}, "こんに..."},
+		{"Hello World", "abbreviate", []*ast.Object{NewInteger(3)}, "StringException: Minimum abbreviation width is 4"},
+		{"abc", "leftPad", []*ast.Object{NewInteger(5)}, "  abc"},
+		{"abc", "leftPad", []*ast.Object{NewInteger(7), s("xy")}, "xyxyabc"},
+		{"abc", "leftPad", []*ast.Object{NewInteger(2), s("x")}, "abc"},
+		{"abc", "leftPad", []*ast.Object{NewInteger(5), s("")}, "abc"},
+		{"あい", "leftPad", []*ast.Object{NewInteger(4), s("う")}, "ううあい"},
+		{"abc", "rightPad", []*ast.Object{NewInteger(5)}, "abc  "},
+		{"abc", "rightPad", []*ast.Object{NewInteger(6), s("xy")}, "abcxyx"},
+		{"abc", "rightPad", []*ast.Object{NewInteger(-1), s("x")}, "abc"},
+		{`a\tb\nc`, "unescapeJava", []*ast.Object{}, "a\tb\nc"},
+		{`Aあ`, "unescapeJava", []*ast.Object{}, "Aあ"},
+		{`\'\"\\`, "unescapeJava", []*ast.Object{}, `'"\`},
+		{`\u00zz`, "unescapeJava", []*ast.Object{}, `\u00zz`},
+		{`abc\`, "unescapeJava", []*ast.Object{}, `abc\`},
+		{"<a>b</a>", "substringBetween", []*ast.Object{s("<a>"), s("</a>")}, "b"},
+		{"xaxbx", "substringBetween", []*ast.Object{s("x")}, "a"},
+		{"yabc", "substringBetween", []*ast.Object{s("y"), s("z")}, "null"},
+		{"abc", "substringBetween", []*ast.Object{s("z")}, "null"},
+		{"[]", "substringBetween", []*ast.Object{s("["), s("]")}, ""},
+		{"123", "isNumeric", []*ast.Object{}, "true"},
+		{"12.3", "isNumeric", []*ast.Object{}, "false"},
+		{"-1", "isNumeric", []*ast.Object{}, "false"},
+		{"", "isNumeric", []*ast.Object{}, "false"},
+		{"HelloWorld", "removeStartIgnoreCase", []*ast.Object{s("hello")}, "World"},
+		{"ÄÖÜabc", "removeStartIgnoreCase", []*ast.Object{s("äöü")}, "abc"},
+		{"ſabc", "removeStartIgnoreCase", []*ast.Object{s("S")}, "abc"},
+		{"ab", "removeStartIgnoreCase", []*ast.Object{s("abc")}, "ab"},
+		{"HelloWorld", "removeStartIgnoreCase", []*ast.Object{s("world")}, "HelloWorld"},
+		{"HelloWorld", "removeEndIgnoreCase", []*ast.Object{s("WORLD")}, "Hello"},
+		{"abcÄÖÜ", "removeEndIgnoreCase", []*ast.Object{s("äöü")}, "abc"},
+		{"abcK", "removeEndIgnoreCase", []*ast.Object{s("k")}, "abc"},
+		{"bc", "removeEndIgnoreCase", []*ast.Object{s("abc")}, "bc"},
+	}
+	for i, testCase := range testCases {
+		actual := resultString(callNativeMethod(s(testCase.Receiver), testCase.Method, testCase.Params, nil))
+		if actual != testCase.Expected {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Expected, actual)
+		}
+	}
+}
+
+func TestStringFormat(t *testing.T) {
+	list := func(elements ...*ast.Object) *ast.Object {
+		return CreateListObject(CreateListType(ObjectType), elements)
+	}
+	testCases := []struct {
+		Pattern  string
+		Args     *ast.Object
+		Expected string
+	}{
+		{"{0} and {1}", list(NewString("a"), NewInteger(1)), "a and 1"},
+		{"{1}{0}{1}", list(NewString("a"), NewString("b")), "bab"},
+		{"{ 0 }", list(NewString("a")), "a"},
+		{"{0,number}", list(NewInteger(10)), "10"},
+		{"{2} {-1} {x}", list(NewString("a")), "{2} {-1} {x}"},
+		{"'{0}' {0}", list(NewString("a")), "{0} a"},
+		{"it''s {0}", list(NewString("a")), "it's a"},
+		{"{0", list(NewString("a")), "{0"},
+		{"{0}", list(Null), "null"},
+		{"あ{0}い", list(NewString("う")), "あうい"},
+	}
+	methods, _ := StringType.StaticMethods.Get("format")
+	for i, testCase := range testCases {
+		r := methods[0].NativeFunction(nil, []*ast.Object{NewString(testCase.Pattern), testCase.Args}, map[string]interface{}{})
+		actual := resultString(r)
+		if actual != testCase.Expected {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Expected, actual)
+		}
+	}
+}
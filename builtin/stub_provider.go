@@ -0,0 +1,83 @@
+package builtin
+
+import "github.com/tzmfreedom/land/ast"
+
+var StubProviderType = ast.CreateClass(
+	"StubProvider",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+var stubProviderTypeParameter = &ast.Parameter{
+	Type: StubProviderType,
+	Name: "_",
+}
+
+// NewStub returns stub object of the class, instance method calls on it are routed to the provider
+func NewStub(classType *ast.ClassType, provider *ast.Object) *ast.Object {
+	stub := ast.CreateObject(classType)
+	for _, f := range classType.InstanceFields.Data {
+		stub.InstanceFields.Set(f.Name, Null)
+	}
+	stub.Extra["stub_provider"] = provider
+	return stub
+}
+
+// StubProvider returns the provider of the stub object, or nil if the object is not a stub
+func StubProvider(o *ast.Object) *ast.Object {
+	provider, ok := o.Extra["stub_provider"]
+	if !ok {
+		return nil
+	}
+	return provider.(*ast.Object)
+}
+
+// StubArguments creates arguments of StubProvider#handleMethodCall from the called method
+func StubArguments(stub *ast.Object, m *ast.Method, args []*ast.Object) []*ast.Object {
+	paramTypes := make([]*ast.Object, len(m.Parameters))
+	paramNames := make([]*ast.Object, len(m.Parameters))
+	for i, param := range m.Parameters {
+		paramTypes[i] = NewType(param.Type)
+		paramNames[i] = NewString(param.Name)
+	}
+	listOfParamTypes := ast.CreateObject(CreateListType(TypeType))
+	listOfParamTypes.Extra["records"] = paramTypes
+	listOfParamNames := ast.CreateObject(CreateListType(StringType))
+	listOfParamNames.Extra["records"] = paramNames
+	listOfArgs := ast.CreateObject(CreateListType(ObjectType))
+	listOfArgs.Extra["records"] = args
+
+	return []*ast.Object{
+		stub,
+		NewString(m.Name),
+		NewType(m.ReturnType),
+		listOfParamTypes,
+		listOfParamNames,
+		listOfArgs,
+	}
+}
+
+func init() {
+	StubProviderType.Interface = true
+	StubProviderType.InstanceMethods.Set(
+		"handleMethodCall",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"handleMethodCall",
+				ObjectType,
+				[]*ast.Parameter{
+					objectTypeParameter,
+					stringTypeParameter,
+					typeTypeParameter,
+					CreateListTypeParameter(TypeType),
+					CreateListTypeParameter(StringType),
+					CreateListTypeParameter(ObjectType),
+				},
+				nil,
+			),
+		},
+	)
+
+	systemNameSpace.Set("StubProvider", StubProviderType)
+}
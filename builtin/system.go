@@ -15,10 +15,23 @@ const (
 	DebugColor   = "\033[0;36m%s\033[0m"
 )
 
+// systemNameSpace holds classes referred as System.ClassName
+var systemNameSpace = ast.NewClassMap()
+
 type EqualChecker interface {
 	Equals(*ast.Object, *ast.Object) bool
 }
 
+// MethodCaller calls apex method from native functions, such as compareTo of Comparable
+type MethodCaller interface {
+	CallMethod(receiver *ast.Object, methodName string, params []*ast.Object) (*ast.Object, error)
+}
+
+type AsyncJobRunner interface {
+	RunAsyncJobs() error
+	FireScheduledJobs() error
+}
+
 func init() {
 	system := ast.CreateClass(
 		"System",
@@ -39,6 +52,54 @@ func init() {
 						},
 					},
 				},
+				"enqueuejob": {
+					ast.CreateMethod(
+						"enqueueJob",
+						StringType,
+						[]*ast.Parameter{queueableTypeParameter},
+						enqueueJob,
+					),
+				},
+				"schedule": {
+					ast.CreateMethod(
+						"schedule",
+						StringType,
+						[]*ast.Parameter{
+							stringTypeParameter,
+							stringTypeParameter,
+							{Type: schedulableType, Name: "_"},
+						},
+						schedule,
+					),
+				},
+				"abortjob": {
+					ast.CreateMethod(
+						"abortJob",
+						nil,
+						[]*ast.Parameter{stringTypeParameter},
+						abortJob,
+					),
+				},
+				"now": {
+					ast.CreateMethod(
+						"now",
+						DatetimeType,
+						[]*ast.Parameter{},
+						func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+							return NewDatetime(clockOf(extra).Now())
+						},
+					),
+				},
+				"today": {
+					ast.CreateMethod(
+						"today",
+						DateType,
+						[]*ast.Parameter{},
+						func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+							return newDateOf(clockOf(extra).Now().In(UserTimeZone))
+						},
+					),
+				},
 				"assertequals": {
 					&ast.Method{
 						Name:      "assertequals",
@@ -72,6 +133,7 @@ func init() {
 	)
 
 	primitiveClassMap.Set("system", system)
+	nameSpaceStore.Set("System", systemNameSpace)
 }
 
 type TestError struct {
@@ -0,0 +1,139 @@
+package builtin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// datetimeLayout is format of datetime value stored in the database
+const datetimeLayout = "2006-01-02 15:04:05"
+const dateLayout = "2006-01-02"
+
+// systemSObject is sobject managed by land itself, such as AsyncApexJob and CronTrigger.
+// It is not included in the metadata file, so its table is created when a record is inserted.
+type systemSObject struct {
+	ClassType *ast.ClassType
+	Fields    []systemSObjectField
+}
+
+// systemSObjectField with ReferenceTo is lookup field, its relationship name is the name without Id suffix
+type systemSObjectField struct {
+	Name        string
+	Type        *ast.ClassType
+	ReferenceTo *systemSObject
+}
+
+var systemSObjects = map[string]*systemSObject{}
+
+func newSystemSObject(name string, fields []systemSObjectField) *systemSObject {
+	instanceFields := ast.NewFieldMap()
+	for _, f := range fields {
+		instanceFields.Set(f.Name, ast.CreateField(f.Name, f.Type))
+		if f.ReferenceTo != nil {
+			relationshipName := strings.TrimSuffix(f.Name, "Id")
+			instanceFields.Set(relationshipName, ast.CreateField(relationshipName, f.ReferenceTo.ClassType))
+		}
+	}
+	s := &systemSObject{
+		ClassType: &ast.ClassType{
+			Name:            name,
+			SuperClass:      SObjectType,
+			Constructors:    []*ast.Method{},
+			InstanceFields:  instanceFields,
+			StaticFields:    ast.NewFieldMap(),
+			InstanceMethods: ast.NewMethodMap(),
+			StaticMethods:   ast.NewMethodMap(),
+			InnerClasses:    ast.NewClassMap(),
+			ToString: func(o *ast.Object) string {
+				return SObjectType.ToString(o)
+			},
+		},
+		Fields: fields,
+	}
+	systemSObjects[strings.ToLower(name)] = s
+	primitiveClassMap.Set(name, s.ClassType)
+	return s
+}
+
+// sObject returns the definition used by relationship query
+func (s *systemSObject) sObject() Sobject {
+	fields := make([]SobjectField, len(s.Fields))
+	for i, f := range s.Fields {
+		fields[i] = SobjectField{Name: f.Name}
+		if f.ReferenceTo != nil {
+			fields[i].RelationshipName = strings.TrimSuffix(f.Name, "Id")
+			fields[i].ReferenceTo = []string{f.ReferenceTo.ClassType.Name}
+		}
+	}
+	return Sobject{Name: s.ClassType.Name, Fields: fields}
+}
+
+func (s *systemSObject) createTable() {
+	columns := make([]string, len(s.Fields))
+	for i, f := range s.Fields {
+		dbType := "TEXT"
+		if f.Type == IntegerType {
+			dbType = "INT"
+		}
+		columns[i] = fmt.Sprintf("`%s` %s", f.Name, dbType)
+	}
+	DatabaseDriver.ExecuteRaw(fmt.Sprintf("CREATE TABLE IF NOT EXISTS `%s` (%s);", s.ClassType.Name, strings.Join(columns, ", ")))
+}
+
+// Insert inserts a record with the values, the table is created if not exists
+func (s *systemSObject) Insert(values map[string]interface{}) {
+	s.createTable()
+	names := sortedKeys(values)
+	placeholders := make([]string, len(names))
+	args := make([]interface{}, len(names))
+	for i, name := range names {
+		placeholders[i] = "?"
+		args[i] = values[name]
+	}
+	DatabaseDriver.ExecuteRaw(
+		fmt.Sprintf("INSERT INTO `%s`(%s) VALUES (%s)", s.ClassType.Name, strings.Join(names, ", "), strings.Join(placeholders, ", ")),
+		args...,
+	)
+}
+
+func (s *systemSObject) Update(id string, values map[string]interface{}) {
+	names := sortedKeys(values)
+	sets := make([]string, len(names))
+	args := make([]interface{}, len(names)+1)
+	for i, name := range names {
+		sets[i] = fmt.Sprintf("%s = ?", name)
+		args[i] = values[name]
+	}
+	args[len(names)] = id
+	DatabaseDriver.ExecuteRaw(
+		fmt.Sprintf("UPDATE `%s` SET %s WHERE Id = ?", s.ClassType.Name, strings.Join(sets, ", ")),
+		args...,
+	)
+}
+
+func (s *systemSObject) Delete(id string) {
+	DatabaseDriver.ExecuteRaw(fmt.Sprintf("DELETE FROM `%s` WHERE Id = ?", s.ClassType.Name), id)
+}
+
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// findSObject returns sobject loaded from the metadata file or managed by land
+func findSObject(name string) (Sobject, bool) {
+	if sObject, ok := sObjects[name]; ok {
+		return sObject, true
+	}
+	if s, ok := systemSObjects[strings.ToLower(name)]; ok {
+		return s.sObject(), true
+	}
+	return Sobject{}, false
+}
@@ -0,0 +1,92 @@
+package builtin
+
+import "github.com/tzmfreedom/land/ast"
+
+var TypeType = &ast.ClassType{Name: "Type"}
+var typeTypeParameter = &ast.Parameter{
+	Type: TypeType,
+	Name: "_",
+}
+
+// NewType returns System.Type object for the class, nil classType means void
+func NewType(classType *ast.ClassType) *ast.Object {
+	t := ast.CreateObject(TypeType)
+	t.Extra["value"] = classType
+	return t
+}
+
+func typeName(o *ast.Object) string {
+	classType := o.Extra["value"].(*ast.ClassType)
+	if classType == nil {
+		return "void"
+	}
+	name := classType.String()
+	// inner class name is qualified with outer class name, such as Outer.Inner
+	if classType.Parent != nil {
+		switch outer := classType.Parent.(type) {
+		case *ast.ClassDeclaration:
+			name = outer.Name + "." + name
+		case *ast.InterfaceDeclaration:
+			name = outer.Name + "." + name
+		}
+	}
+	return name
+}
+
+func init() {
+	instanceMethods := ast.NewMethodMap()
+	TypeType.Constructors = []*ast.Method{}
+	TypeType.InstanceFields = ast.NewFieldMap()
+	TypeType.StaticFields = ast.NewFieldMap()
+	TypeType.InstanceMethods = instanceMethods
+	TypeType.StaticMethods = ast.NewMethodMap()
+	TypeType.InnerClasses = ast.NewClassMap()
+	TypeType.Modifiers = []*ast.Modifier{ast.PublicModifier()}
+	TypeType.ToString = typeName
+
+	instanceMethods.Set(
+		"getName",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getName",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(typeName(this))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"toString",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"toString",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(typeName(this))
+				},
+			),
+		},
+	)
+	instanceMethods.Set(
+		"equals",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"equals",
+				BooleanType,
+				[]*ast.Parameter{objectTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					other := params[0]
+					if other.ClassType != TypeType {
+						return NewBoolean(false)
+					}
+					return NewBoolean(typeName(this) == typeName(other))
+				},
+			),
+		},
+	)
+
+	systemNameSpace.Set("Type", TypeType)
+}
@@ -22,6 +22,85 @@ func createTestType() *ast.ClassType {
 		},
 	)
 
+	staticMethods.Set(
+		"createStub",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"createStub",
+				ObjectType,
+				[]*ast.Parameter{typeTypeParameter, stubProviderTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					classType := params[0].Extra["value"].(*ast.ClassType)
+					return NewStub(classType, params[1])
+				},
+			),
+		},
+	)
+
+	staticMethods.Set(
+		"setCurrentTime",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"setCurrentTime",
+				nil,
+				[]*ast.Parameter{datetimeTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					// null restores the time configured by --now or the source of the embedder
+					if params[0] == Null {
+						clockOf(extra).Unset()
+						return nil
+					}
+					clockOf(extra).Set(datetimeValue(params[0]))
+					return nil
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"startTest",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"startTest",
+				nil,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					extra["outer_limits"] = extra["limits"]
+					extra["limits"] = NewLimits(false)
+					return nil
+				},
+			),
+		},
+	)
+	staticMethods.Set(
+		"stopTest",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"stopTest",
+				nil,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if limits, ok := extra["outer_limits"]; ok {
+						extra["limits"] = limits
+						delete(extra, "outer_limits")
+					}
+					// scheduled jobs fire once, and async jobs enqueued by them run afterward
+					runner := extra["interpreter"].(AsyncJobRunner)
+					err := runner.FireScheduledJobs()
+					if e := runner.RunAsyncJobs(); err == nil {
+						err = e
+					}
+					if err != nil {
+						if e, ok := err.(*ExceptionError); ok {
+							return CreateRaise(e.Exception)
+						}
+						return Raise(AsyncExceptionType, err.Error())
+					}
+					return nil
+				},
+			),
+		},
+	)
+
 	classType := ast.CreateClass(
 		"Test",
 		[]*ast.Method{},
@@ -0,0 +1,159 @@
+package builtin
+
+import (
+	"strings"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+)
+
+// UserTimeZone is the time zone of the running user, Datetime methods in local time use it
+var UserTimeZone = time.Local
+
+// UserLocale is the locale of the running user, default formats and names of SimpleDateFormat depend on it
+var UserLocale = "en_US"
+
+// SetUserTimeZone sets the time zone of the running user by IANA time zone ID such as America/Los_Angeles
+func SetUserTimeZone(id string) error {
+	loc, err := time.LoadLocation(id)
+	if err != nil {
+		return err
+	}
+	UserTimeZone = loc
+	return nil
+}
+
+// SetUserLocale sets the locale of the running user such as en_US or ja_JP
+func SetUserLocale(locale string) {
+	UserLocale = strings.Replace(locale, "-", "_", -1)
+}
+
+var timeZoneDisplayNames = map[string]string{
+	"America/Los_Angeles": "Pacific Standard Time",
+	"America/Denver":      "Mountain Standard Time",
+	"America/Phoenix":     "Mountain Standard Time",
+	"America/Chicago":     "Central Standard Time",
+	"America/New_York":    "Eastern Standard Time",
+	"America/Anchorage":   "Alaska Standard Time",
+	"Pacific/Honolulu":    "Hawaii Standard Time",
+	"America/Sao_Paulo":   "Brasilia Time",
+	"Europe/London":       "Greenwich Mean Time",
+	"Europe/Dublin":       "Greenwich Mean Time",
+	"Europe/Paris":        "Central European Time",
+	"Europe/Berlin":       "Central European Time",
+	"Europe/Madrid":       "Central European Time",
+	"Europe/Rome":         "Central European Time",
+	"Europe/Amsterdam":    "Central European Time",
+	"Europe/Moscow":       "Moscow Standard Time",
+	"Asia/Dubai":          "Gulf Standard Time",
+	"Asia/Kolkata":        "India Standard Time",
+	"Asia/Singapore":      "Singapore Time",
+	"Asia/Shanghai":       "China Standard Time",
+	"Asia/Hong_Kong":      "Hong Kong Time",
+	"Asia/Seoul":          "Korean Standard Time",
+	"Asia/Tokyo":          "Japan Standard Time",
+	"Australia/Sydney":    "Australian Eastern Standard Time (New South Wales)",
+	"Pacific/Auckland":    "New Zealand Standard Time",
+	"GMT":                 "Greenwich Mean Time",
+	"UTC":                 "Coordinated Universal Time",
+}
+
+// timeZoneDisplayName returns the long name of standard time of the zone,
+// GMT offset such as GMT+09:00 is used for the zone without name
+func timeZoneDisplayName(loc *time.Location) string {
+	if name, ok := timeZoneDisplayNames[loc.String()]; ok {
+		return name
+	}
+	_, offset := time.Date(SystemClock.Now().Year(), time.January, 1, 0, 0, 0, 0, loc).Zone()
+	return "GMT" + formatOffset(offset, true)
+}
+
+var TimeZoneType = ast.CreateClass(
+	"TimeZone",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+// NewTimeZone creates TimeZone object, unknown time zone ID is GMT as Java does
+func NewTimeZone(id string) *ast.Object {
+	loc, err := time.LoadLocation(id)
+	if err != nil || id == "" || id == "Local" {
+		loc, _ = time.LoadLocation("GMT")
+	}
+	obj := ast.CreateObject(TimeZoneType)
+	obj.Extra["value"] = loc
+	return obj
+}
+
+func timeZoneValue(o *ast.Object) *time.Location {
+	return o.Extra["value"].(*time.Location)
+}
+
+var timeZoneTypeParameter = &ast.Parameter{
+	Type: TimeZoneType,
+	Name: "_",
+}
+
+func init() {
+	TimeZoneType.ToString = func(o *ast.Object) string {
+		return timeZoneValue(o).String()
+	}
+	TimeZoneType.InstanceMethods.Set(
+		"getDisplayName",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getDisplayName",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(timeZoneDisplayName(timeZoneValue(this)))
+				},
+			),
+		},
+	)
+	TimeZoneType.InstanceMethods.Set(
+		"getID",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getID",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(timeZoneValue(this).String())
+				},
+			),
+		},
+	)
+	TimeZoneType.InstanceMethods.Set(
+		"getOffset",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getOffset",
+				IntegerType,
+				[]*ast.Parameter{datetimeTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					if params[0] == Null {
+						return NewNullPointerException("Datetime")
+					}
+					_, offset := datetimeValue(params[0]).In(timeZoneValue(this)).Zone()
+					return NewInteger(offset * 1000)
+				},
+			),
+		},
+	)
+	TimeZoneType.StaticMethods.Set(
+		"getTimeZone",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getTimeZone",
+				TimeZoneType,
+				[]*ast.Parameter{stringTypeParameter},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewTimeZone(params[0].StringValue())
+				},
+			),
+		},
+	)
+	primitiveClassMap.Set("TimeZone", TimeZoneType)
+}
@@ -17,15 +17,17 @@ var Null = &ast.Object{
 	Extra:          map[string]interface{}{},
 }
 
+// NewInteger creates Integer object, the value is wrapped to 32-bit two's complement
 func NewInteger(value int) *ast.Object {
 	t := ast.CreateObject(IntegerType)
-	t.Extra["value"] = value
+	t.Extra["value"] = int(int32(value))
 	return t
 }
 
+// NewLong creates Long object, the value is wrapped to 64-bit two's complement
 func NewLong(value int) *ast.Object {
 	t := ast.CreateObject(LongType)
-	t.Extra["value"] = value
+	t.Extra["value"] = int(int64(value))
 	return t
 }
 
@@ -35,6 +37,12 @@ func NewDouble(value float64) *ast.Object {
 	return t
 }
 
+func NewDecimal(value *Decimal) *ast.Object {
+	t := ast.CreateObject(DecimalType)
+	t.Extra["value"] = value
+	return t
+}
+
 func NewString(value string) *ast.Object {
 	t := ast.CreateObject(StringType)
 	t.Extra["value"] = value
@@ -85,6 +93,9 @@ func Equals(t, other *ast.ClassType) bool {
 		return true
 	}
 	if t.IsGenerics() && other.IsGenerics() {
+		if isIterableOf(t, other) {
+			return true
+		}
 		if t.Name != other.Name {
 			return false
 		}
@@ -100,10 +111,24 @@ func Equals(t, other *ast.ClassType) bool {
 		}
 		return true
 	}
+	if t.IsGenerics() && !other.IsGenerics() {
+		// class implementing generic interface, such as Iterator<String>
+		for c := other; c != nil; c = c.SuperClass {
+			for _, impl := range c.ImplementClasses {
+				if impl.IsGenerics() && Equals(t, impl) {
+					return true
+				}
+			}
+		}
+		return false
+	}
 	if !t.IsGenerics() && !other.IsGenerics() {
 		if t == other {
 			return true
 		}
+		if IsNumericWidening(t, other) {
+			return true
+		}
 		if other.SuperClass != nil {
 			if Equals(t, other.SuperClass) {
 				return true
@@ -120,7 +145,54 @@ func Equals(t, other *ast.ClassType) bool {
 	return false
 }
 
+// numericRank orders numeric types by implicit widening, Integer -> Long -> Double, Decimal
+func numericRank(t *ast.ClassType) int {
+	switch t {
+	case IntegerType:
+		return 1
+	case LongType:
+		return 2
+	case DoubleType, DecimalType:
+		return 3
+	}
+	return 0
+}
+
+// IsNumericWidening returns true if the number of other type is implicitly converted to t, such as Integer to Decimal.
+// Double and Decimal are converted to each other.
+func IsNumericWidening(t, other *ast.ClassType) bool {
+	rank := numericRank(t)
+	otherRank := numericRank(other)
+	return t != other && rank != 0 && otherRank != 0 && rank >= otherRank
+}
+
+// NumericType returns the type of arithmetic operation result, the wider type of operands
+func NumericType(l, r *ast.ClassType) *ast.ClassType {
+	switch {
+	case l == DecimalType || r == DecimalType:
+		return DecimalType
+	case l == DoubleType || r == DoubleType:
+		return DoubleType
+	case l == LongType || r == LongType:
+		return LongType
+	}
+	return IntegerType
+}
+
+func IsNumeric(t *ast.ClassType) bool {
+	return numericRank(t) != 0
+}
+
+// SearchMethod returns the method matched with parameters, the method without numeric widening is preferred,
+// such as Math.abs(Integer) for Integer parameter rather than Math.abs(Decimal)
 func SearchMethod(receiverClass *ast.ClassType, methods []*ast.Method, parameters []*ast.ClassType) *ast.Method {
+	if m := searchMethod(receiverClass, methods, parameters, true); m != nil {
+		return m
+	}
+	return searchMethod(receiverClass, methods, parameters, false)
+}
+
+func searchMethod(receiverClass *ast.ClassType, methods []*ast.Method, parameters []*ast.ClassType, exact bool) *ast.Method {
 	l := len(parameters)
 	for _, m := range methods {
 		if len(m.Parameters) != l {
@@ -137,6 +209,10 @@ func SearchMethod(receiverClass *ast.ClassType, methods []*ast.Method, parameter
 			if methodParam == ObjectType {
 				continue
 			}
+			if exact && IsNumericWidening(methodParam, inputParam) {
+				match = false
+				break
+			}
 			if !Equals(methodParam, inputParam) {
 				match = false
 				break
@@ -158,7 +234,8 @@ func convertGenericsType(receiverClass *ast.ClassType, classType *ast.ClassType)
 		return generics[1]
 	}
 	if classType.IsGenerics() {
-		newClassType := ast.CreateClass(classType.Name, classType.Constructors, classType.InstanceMethods, classType.StaticMethods)
+		copied := *classType
+		newClassType := &copied
 		newClassType.Generics = make([]*ast.ClassType, len(classType.Generics))
 		for i, g := range classType.Generics {
 			newClassType.Generics[i] = convertGenericsType(receiverClass, g)
@@ -167,3 +244,24 @@ func convertGenericsType(receiverClass *ast.ClassType, classType *ast.ClassType)
 	}
 	return classType
 }
+
+// ResolveReturnType replaces type parameters of the method return type with generics of the receiver,
+// such as List<T:2> of Map.values()
+func ResolveReturnType(receiverClass *ast.ClassType, returnType *ast.ClassType) *ast.ClassType {
+	if !hasTypeParameter(returnType) {
+		return returnType
+	}
+	return convertGenericsType(receiverClass, returnType)
+}
+
+func hasTypeParameter(classType *ast.ClassType) bool {
+	if classType == T1type || classType == T2type {
+		return true
+	}
+	for _, generic := range classType.Generics {
+		if hasTypeParameter(generic) {
+			return true
+		}
+	}
+	return false
+}
@@ -29,6 +29,14 @@ func (r *TypeRefResolver) ResolveType(names []string) (*ast.ClassType, error) {
 			if class, ok := r.CurrentClass.InnerClasses.Get(className); ok {
 				return class, nil
 			}
+			// search for sibling inner class from inner class
+			if outer, ok := r.CurrentClass.Parent.(*ast.ClassDeclaration); ok {
+				if class, ok := r.ClassTypes.Get(outer.Name); ok {
+					if inner, ok := class.InnerClasses.Get(className); ok {
+						return inner, nil
+					}
+				}
+			}
 		}
 	} else if len(names) == 2 {
 		// search for UserClass.InnerClass
@@ -0,0 +1,44 @@
+package builtin
+
+import (
+	"github.com/tzmfreedom/land/ast"
+)
+
+var userInfoType = ast.CreateClass(
+	"UserInfo",
+	[]*ast.Method{},
+	ast.NewMethodMap(),
+	ast.NewMethodMap(),
+)
+
+func init() {
+	userInfoType.StaticMethods.Set(
+		"getLocale",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getLocale",
+				StringType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					return NewString(UserLocale)
+				},
+			),
+		},
+	)
+	userInfoType.StaticMethods.Set(
+		"getTimeZone",
+		[]*ast.Method{
+			ast.CreateMethod(
+				"getTimeZone",
+				TimeZoneType,
+				[]*ast.Parameter{},
+				func(this *ast.Object, params []*ast.Object, extra map[string]interface{}) interface{} {
+					obj := ast.CreateObject(TimeZoneType)
+					obj.Extra["value"] = UserTimeZone
+					return obj
+				},
+			),
+		},
+	)
+	primitiveClassMap.Set("UserInfo", userInfoType)
+}
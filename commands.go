@@ -17,7 +17,6 @@ import (
 	"path/filepath"
 
 	"github.com/Songmu/prompter"
-	"github.com/chzyer/readline"
 	"github.com/fsnotify/fsnotify"
 	"github.com/mattn/go-colorable"
 	"github.com/tzmfreedom/land/ast"
@@ -52,6 +51,90 @@ var actionFlag = cli.StringFlag{
 	Name: "action, a",
 }
 
+var untilFlag = cli.StringFlag{
+	Name:  "until",
+	Usage: "run scheduled jobs until the time, such as 2020-01-01T00:00:00Z or \"2020-01-01 00:00:00\"",
+}
+
+var nowFlag = cli.StringFlag{
+	Name:   "now",
+	EnvVar: "LAND_NOW",
+	Usage:  "fix the current time, such as 2020-01-01T00:00:00Z or \"2020-01-01 00:00:00\" (default: host time)",
+}
+
+var timeZoneFlag = cli.StringFlag{
+	Name:   "timezone",
+	EnvVar: "LAND_TIMEZONE",
+	Usage:  "time zone of the running user, such as America/Los_Angeles (default: host time zone)",
+}
+
+var localeFlag = cli.StringFlag{
+	Name:   "locale",
+	EnvVar: "LAND_LOCALE",
+	Value:  "en_US",
+	Usage:  "locale of the running user, such as en_US or ja_JP",
+}
+
+var keyStoreFlag = cli.StringFlag{
+	Name:   "keystore",
+	EnvVar: "LAND_KEYSTORE",
+	Value:  builtin.KeyStoreDirectory,
+	Usage:  "directory of certificates for Crypto.signWithCertificate, <name>.key and <name>.crt in PEM format",
+}
+
+var calloutsFlag = cli.StringFlag{
+	Name:   "callouts",
+	EnvVar: "LAND_CALLOUTS",
+	Usage:  "YAML file of named credentials and remote sites, callouts are not restricted if it is not given",
+}
+
+var cassetteFlag = cli.StringFlag{
+	Name:   "cassette",
+	EnvVar: "LAND_CASSETTE",
+	Usage:  "YAML file to record callouts to, or replay callouts from",
+}
+
+var cassetteModeFlag = cli.StringFlag{
+	Name:   "cassette-mode",
+	EnvVar: "LAND_CASSETTE_MODE",
+	Value:  builtin.CassetteReplay,
+	Usage:  "record or replay",
+}
+
+var serverTimeoutFlag = cli.DurationFlag{
+	Name:   "timeout",
+	EnvVar: "LAND_SERVER_TIMEOUT",
+	Value:  server.DefaultTimeout,
+	Usage:  "timeout of each request, the execution is interrupted after it",
+}
+
+var snippetStorageFlag = cli.StringFlag{
+	Name:   "storage",
+	EnvVar: "LAND_SNIPPET_STORAGE",
+	Usage:  "storage of shared snippets: postgres://..., sqlite:{file} or file:{directory}, DATABASE_URL or file:snippets if it is not given",
+}
+
+var maxExecutionTimeFlag = cli.DurationFlag{
+	Name:   "max-execution-time",
+	EnvVar: "LAND_MAX_EXECUTION_TIME",
+	Value:  server.DefaultMaxExecutionTime,
+	Usage:  "wall-clock time of each execution, 0 is unlimited",
+}
+
+var maxStatementsFlag = cli.IntFlag{
+	Name:   "max-statements",
+	EnvVar: "LAND_MAX_STATEMENTS",
+	Value:  server.DefaultMaxStatements,
+	Usage:  "number of statements of each execution, 0 is unlimited",
+}
+
+var maxHeapFlag = cli.IntFlag{
+	Name:   "max-heap-mb",
+	EnvVar: "LAND_MAX_HEAP_MB",
+	Value:  server.DefaultMaxHeapMB,
+	Usage:  "bytes allocated by each execution in megabytes, 0 is unlimited",
+}
+
 var interactiveFlag = cli.BoolFlag{
 	Name: "interactive, i",
 }
@@ -182,8 +265,18 @@ var testCommand = cli.Command{
 		fileFlag,
 		directoryFlag,
 		metaFileFlag,
+		timeZoneFlag,
+		keyStoreFlag,
+		calloutsFlag,
+		cassetteFlag,
+		cassetteModeFlag,
+		localeFlag,
+		nowFlag,
 	},
 	Action: func(c *cli.Context) error {
+		if err := setUserSettings(c); err != nil {
+			return err
+		}
 		builtin.LoadSObjectClass(c.String("metafile"))
 
 		files, err := parseFileOption(c)
@@ -200,13 +293,9 @@ var testCommand = cli.Command{
 		}
 		var i = 1
 		for _, classType := range classTypes {
-			for _, methods := range classType.StaticMethods.All() {
-				for _, m := range methods {
-					if m.IsTestMethod() {
-						runTest(classTypes, classType, m, i)
-						i++
-					}
-				}
+			i, err = runTestClass(classTypes, classType, i)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err.Error())
 			}
 		}
 		return nil
@@ -251,6 +340,7 @@ var serverCommand = cli.Command{
 		fileFlag,
 		directoryFlag,
 		metaFileFlag,
+		serverTimeoutFlag,
 	},
 	Action: func(c *cli.Context) error {
 		builtin.LoadSObjectClass(c.String("metafile"))
@@ -267,8 +357,7 @@ var serverCommand = cli.Command{
 		if err != nil {
 			return err
 		}
-		server.Run(classTypes)
-		return nil
+		return server.Run(classTypes, c.Duration("timeout"))
 	},
 }
 
@@ -277,11 +366,26 @@ var evalServerCommand = cli.Command{
 	Usage: "",
 	Flags: []cli.Flag{
 		metaFileFlag,
+		serverTimeoutFlag,
+		snippetStorageFlag,
+		maxExecutionTimeFlag,
+		maxStatementsFlag,
+		maxHeapFlag,
 	},
 	Action: func(c *cli.Context) error {
 		builtin.LoadSObjectClass(c.String("metafile"))
 
-		s := &server.EvalServer{}
+		store, err := server.NewSnippetStore(c.String("storage"))
+		if err != nil {
+			return err
+		}
+		s := &server.EvalServer{
+			Timeout:          c.Duration("timeout"),
+			Store:            store,
+			MaxExecutionTime: c.Duration("max-execution-time"),
+			MaxStatements:    c.Int("max-statements"),
+			MaxHeapSize:      uint64(c.Int("max-heap-mb")) << 20,
+		}
 		s.Run()
 		return nil
 	},
@@ -322,12 +426,23 @@ var runCommand = cli.Command{
 		fileFlag,
 		directoryFlag,
 		actionFlag,
+		interactiveFlag,
 		metaFileFlag,
+		timeZoneFlag,
+		keyStoreFlag,
+		calloutsFlag,
+		cassetteFlag,
+		cassetteModeFlag,
+		localeFlag,
+		nowFlag,
 	},
 	Action: func(c *cli.Context) error {
-		if c.String("action") == "" {
+		if c.String("action") == "" && !c.Bool("interactive") {
 			return errors.New("-a CLASS#METHOD is required")
 		}
+		if err := setUserSettings(c); err != nil {
+			return err
+		}
 		builtin.LoadSObjectClass(c.String("metafile"))
 
 		files, err := parseFileOption(c)
@@ -357,6 +472,166 @@ var runCommand = cli.Command{
 	},
 }
 
+var execCommand = cli.Command{
+	Name:  "exec",
+	Usage: "execute anonymous Apex of the file, or stdin if -f is not given, with classes in -d",
+	Flags: []cli.Flag{
+		fileFlag,
+		directoryFlag,
+		metaFileFlag,
+		timeZoneFlag,
+		keyStoreFlag,
+		calloutsFlag,
+		cassetteFlag,
+		cassetteModeFlag,
+		localeFlag,
+		nowFlag,
+	},
+	Action: func(c *cli.Context) error {
+		if err := setUserSettings(c); err != nil {
+			return err
+		}
+		builtin.LoadSObjectClass(c.String("metafile"))
+
+		var classTypes []*ast.ClassType
+		if dir := c.String("directory"); dir != "" {
+			files, err := classFiles(dir)
+			if err != nil {
+				return err
+			}
+			trees, err := parseFiles(files)
+			if err != nil {
+				return err
+			}
+			classTypes, err = buildAllFile(trees)
+			if err != nil {
+				return err
+			}
+		}
+
+		file := c.String("file")
+		var src []byte
+		var err error
+		if file == "" || file == "-" {
+			file = "<stdin>"
+			src, err = ioutil.ReadAll(os.Stdin)
+		} else {
+			src, err = ioutil.ReadFile(file)
+		}
+		if err != nil {
+			return err
+		}
+		classType, err := buildAnonymous(string(src), file)
+		if err != nil {
+			return err
+		}
+		return run(ast.AnonymousClassName+"#"+ast.AnonymousMethodName, append(classTypes, classType))
+	},
+}
+
+var scheduleCommand = cli.Command{
+	Name:  "schedule",
+	Usage: "run action, then fire jobs scheduled by System.schedule until the time",
+	Flags: []cli.Flag{
+		fileFlag,
+		directoryFlag,
+		actionFlag,
+		metaFileFlag,
+		untilFlag,
+		timeZoneFlag,
+		keyStoreFlag,
+		calloutsFlag,
+		cassetteFlag,
+		cassetteModeFlag,
+		localeFlag,
+		nowFlag,
+	},
+	Action: func(c *cli.Context) error {
+		if c.String("action") == "" {
+			return errors.New("-a CLASS#METHOD is required")
+		}
+		if c.String("until") == "" {
+			return errors.New("--until TIME is required")
+		}
+		if err := setUserSettings(c); err != nil {
+			return err
+		}
+		until, err := parseTime(c.String("until"))
+		if err != nil {
+			return err
+		}
+		builtin.LoadSObjectClass(c.String("metafile"))
+
+		files, err := parseFileOption(c)
+		if err != nil {
+			return err
+		}
+		trees, err := parseFiles(files)
+		if err != nil {
+			return err
+		}
+		classTypes, err := buildAllFile(trees)
+		if err != nil {
+			return err
+		}
+
+		builtin.DatabaseDriver.Begin()
+		defer builtin.DatabaseDriver.Rollback()
+		var i *interpreter.Interpreter
+		err = invoke(c.String("action"), classTypes, func(v *interpreter.Interpreter) {
+			i = v
+		})
+		if err != nil {
+			return err
+		}
+		return i.RunScheduledJobs(until)
+	},
+}
+
+// setUserSettings sets time zone and locale of the running user, the current time, the key store, callouts and the cassette from the flags
+func setUserSettings(c *cli.Context) error {
+	builtin.KeyStoreDirectory = c.String("keystore")
+	if callouts := c.String("callouts"); callouts != "" {
+		if err := builtin.LoadCalloutConfig(callouts); err != nil {
+			return err
+		}
+	}
+	if cassette := c.String("cassette"); cassette != "" {
+		var err error
+		if builtin.CalloutCassette, err = builtin.LoadCassette(cassette, c.String("cassette-mode")); err != nil {
+			return err
+		}
+	}
+	if timeZone := c.String("timezone"); timeZone != "" {
+		if err := builtin.SetUserTimeZone(timeZone); err != nil {
+			return fmt.Errorf("invalid time zone: %s", timeZone)
+		}
+	}
+	builtin.SetUserLocale(c.String("locale"))
+	builtin.SystemClock.Reset()
+	if now := c.String("now"); now != "" {
+		t, err := parseTime(now)
+		if err != nil {
+			return err
+		}
+		// the time is the source of the clock, so Test.setCurrentTime(null) returns to it
+		builtin.SystemClock.SetSource(func() time.Time { return t })
+	}
+	return nil
+}
+
+// parseTime parses time in RFC3339 or "2006-01-02 15:04:05" format in the user time zone
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", value, builtin.UserTimeZone)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time: %s", value)
+	}
+	return t, nil
+}
+
 var checkCommand = cli.Command{
 	Name:  "check",
 	Usage: "",
@@ -439,25 +714,28 @@ func parseFileOption(c *cli.Context) ([]string, error) {
 		return nil, errors.New("-f FILE or -d DIRECTORY is required")
 	}
 
-	var files []string
 	if file != "" {
-		files = []string{file}
-	} else if dir != "" {
-		filesInDirectory, err := ioutil.ReadDir(dir)
-		if err != nil {
-			return nil, err
+		return []string{file}, nil
+	}
+	return classFiles(dir)
+}
+
+// classFiles returns .cls and .apxc files in the directory
+func classFiles(dir string) ([]string, error) {
+	filesInDirectory, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, f := range filesInDirectory {
+		if f.IsDir() {
+			continue
 		}
-		files = []string{}
-		for _, f := range filesInDirectory {
-			if f.IsDir() {
-				continue
-			}
-			ext := filepath.Ext(f.Name())
-			if ext != ".cls" && ext != ".apxc" {
-				continue
-			}
-			files = append(files, fmt.Sprintf("%s/%s", dir, f.Name()))
+		ext := filepath.Ext(f.Name())
+		if ext != ".cls" && ext != ".apxc" {
+			continue
 		}
+		files = append(files, fmt.Sprintf("%s/%s", dir, f.Name()))
 	}
 	return files, nil
 }
@@ -507,6 +785,14 @@ func semanticAnalysis(t *ast.ClassType) error {
 }
 
 func run(action string, classTypes []*ast.ClassType, options ...func(*interpreter.Interpreter)) error {
+	builtin.DatabaseDriver.Begin()
+	defer builtin.DatabaseDriver.Rollback()
+
+	return invoke(action, classTypes, options...)
+}
+
+// invoke calls Class#method in the current transaction
+func invoke(action string, classTypes []*ast.ClassType, options ...func(*interpreter.Interpreter)) error {
 	method := "action"
 	args := strings.Split(action, "#")
 	if len(args) > 1 {
@@ -521,133 +807,13 @@ func run(action string, classTypes []*ast.ClassType, options ...func(*interprete
 	for _, option := range options {
 		option(interpreter)
 	}
-	builtin.DatabaseDriver.Begin()
-	defer builtin.DatabaseDriver.Rollback()
-
 	interpreter.LoadStaticField()
 	_, err := invoke.Accept(interpreter)
-	return err
-}
-
-func interactiveRun(classTypes []*ast.ClassType, files []string) error {
-	lastReloadedAt := time.Now()
-	landInterpreter := interpreter.NewInterpreterWithBuiltin(classTypes)
-	l, _ := readline.NewEx(&readline.Config{
-		Prompt:          "\033[31m>>\033[0m ",
-		HistoryFile:     "/tmp/land.tmp",
-		InterruptPrompt: "^C",
-		EOFPrompt:       "exit",
-	})
-	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
 		return err
 	}
-	defer watcher.Close()
-
-	ch := make(chan bool, 1)
-	go func() {
-		for {
-			select {
-			case event, ok := <-watcher.Events:
-				if !ok {
-					return
-				}
-				ch <- true
-				if event.Op&fsnotify.Write == fsnotify.Write {
-					buildFile(landInterpreter, event.Name)
-				} else if event.Op&fsnotify.Create == fsnotify.Create {
-					buildFile(landInterpreter, event.Name)
-				}
-				<-ch
-			case err, ok := <-watcher.Errors:
-				if !ok {
-					return
-				}
-				fmt.Println("error:", err)
-			}
-		}
-	}()
-	err = watcher.Add("./fixtures")
-	if err != nil {
-		return err
-	}
-
-	// TODO: implement
-	env := interpreter.NewEnv(nil)
-	interpreter.Subscribe("method_end", func(ctx *interpreter.Context, n ast.Node) {
-		env = ctx.Env
-	})
-	for {
-		line, err := l.Readline()
-		if err != nil {
-			panic(err)
-		}
-		inputs := strings.Split(line, " ")
-		cmd := inputs[0]
-		args := inputs[1:]
-		switch cmd {
-		case "execute":
-			if len(args) == 0 {
-				fmt.Println("Error: execute command required argument")
-				continue
-			}
-			ch <- true
-			run(args[0], classTypes)
-			<-ch
-		case "reload":
-			ch <- true
-			if len(args) == 0 {
-				err := reloadAll(landInterpreter, files)
-				if err != nil {
-					return err
-				}
-			} else {
-				_, err := buildFile(landInterpreter, args[0])
-				if err != nil {
-					fmt.Println(err.Error())
-				}
-			}
-			<-ch
-		case "run":
-			if len(args) == 0 {
-				fmt.Println("Error: run command required argument")
-				continue
-			}
-			isReload := false
-			for _, f := range files {
-				info, err := os.Stat(f)
-				if err != nil {
-					return err
-				}
-				if info.ModTime().After(lastReloadedAt) {
-					isReload = true
-					break
-				}
-			}
-			ch <- true
-			if isReload {
-				lastReloadedAt = time.Now()
-				err := reloadAll(landInterpreter, files)
-				if err != nil {
-					return err
-				}
-			}
-			run(args[0], classTypes)
-			<-ch
-		case "exit":
-			return nil
-		default:
-			code := createTempClass(line)
-			execFile(code, env)
-		}
-	}
-	return nil
-}
-
-func createTempClass(statement string) string {
-	return fmt.Sprintf(`public class Temporary {
-public static void action() { %s; }
-}`, statement)
+	// async jobs run after the transaction of the action
+	return interpreter.RunAsyncJobs()
 }
 
 func watchAndRunTest(classTypes []*ast.ClassType, directory string) error {
@@ -674,14 +840,9 @@ func watchAndRunTest(classTypes []*ast.ClassType, directory string) error {
 				if err != nil {
 					fmt.Printf("Error: %s\n", err.Error())
 				} else {
-					i := 0
-					for _, methods := range classType.StaticMethods.Data {
-						for _, m := range methods {
-							if m.IsTestMethod() {
-								runTest(classTypes, classType, m, i)
-							}
-							i++
-						}
+					_, err = runTestClass(classTypes, classType, 1)
+					if err != nil {
+						fmt.Printf("Error: %s\n", err.Error())
 					}
 				}
 			}
@@ -704,7 +865,11 @@ func runAction(interpreter *interpreter.Interpreter, expression []string) error
 	}
 	interpreter.LoadStaticField()
 	_, err := invoke.Accept(interpreter)
-	return err
+	if err != nil {
+		return err
+	}
+	// async jobs run after the transaction of the action
+	return interpreter.RunAsyncJobs()
 }
 
 func buildFile(interpreter *interpreter.Interpreter, file string) (*ast.ClassType, error) {
@@ -765,37 +930,31 @@ func buildAllFile(trees []ast.Node) ([]*ast.ClassType, error) {
 	return classTypes, nil
 }
 
-func execFile(code string, env *interpreter.Env) *interpreter.Env {
-	t, err := ast.ParseString(code, preprocessors...)
+// buildAnonymous compiles anonymous Apex to AnonymousBlock class, which is registered as the other classes
+func buildAnonymous(src string, fileName string) (*ast.ClassType, error) {
+	t, err := ast.ParseAnonymous(src, fileName, preprocessors...)
+	if err != nil {
+		return nil, err
+	}
 	classType, err := register(t, false)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
-	classTypes := builtin.PrimitiveClassMap()
+	tmpClassMap := builtin.PrimitiveClassMap()
 	for _, classType := range classMap.Data {
-		classTypes.Set(classType.Name, classType)
+		tmpClassMap.Set(classType.Name, classType)
 	}
-
-	classType, err = convert(classType, classTypes)
+	classType, err = convert(classType, tmpClassMap)
 	if err != nil {
-		panic(err)
-	}
-	if err = semanticAnalysis(classType); err != nil {
-		panic(err)
+		return nil, err
 	}
-	interpreter := interpreter.NewInterpreterWithBuiltin([]*ast.ClassType{classType})
-	interpreter.Context.Env = env
-	invoke := &ast.MethodInvocation{
-		NameOrExpression: &ast.Name{
-			Value: []string{"Temporary", "action"},
-		},
+	if err := compiler.CheckClass(classType); err != nil {
+		return nil, err
 	}
-	interpreter.LoadStaticField()
-	_, err = invoke.Accept(interpreter)
-	if err != nil {
-		panic(err)
+	if err := semanticAnalysis(classType); err != nil {
+		return nil, err
 	}
-	return env
+	return classType, nil
 }
 
 func reloadAll(interpreter *interpreter.Interpreter, files []string) error {
@@ -839,18 +998,103 @@ func reloadAll(interpreter *interpreter.Interpreter, files []string) error {
 	return nil
 }
 
+// runTestClass runs test methods of the class in one transaction, and returns the next test number.
+// @testSetup methods run once, and the data they create is restored before each test method.
+// Org data is invisible from test methods unless @isTest(SeeAllData=true) is specified.
+func runTestClass(classTypes []*ast.ClassType, classType *ast.ClassType, i int) (int, error) {
+	setupMethods := []*ast.Method{}
+	isolatedMethods := []*ast.Method{}
+	seeAllDataMethods := []*ast.Method{}
+	classSeeAllData := isSeeAllData(classType.GetAnnotation("isTest"))
+	for _, methods := range classType.StaticMethods.All() {
+		for _, m := range methods {
+			if m.IsAnnotated("testSetup") {
+				setupMethods = append(setupMethods, m)
+			} else if m.IsTestMethod() {
+				if classSeeAllData || isSeeAllData(m.GetAnnotation("isTest")) {
+					seeAllDataMethods = append(seeAllDataMethods, m)
+				} else {
+					isolatedMethods = append(isolatedMethods, m)
+				}
+			}
+		}
+	}
+	if classSeeAllData && len(setupMethods) > 0 {
+		return i, fmt.Errorf("%s: test class containing a test setup method cannot be annotated with @isTest(SeeAllData=true)", classType.Name)
+	}
+
+	if err := builtin.DatabaseDriver.Begin(); err != nil {
+		return i, err
+	}
+	defer builtin.DatabaseDriver.Rollback()
+
+	if err := builtin.DatabaseDriver.Savepoint("org"); err != nil {
+		return i, err
+	}
+	for _, m := range seeAllDataMethods {
+		runTest(classTypes, classType, m, i)
+		if err := builtin.DatabaseDriver.RollbackTo("org"); err != nil {
+			return i, err
+		}
+		i++
+	}
+	if len(isolatedMethods) == 0 {
+		return i, nil
+	}
+
+	if err := builtin.DatabaseDriver.Truncate(); err != nil {
+		return i, err
+	}
+	for _, m := range setupMethods {
+		action := fmt.Sprintf("%s#%s", classType.Name, m.Name)
+		err := invoke(action, classTypes, func(i *interpreter.Interpreter) {
+			i.Extra["stdout"] = new(bytes.Buffer)
+		})
+		if err != nil {
+			return i, fmt.Errorf("%s: %s", action, err.Error())
+		}
+	}
+	if err := builtin.DatabaseDriver.Savepoint("setup"); err != nil {
+		return i, err
+	}
+	for _, m := range isolatedMethods {
+		runTest(classTypes, classType, m, i)
+		if err := builtin.DatabaseDriver.RollbackTo("setup"); err != nil {
+			return i, err
+		}
+		i++
+	}
+	return i, nil
+}
+
+func isSeeAllData(annotation *ast.Annotation) bool {
+	if annotation == nil {
+		return false
+	}
+	value, ok := annotation.Parameter("SeeAllData")
+	if !ok {
+		return false
+	}
+	b, ok := value.(*ast.BooleanLiteral)
+	return ok && b.Value
+}
+
+// runTest runs the test method in the current transaction
 func runTest(classTypes []*ast.ClassType, classType *ast.ClassType, m *ast.Method, i int) error {
 	action := fmt.Sprintf("%s#%s", classType.Name, m.Name)
 	fmt.Printf("(%d) %s: ", i, action)
 	var ret *interpreter.Interpreter
-	err := run(action, classTypes, func(i *interpreter.Interpreter) {
+	err := invoke(action, classTypes, func(i *interpreter.Interpreter) {
 		ret = i
 		i.Extra["stdout"] = new(bytes.Buffer)
 	})
+	stdout := colorable.NewColorableStdout()
 	if err != nil {
+		fmt.Println("")
+		fmt.Fprintf(stdout, builtin.ErrorColor, fmt.Sprintf("    Error: %s\n", err.Error()))
+		fmt.Println("")
 		return err
 	}
-	stdout := colorable.NewColorableStdout()
 	errors := ret.Extra["errors"].([]*builtin.TestError)
 	if len(errors) > 0 {
 		fmt.Println("")
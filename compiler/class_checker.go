@@ -11,6 +11,9 @@ import (
 )
 
 func CheckClass(t *ast.ClassType) error {
+	if builtin.IsEnum(t) {
+		return checkTopLevelType(t)
+	}
 	if err := checkTopLevelType(t); err != nil {
 		return err
 	}
@@ -2,7 +2,9 @@ package compiler
 
 import (
 	"fmt"
+
 	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
 )
 
 type ClassRegisterVisitor struct{}
@@ -56,6 +58,15 @@ func (v *ClassRegisterVisitor) VisitInterfaceDeclaration(n *ast.InterfaceDeclara
 	return t, nil
 }
 
+func (v *ClassRegisterVisitor) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	t := builtin.NewEnumType(n.Name, n.Constants)
+	t.Modifiers = n.Modifiers
+	t.Location = n.Location
+	t.Annotations = n.Annotations
+	t.Parent = n.Parent
+	return t, nil
+}
+
 func (v *ClassRegisterVisitor) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return ast.VisitIntegerLiteral(v, n)
 }
@@ -329,6 +340,7 @@ func (v *ClassRegisterVisitor) setDeclaration(declarations []ast.Node, t *ast.Cl
 						Expression: &ast.NullLiteral{},
 						Getter:     getter,
 						Setter:     setter,
+						Location:   decl.Location,
 					},
 				)
 			}
@@ -360,11 +372,12 @@ func (v *ClassRegisterVisitor) setDeclaration(declarations []ast.Node, t *ast.Cl
 							Modifiers:  decl.Modifiers,
 							Name:       d.Name,
 							Expression: d.Expression,
+							Location:   d.Location,
 						},
 					)
 				}
 			}
-		case *ast.ClassDeclaration:
+		case *ast.ClassDeclaration, *ast.InterfaceDeclaration, *ast.EnumDeclaration:
 			r, err := decl.Accept(v)
 			if err != nil {
 				return err
@@ -357,6 +357,34 @@ func TestClassRegisterDuplicateClass(t *testing.T) {
 	}
 }
 
+func TestClassRegisterInnerInterface(t *testing.T) {
+	classNode := &ast.ClassDeclaration{
+		Modifiers:   []*ast.Modifier{},
+		Annotations: []*ast.Annotation{},
+		Name:        "Foo",
+		Declarations: []ast.Node{
+			&ast.InterfaceDeclaration{
+				Modifiers:   []*ast.Modifier{},
+				Annotations: []*ast.Annotation{},
+				Name:        "Bar",
+				Methods:     []*ast.MethodDeclaration{},
+			},
+		},
+	}
+	classRegister := &ClassRegisterVisitor{}
+	r, err := classNode.Accept(classRegister)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner, ok := r.(*ast.ClassType).InnerClasses.Get("Bar")
+	if !ok {
+		t.Fatalf("inner interface Bar is not registered")
+	}
+	if !inner.IsInterface() {
+		t.Errorf("inner class Bar is expected to be interface")
+	}
+}
+
 func TestClassRegisterError(t *testing.T) {
 	testCases := []struct {
 		Input         ast.Node
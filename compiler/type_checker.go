@@ -3,7 +3,9 @@ package compiler
 import (
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/tzmfreedom/land/ast"
@@ -24,6 +26,10 @@ func NewTypeChecker() *TypeChecker {
 }
 
 func (v *TypeChecker) VisitClassType(n *ast.ClassType) (interface{}, error) {
+	// enum has only builtin methods
+	if builtin.IsEnum(n) {
+		return nil, nil
+	}
 	v.Context.CurrentClass = n
 	if n.StaticFields != nil {
 		for _, f := range n.StaticFields.Data {
@@ -41,6 +47,7 @@ func (v *TypeChecker) VisitClassType(n *ast.ClassType) (interface{}, error) {
 			if !builtin.Equals(f.Type, e.(*ast.ClassType)) {
 				v.AddError(fmt.Sprintf("expression <%s> does not match <%s>", e.(*ast.ClassType).String(), f.Type.String()), f.Expression)
 			}
+			f.Expression = widen(f.Type, f.Expression, e.(*ast.ClassType))
 		}
 	}
 
@@ -62,6 +69,7 @@ func (v *TypeChecker) VisitClassType(n *ast.ClassType) (interface{}, error) {
 			if !builtin.Equals(f.Type, e.(*ast.ClassType)) {
 				v.AddError(fmt.Sprintf("expression <%s> does not match <%s>", e.(*ast.ClassType).String(), f.Type.String()), f.Expression)
 			}
+			f.Expression = widen(f.Type, f.Expression, e.(*ast.ClassType))
 		}
 	}
 
@@ -96,6 +104,14 @@ func (v *TypeChecker) VisitClassType(n *ast.ClassType) (interface{}, error) {
 		}
 	}
 
+	if n.InnerClasses != nil {
+		for _, inner := range n.InnerClasses.Data {
+			if _, err := v.VisitClassType(inner); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	v.Context.CurrentClass = nil
 	return nil, nil
 }
@@ -120,7 +136,17 @@ func (v *TypeChecker) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration) (in
 	return ast.VisitInterfaceDeclaration(v, n)
 }
 
+func (v *TypeChecker) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	return ast.VisitEnumDeclaration(v, n)
+}
+
 func (v *TypeChecker) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
+	if n.IsLong {
+		return builtin.LongType, nil
+	}
+	if n.Value > math.MaxInt32 || n.Value < math.MinInt32 {
+		v.AddError(fmt.Sprintf("Illegal integer: %d", n.Value), n)
+	}
 	return builtin.IntegerType, nil
 }
 
@@ -202,7 +228,9 @@ func (v *TypeChecker) VisitTry(n *ast.Try) (interface{}, error) {
 	for _, c := range n.CatchClause {
 		c.Accept(v)
 	}
-	n.FinallyBlock.Accept(v)
+	if n.FinallyBlock != nil {
+		n.FinallyBlock.Accept(v)
+	}
 	return nil, nil
 }
 
@@ -333,15 +361,9 @@ func (v *TypeChecker) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		if err := v.checkAddError(n, receiverType, method); err != nil {
 			return nil, err
 		}
+		widenParameters(receiverType, method, n.Parameters, types)
 		if method.ReturnType != nil {
-			retType := method.ReturnType
-			if retType == builtin.T1type {
-				return receiverType.Generics[0], nil
-			}
-			if retType == builtin.T2type {
-				return receiverType.Generics[1], nil
-			}
-			return method.ReturnType, nil
+			return builtin.ResolveReturnType(receiverType, method.ReturnType), nil
 		}
 	} else if fieldAccess, ok := nameOrExp.(*ast.FieldAccess); ok {
 		classType, err := fieldAccess.Expression.Accept(v)
@@ -360,16 +382,9 @@ func (v *TypeChecker) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		if err := v.checkAddError(n, receiverType, method); err != nil {
 			return nil, err
 		}
+		widenParameters(receiverType, method, n.Parameters, types)
 		if method.ReturnType != nil {
-			// TODO: duplicate code
-			retType := method.ReturnType
-			if retType == builtin.T1type {
-				return receiverType.Generics[0], nil
-			}
-			if retType == builtin.T2type {
-				return receiverType.Generics[1], nil
-			}
-			return method.ReturnType, nil
+			return builtin.ResolveReturnType(receiverType, method.ReturnType), nil
 		}
 	}
 	return nil, nil
@@ -412,6 +427,7 @@ func (v *TypeChecker) VisitNew(n *ast.New) (interface{}, error) {
 			if !builtin.Equals(f.Type, valueType) {
 				v.AddError(fmt.Sprintf("Illegal assignment from %s to %s", valueType.String(), f.Type.String()), n)
 			}
+			binOp.Right = widen(f.Type, binOp.Right, valueType)
 		}
 	} else {
 		for i, p := range n.Parameters {
@@ -426,15 +442,16 @@ func (v *TypeChecker) VisitNew(n *ast.New) (interface{}, error) {
 	if classType.Name == "List" {
 		elemClass := classType.Generics[0]
 		if n.Init != nil {
-			for _, record := range n.Init.Records {
+			for i, record := range n.Init.Records {
 				r, err := record.Accept(v)
-				paramElemClass := r.(*ast.ClassType)
 				if err != nil {
 					return nil, err
 				}
-				if !builtin.Equals(paramElemClass, elemClass) {
+				paramElemClass := r.(*ast.ClassType)
+				if !builtin.Equals(elemClass, paramElemClass) {
 					v.AddError(fmt.Sprintf("initialization is not match type %s != %s", elemClass.String(), paramElemClass.String()), n)
 				}
+				n.Init.Records[i] = widen(elemClass, record, paramElemClass)
 			}
 		}
 	}
@@ -443,23 +460,25 @@ func (v *TypeChecker) VisitNew(n *ast.New) (interface{}, error) {
 		keyClass := classType.Generics[0]
 		valueClass := classType.Generics[1]
 		if n.Init != nil {
-			for key, value := range n.Init.Values {
-				r, err := key.Accept(v)
-				paramKeyClass := r.(*ast.ClassType)
+			for _, entry := range n.Init.Values {
+				r, err := entry.Key.Accept(v)
 				if err != nil {
 					return nil, err
 				}
-				if builtin.Equals(paramKeyClass, keyClass) {
+				paramKeyClass := r.(*ast.ClassType)
+				if !builtin.Equals(keyClass, paramKeyClass) {
 					v.AddError(fmt.Sprintf("initialization is not match type %s != %s", keyClass.String(), paramKeyClass.String()), n)
 				}
-				r, err = value.Accept(v)
-				paramValueClass := r.(*ast.ClassType)
+				entry.Key = widen(keyClass, entry.Key, paramKeyClass)
+				r, err = entry.Value.Accept(v)
 				if err != nil {
 					return nil, err
 				}
-				if builtin.Equals(paramValueClass, valueClass) {
+				paramValueClass := r.(*ast.ClassType)
+				if !builtin.Equals(valueClass, paramValueClass) {
 					v.AddError(fmt.Sprintf("initialization is not match type %s != %s", valueClass.String(), paramValueClass.String()), n)
 				}
+				entry.Value = widen(valueClass, entry.Value, paramValueClass)
 			}
 		}
 	}
@@ -480,6 +499,7 @@ func (v *TypeChecker) VisitNew(n *ast.New) (interface{}, error) {
 		v.AddError(fmt.Sprintf("constructor <%s> not found", classType.String()), n)
 		return n.Type, nil
 	}
+	widenParameters(classType, method, n.Parameters, params)
 	// TODO: for protected impl
 	if method.IsPrivate() && v.Context.CurrentClass != classType {
 		v.AddError(fmt.Sprintf("constructor <%s> not found", classType.String()), n)
@@ -492,14 +512,31 @@ func (v *TypeChecker) VisitNullLiteral(n *ast.NullLiteral) (interface{}, error)
 }
 
 func (v *TypeChecker) VisitUnaryOperator(n *ast.UnaryOperator) (interface{}, error) {
+	// -2147483648 is the only literal of Integer.MIN_VALUE
+	if lit, ok := n.Expression.(*ast.IntegerLiteral); ok && n.Op == "-" && !lit.IsLong && lit.Value == math.MaxInt32+1 {
+		return builtin.IntegerType, nil
+	}
 	t, err := n.Expression.Accept(v)
 	if err != nil {
 		return nil, err
 	}
-	if t != builtin.IntegerType {
-		v.AddError(fmt.Sprintf("expression <%s> must be Integer", t.(*ast.ClassType).String()), n.Expression)
+	classType := t.(*ast.ClassType)
+	switch n.Op {
+	case "!":
+		if classType != builtin.BooleanType {
+			v.AddError(fmt.Sprintf("expression <%s> must be Boolean", classType.String()), n.Expression)
+		}
+		return builtin.BooleanType, nil
+	case "+", "-":
+		if !builtin.IsNumeric(classType) {
+			v.AddError(fmt.Sprintf("expression <%s> must be Integer, Long, Double or Decimal", classType.String()), n.Expression)
+		}
+		return classType, nil
 	}
-	return nil, nil
+	if !isIntegral(classType) {
+		v.AddError(fmt.Sprintf("expression <%s> must be Integer or Long", classType.String()), n.Expression)
+	}
+	return classType, nil
 }
 
 func (v *TypeChecker) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, error) {
@@ -511,7 +548,13 @@ func (v *TypeChecker) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 		n.Op == "+=" ||
 		n.Op == "-=" ||
 		n.Op == "*=" ||
-		n.Op == "/=" {
+		n.Op == "/=" ||
+		n.Op == "&=" ||
+		n.Op == "|=" ||
+		n.Op == "^=" ||
+		n.Op == "<<=" ||
+		n.Op == ">>=" ||
+		n.Op == ">>>=" {
 
 		var l *ast.ClassType
 		resolver := NewTypeResolver(v.Context)
@@ -539,46 +582,64 @@ func (v *TypeChecker) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 			}
 			l = left.(*ast.ClassType)
 		}
-		if r != nil && !builtin.Equals(l, r.(*ast.ClassType)) {
-			v.AddError(fmt.Sprintf("Illegal assignment from %s to %s", r.(*ast.ClassType).String(), l.String()), n.Left)
-		}
-		if soql, ok := n.Right.(*ast.Soql); ok {
+		if soql, ok := n.Right.(*ast.Soql); ok && r != nil {
 			if l.SuperClass == builtin.SObjectType {
 				soql.ExactlyOne = true
+				r = r.(*ast.ClassType).Generics[0]
 			}
 		}
+		if r != nil && !builtin.Equals(l, r.(*ast.ClassType)) {
+			v.AddError(fmt.Sprintf("Illegal assignment from %s to %s", r.(*ast.ClassType).String(), l.String()), n.Left)
+		}
+		if n.Op == "=" && r != nil {
+			n.Right = widen(l, n.Right, r.(*ast.ClassType))
+		}
 		return l, nil
 	} else {
 		l, err := n.Left.Accept(v)
 		if err != nil {
 			return nil, err
 		}
+		lType := l.(*ast.ClassType)
+		rType := r.(*ast.ClassType)
 		if n.Op == "+" {
-			if l != builtin.IntegerType && l != builtin.StringType && l != builtin.DoubleType {
-				v.AddError(fmt.Sprintf("expression <%s> must be Integer, String or Double", l.(*ast.ClassType).String()), n.Left)
+			if !builtin.IsNumeric(lType) && lType != builtin.StringType {
+				v.AddError(fmt.Sprintf("expression <%s> must be Integer, Long, Double, Decimal or String", lType.String()), n.Left)
 			}
-			if (l == builtin.StringType || r == builtin.StringType) && l != r {
-				v.AddError(fmt.Sprintf("expression <%s> does not match <%s>", l.(*ast.ClassType).String(), r.(*ast.ClassType).String()), n.Left)
+			if (lType == builtin.StringType || rType == builtin.StringType) && lType != rType {
+				v.AddError(fmt.Sprintf("expression <%s> does not match <%s>", lType.String(), rType.String()), n.Left)
 			}
-			if l == builtin.DoubleType || r == builtin.DoubleType {
-				return builtin.DoubleType, nil
-			} else if l == builtin.StringType {
+			if lType == builtin.StringType {
 				return builtin.StringType, nil
-			} else {
-				return builtin.IntegerType, nil
 			}
+			return builtin.NumericType(lType, rType), nil
 		}
 		if n.Op == "-" || n.Op == "*" || n.Op == "/" || n.Op == "%" {
-			if l != builtin.IntegerType && l != builtin.DoubleType {
-				v.AddError(fmt.Sprintf("expression <%s> must be Integer or Double", l.(*ast.ClassType).String()), n.Left)
-			} else if r != builtin.IntegerType && r != builtin.DoubleType {
-				v.AddError(fmt.Sprintf("expression <%s> must be Integer or Double", r.(*ast.ClassType).String()), n.Right)
+			if !builtin.IsNumeric(lType) {
+				v.AddError(fmt.Sprintf("expression <%s> must be Integer, Long, Double or Decimal", lType.String()), n.Left)
+			} else if !builtin.IsNumeric(rType) {
+				v.AddError(fmt.Sprintf("expression <%s> must be Integer, Long, Double or Decimal", rType.String()), n.Right)
+			}
+			return builtin.NumericType(lType, rType), nil
+		}
+		if n.Op == "&" || n.Op == "|" || n.Op == "^" {
+			if lType == builtin.BooleanType && rType == builtin.BooleanType {
+				return builtin.BooleanType, nil
 			}
-			if l == builtin.DoubleType || r == builtin.DoubleType {
-				return builtin.DoubleType, nil
-			} else {
-				return builtin.IntegerType, nil
+			if !isIntegral(lType) {
+				v.AddError(fmt.Sprintf("expression <%s> must be Integer or Long", lType.String()), n.Left)
+			} else if !isIntegral(rType) {
+				v.AddError(fmt.Sprintf("expression <%s> must be Integer or Long", rType.String()), n.Right)
 			}
+			return builtin.NumericType(lType, rType), nil
+		}
+		if n.Op == "<<" || n.Op == ">>" || n.Op == ">>>" {
+			if !isIntegral(lType) {
+				v.AddError(fmt.Sprintf("expression <%s> must be Integer or Long", lType.String()), n.Left)
+			} else if !isIntegral(rType) {
+				v.AddError(fmt.Sprintf("expression <%s> must be Integer or Long", rType.String()), n.Right)
+			}
+			return lType, nil
 		}
 		if n.Op == "==" || n.Op == "!=" || n.Op == "<" || n.Op == "<=" || n.Op == ">" || n.Op == ">=" || n.Op == "&&" || n.Op == "||" {
 			return builtin.BooleanType, nil
@@ -615,6 +676,7 @@ func (v *TypeChecker) VisitReturn(n *ast.Return) (interface{}, error) {
 	if !builtin.Equals(retType, exp.(*ast.ClassType)) {
 		v.AddError(fmt.Sprintf("return type <%s> does not match %v", exp.(*ast.ClassType).String(), retType.String()), n.Expression)
 	}
+	n.Expression = widen(retType, n.Expression, exp.(*ast.ClassType))
 	return exp, nil
 }
 
@@ -625,15 +687,8 @@ func (v *TypeChecker) VisitThrow(n *ast.Throw) (interface{}, error) {
 	}
 	// Check Subclass of Exception
 	baseClass := r.(*ast.ClassType)
-	super := baseClass.SuperClass
-	if super == nil {
+	if baseClass == builtin.NullType || !builtin.Equals(builtin.ExceptionType, baseClass) {
 		v.AddError(fmt.Sprintf("Throw expression must be of type exception: %s", baseClass.String()), n)
-	} else {
-		if err != nil {
-			v.AddError(err.Error(), n)
-		} else if super != builtin.ExceptionType {
-			v.AddError(fmt.Sprintf("Throw expression must be of type exception: %s", baseClass.String()), n)
-		}
 	}
 	return nil, nil
 }
@@ -644,10 +699,7 @@ func (v *TypeChecker) VisitSoql(n *ast.Soql) (interface{}, error) {
 	if err != nil {
 		return nil, v.compileError(err.Error(), n)
 	}
-	return &ast.ClassType{
-		Name:     "List",
-		Generics: []*ast.ClassType{t},
-	}, nil
+	return builtin.CreateListType(t), nil
 }
 
 func (v *TypeChecker) VisitSosl(n *ast.Sosl) (interface{}, error) {
@@ -663,18 +715,110 @@ func (v *TypeChecker) VisitSwitch(n *ast.Switch) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	expType := exp.(*ast.ClassType)
+	isSObject := expType == builtin.SObjectType || expType.SuperClass == builtin.SObjectType
+	isEnum := builtin.IsEnum(expType)
+	if !isSObject && !isEnum &&
+		expType != builtin.IntegerType &&
+		expType != builtin.LongType &&
+		expType != builtin.StringType {
+		v.AddError(fmt.Sprintf("switch expression <%s> must be Integer, Long, String, SObject or Enum", expType.String()), n.Expression)
+		return nil, nil
+	}
+	values := map[string]struct{}{}
 	for _, w := range n.WhenStatements {
-		t, err := w.Accept(v)
+		var whenType *ast.WhenType
+		for _, c := range w.Condition {
+			key, ok := v.checkWhenValue(c, expType)
+			if !ok {
+				continue
+			}
+			if _, ok := values[key]; ok {
+				v.AddError(fmt.Sprintf("duplicate when value <%s>", key), c)
+				continue
+			}
+			values[key] = struct{}{}
+			if t, ok := c.(*ast.WhenType); ok {
+				whenType = t
+			}
+		}
+		_, err := v.NewEnv(func() (interface{}, error) {
+			// when Account a { ... } declares typed variable in the branch
+			if whenType != nil {
+				v.Context.Env.Set(whenType.Identifier, whenType.Type)
+			}
+			return w.Statements.Accept(v)
+		})
 		if err != nil {
 			return nil, err
 		}
-		if t != exp {
-			// v.Errors
-		}
+	}
+	if n.ElseStatement != nil {
+		return n.ElseStatement.Accept(v)
 	}
 	return nil, nil
 }
 
+// checkWhenValue checks the value of when clause is compatible with switch expression,
+// and returns the key to detect duplicate values
+func (v *TypeChecker) checkWhenValue(c ast.Node, expType *ast.ClassType) (string, bool) {
+	isSObject := expType == builtin.SObjectType || expType.SuperClass == builtin.SObjectType
+	switch n := c.(type) {
+	case *ast.NullLiteral:
+		return "null", true
+	case *ast.WhenType:
+		if !isSObject {
+			v.AddError(fmt.Sprintf("when type <%s> requires SObject expression", n.Type.String()), c)
+			return "", false
+		}
+		if n.Type.SuperClass != builtin.SObjectType {
+			v.AddError(fmt.Sprintf("when type <%s> must be SObject type", n.Type.String()), c)
+			return "", false
+		}
+		if expType != builtin.SObjectType && expType != n.Type {
+			v.AddError(fmt.Sprintf("when type <%s> is not compatible with <%s>", n.Type.String(), expType.String()), c)
+			return "", false
+		}
+		return n.Type.String(), true
+	case *ast.Name:
+		// enum constant, such as `when WINTER`
+		if !builtin.IsEnum(expType) {
+			v.AddError(fmt.Sprintf("when value %s must be literal", n.Value[0]), c)
+			return "", false
+		}
+		value, ok := builtin.EnumValueOf(expType, n.Value[0])
+		if !ok {
+			v.AddError(fmt.Sprintf("%s is not a constant of %s", n.Value[0], expType.String()), c)
+			return "", false
+		}
+		return value.StringValue(), true
+	}
+	if builtin.IsEnum(expType) {
+		v.AddError(fmt.Sprintf("when value must be a constant of %s", expType.String()), c)
+		return "", false
+	}
+	if isSObject {
+		v.AddError(fmt.Sprintf("when value of <%s> must be SObject type or null", expType.String()), c)
+		return "", false
+	}
+	t, err := c.Accept(v)
+	if err != nil {
+		return "", false
+	}
+	valueType := t.(*ast.ClassType)
+	if valueType != expType && !(expType == builtin.LongType && valueType == builtin.IntegerType) {
+		v.AddError(fmt.Sprintf("when value <%s> is not compatible with <%s>", valueType.String(), expType.String()), c)
+		return "", false
+	}
+	switch n := c.(type) {
+	case *ast.IntegerLiteral:
+		return strconv.Itoa(n.Value), true
+	case *ast.StringLiteral:
+		return "'" + n.Value + "'", true
+	}
+	return "", false
+}
+
 func (v *TypeChecker) VisitTrigger(n *ast.Trigger) (interface{}, error) {
 	panic("Not pass")
 	return nil, nil
@@ -696,14 +840,18 @@ func (v *TypeChecker) VisitVariableDeclaration(n *ast.VariableDeclaration) (inte
 			continue
 		}
 		v.Context.Env.Set(d.Name, n.Type)
-		if !builtin.Equals(n.Type, t.(*ast.ClassType)) {
-			v.AddError(fmt.Sprintf("Illegal assignment from %s to %s", t.(*ast.ClassType).String(), n.Type.String()), n)
-		}
 		if soql, ok := d.Expression.(*ast.Soql); ok {
 			if n.Type.SuperClass == builtin.SObjectType {
 				soql.ExactlyOne = true
+				t = t.(*ast.ClassType).Generics[0]
 			}
 		}
+		if !builtin.Equals(n.Type, t.(*ast.ClassType)) {
+			v.AddError(fmt.Sprintf("Illegal assignment from %s to %s", t.(*ast.ClassType).String(), n.Type.String()), n)
+		}
+		if d.Expression != nil {
+			d.Expression = widen(n.Type, d.Expression, t.(*ast.ClassType))
+		}
 	}
 	return nil, nil
 }
@@ -767,7 +915,11 @@ func (v *TypeChecker) VisitFieldAccess(n *ast.FieldAccess) (interface{}, error)
 
 func (v *TypeChecker) VisitType(n *ast.TypeRef) (interface{}, error) {
 	resolver := NewTypeResolver(v.Context)
-	return resolver.ConvertType(n)
+	_, err := resolver.ConvertType(n)
+	if err != nil {
+		return nil, err
+	}
+	return builtin.TypeType, nil
 }
 
 func (v *TypeChecker) VisitBlock(n *ast.Block) (interface{}, error) {
@@ -976,4 +1128,33 @@ var reg = regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9_]*$")
 
 func isInvalidIdentifier(name string) bool {
 	return !reg.MatchString(name)
-}
\ No newline at end of file
+}
+
+// widen wraps the numeric expression with cast to the wider type, such as Integer assigned to Decimal,
+// the interpreter converts the value on the cast
+func widen(classType *ast.ClassType, exp ast.Node, expType *ast.ClassType) ast.Node {
+	if !builtin.IsNumericWidening(classType, expType) {
+		return exp
+	}
+	return &ast.CastExpression{
+		CastType:   classType,
+		Expression: exp,
+		Location:   exp.GetLocation(),
+		Parent:     exp.GetParent(),
+	}
+}
+
+// widenParameters widens the parameters to the types of the method parameters
+func widenParameters(receiverType *ast.ClassType, method *ast.Method, parameters []ast.Node, types []*ast.ClassType) {
+	if len(method.Parameters) != len(parameters) {
+		return
+	}
+	for i, p := range method.Parameters {
+		paramType := builtin.ResolveReturnType(receiverType, p.Type)
+		parameters[i] = widen(paramType, parameters[i], types[i])
+	}
+}
+
+func isIntegral(classType *ast.ClassType) bool {
+	return classType == builtin.IntegerType || classType == builtin.LongType
+}
@@ -294,13 +294,13 @@ func TestTypeChecker(t *testing.T) {
 			}),
 			[]*Error{
 				{
-					Message: "expression <Boolean> must be Integer",
+					Message: "expression <Boolean> must be Integer or Long",
 				},
 				{
-					Message: "expression <Double> must be Integer",
+					Message: "expression <Double> must be Integer or Long",
 				},
 				{
-					Message: "expression <String> must be Integer",
+					Message: "expression <String> must be Integer or Long",
 				},
 			},
 		},
@@ -430,22 +430,22 @@ func TestTypeChecker(t *testing.T) {
 			}),
 			[]*Error{
 				{
-					Message: "expression <Boolean> must be Integer, String or Double",
+					Message: "expression <Boolean> must be Integer, Long, Double, Decimal or String",
 				},
 				{
 					Message: "expression <String> does not match <Integer>",
 				},
 				{
-					Message: "expression <String> must be Integer or Double",
+					Message: "expression <String> must be Integer, Long, Double or Decimal",
 				},
 				{
-					Message: "expression <String> must be Integer or Double",
+					Message: "expression <String> must be Integer, Long, Double or Decimal",
 				},
 				{
-					Message: "expression <String> must be Integer or Double",
+					Message: "expression <String> must be Integer, Long, Double or Decimal",
 				},
 				{
-					Message: "expression <String> must be Integer or Double",
+					Message: "expression <String> must be Integer, Long, Double or Decimal",
 				},
 			},
 		},
@@ -658,3 +658,66 @@ func newTestClassType(classType *ast.ClassType) *ast.ClassType {
 	}
 	return classType
 }
+
+func TestSwitchWhenValues(t *testing.T) {
+	season := builtin.NewEnumType("Season", []string{"WINTER", "SPRING"})
+	account := &ast.ClassType{Name: "Account", SuperClass: builtin.SObjectType}
+	contact := &ast.ClassType{Name: "Contact", SuperClass: builtin.SObjectType}
+	integer := func(value int) ast.Node {
+		return &ast.IntegerLiteral{Value: value}
+	}
+	name := func(value string) ast.Node {
+		return &ast.Name{Value: []string{value}}
+	}
+	whenType := func(classType *ast.ClassType) ast.Node {
+		return &ast.WhenType{Type: classType, Identifier: "r"}
+	}
+	testCases := []struct {
+		Type     *ast.ClassType
+		Values   [][]ast.Node
+		Expected []string
+	}{
+		{builtin.IntegerType, [][]ast.Node{{integer(1), integer(-5)}, {integer(5), &ast.NullLiteral{}}}, []string{}},
+		{builtin.IntegerType, [][]ast.Node{{integer(-5)}, {integer(2), integer(-5)}}, []string{"duplicate when value <-5>"}},
+		{builtin.IntegerType, [][]ast.Node{{integer(-2147483649)}}, []string{"Illegal integer: -2147483649"}},
+		{builtin.IntegerType, [][]ast.Node{{&ast.IntegerLiteral{Value: 1, IsLong: true}}}, []string{"when value <Long> is not compatible with <Integer>"}},
+		{builtin.LongType, [][]ast.Node{{integer(1)}, {&ast.IntegerLiteral{Value: 1, IsLong: true}}}, []string{"duplicate when value <1>"}},
+		{builtin.StringType, [][]ast.Node{{&ast.StringLiteral{Value: "a"}, integer(1)}, {&ast.StringLiteral{Value: "a"}}}, []string{
+			"when value <Integer> is not compatible with <String>",
+			"duplicate when value <'a'>",
+		}},
+		{builtin.StringType, [][]ast.Node{{&ast.NullLiteral{}}, {&ast.NullLiteral{}}}, []string{"duplicate when value <null>"}},
+		{builtin.StringType, [][]ast.Node{{name("WINTER")}}, []string{"when value WINTER must be literal"}},
+		{season, [][]ast.Node{{name("WINTER"), name("spring")}, {name("Winter")}}, []string{"duplicate when value <WINTER>"}},
+		{season, [][]ast.Node{{name("FALL"), &ast.StringLiteral{Value: "WINTER"}}}, []string{
+			"FALL is not a constant of Season",
+			"when value must be a constant of Season",
+		}},
+		{builtin.SObjectType, [][]ast.Node{{whenType(account)}, {whenType(contact)}, {whenType(account)}}, []string{"duplicate when value <Account>"}},
+		{builtin.SObjectType, [][]ast.Node{{integer(1)}, {whenType(builtin.StringType)}}, []string{
+			"when value of <SObject> must be SObject type or null",
+			"when type <String> must be SObject type",
+		}},
+		{account, [][]ast.Node{{whenType(contact)}}, []string{"when type <Contact> is not compatible with <Account>"}},
+		{builtin.IntegerType, [][]ast.Node{{whenType(account)}}, []string{"when type <Account> requires SObject expression"}},
+	}
+	for i, testCase := range testCases {
+		checker := NewTypeChecker()
+		checker.Context.Env.Set("v", testCase.Type)
+		n := &ast.Switch{Expression: name("v"), WhenStatements: []*ast.When{}}
+		for _, values := range testCase.Values {
+			n.WhenStatements = append(n.WhenStatements, &ast.When{Condition: values, Statements: &ast.Block{Statements: []ast.Node{}}})
+		}
+		if _, err := checker.VisitSwitch(n); err != nil {
+			t.Errorf("%d: unexpected error raised: %s", i, err.Error())
+			continue
+		}
+		messages := make([]string, len(checker.Errors))
+		for j, err := range checker.Errors {
+			messages[j] = err.Message
+		}
+		if strings.Join(messages, ", ") != strings.Join(testCase.Expected, ", ") {
+			t.Errorf("%d: expected %v, actual %v", i, testCase.Expected, messages)
+		}
+	}
+}
@@ -19,6 +19,10 @@ func NewTypeRefResolver(classTypes *ast.ClassMap, nameSpaceStore *builtin.NameSp
 }
 
 func (v *TypeRefResolver) Resolve(n *ast.ClassType) (*ast.ClassType, error) {
+	// enum has no type reference to resolve
+	if builtin.IsEnum(n) {
+		return n, nil
+	}
 	if n.SuperClassRef != nil {
 		superClass, err := n.SuperClassRef.Accept(v)
 		if err != nil {
@@ -71,25 +75,31 @@ func (v *TypeRefResolver) Resolve(n *ast.ClassType) (*ast.ClassType, error) {
 	for _, m := range n.Constructors {
 		if m.ReturnTypeRef != nil {
 			retType, err := m.ReturnTypeRef.Accept(v)
-			m.ReturnType = retType.(*ast.ClassType)
 			if err != nil {
 				return nil, err
 			}
+			m.ReturnType = retType.(*ast.ClassType)
 		}
 		for _, param := range m.Parameters {
-			param.Accept(v)
+			_, err := param.Accept(v)
+			if err != nil {
+				return nil, err
+			}
+		}
+		_, err := m.Statements.Accept(v)
+		if err != nil {
+			return nil, err
 		}
-		m.Statements.Accept(v)
 	}
 
 	for _, methods := range n.InstanceMethods.All() {
 		for _, m := range methods {
 			if m.ReturnTypeRef != nil {
 				retType, err := m.ReturnTypeRef.Accept(v)
-				m.ReturnType = retType.(*ast.ClassType)
 				if err != nil {
 					return nil, err
 				}
+				m.ReturnType = retType.(*ast.ClassType)
 			}
 			for _, param := range m.Parameters {
 				_, err := param.Accept(v)
@@ -110,10 +120,10 @@ func (v *TypeRefResolver) Resolve(n *ast.ClassType) (*ast.ClassType, error) {
 		for _, m := range methods {
 			if m.ReturnTypeRef != nil {
 				retType, err := m.ReturnTypeRef.Accept(v)
-				m.ReturnType = retType.(*ast.ClassType)
 				if err != nil {
 					return nil, err
 				}
+				m.ReturnType = retType.(*ast.ClassType)
 			}
 			for _, param := range m.Parameters {
 				_, err := param.Accept(v)
@@ -146,16 +156,20 @@ func (v *TypeRefResolver) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration)
 	return ast.VisitInterfaceDeclaration(v, n)
 }
 
+func (v *TypeRefResolver) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	return ast.VisitEnumDeclaration(v, n)
+}
+
 func (v *TypeRefResolver) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return ast.VisitIntegerLiteral(v, n)
 }
 
 func (v *TypeRefResolver) VisitParameter(n *ast.Parameter) (interface{}, error) {
 	classType, err := n.TypeRef.Accept(v)
-	n.Type = classType.(*ast.ClassType)
 	if err != nil {
 		return nil, err
 	}
+	n.Type = classType.(*ast.ClassType)
 	return nil, nil
 }
 
@@ -207,9 +221,11 @@ func (v *TypeRefResolver) VisitTry(n *ast.Try) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
-	_, err = n.FinallyBlock.Accept(v)
-	if err != nil {
-		return nil, err
+	if n.FinallyBlock != nil {
+		_, err = n.FinallyBlock.Accept(v)
+		if err != nil {
+			return nil, err
+		}
 	}
 	for _, c := range n.CatchClause {
 		_, err := c.Accept(v)
@@ -222,10 +238,10 @@ func (v *TypeRefResolver) VisitTry(n *ast.Try) (interface{}, error) {
 
 func (v *TypeRefResolver) VisitCatch(n *ast.Catch) (interface{}, error) {
 	classType, err := n.TypeRef.Accept(v)
-	n.Type = classType.(*ast.ClassType)
 	if err != nil {
 		return nil, err
 	}
+	n.Type = classType.(*ast.ClassType)
 	return n.Block.Accept(v)
 }
 
@@ -318,6 +334,21 @@ func (v *TypeRefResolver) VisitNew(n *ast.New) (interface{}, error) {
 			return nil, err
 		}
 	}
+	if n.Init != nil {
+		for _, r := range n.Init.Records {
+			if _, err := r.Accept(v); err != nil {
+				return nil, err
+			}
+		}
+		for _, entry := range n.Init.Values {
+			if _, err := entry.Key.Accept(v); err != nil {
+				return nil, err
+			}
+			if _, err := entry.Value.Accept(v); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return nil, nil
 }
 
@@ -525,10 +556,10 @@ func (v *TypeRefResolver) VisitGetterSetter(n *ast.GetterSetter) (interface{}, e
 
 func (v *TypeRefResolver) VisitPropertyDeclaration(n *ast.PropertyDeclaration) (interface{}, error) {
 	classType, err := n.TypeRef.Accept(v)
-	n.Type = classType.(*ast.ClassType)
 	if err != nil {
 		return nil, err
 	}
+	n.Type = classType.(*ast.ClassType)
 	return nil, nil
 }
 
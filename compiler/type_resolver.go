@@ -86,6 +86,12 @@ func (r *TypeResolver) ResolveVariable(names []string, checkSetter bool) (*ast.C
 			}
 			return fieldType, nil
 		}
+		if enumType, ok := r.resolveEnumConstant(names); ok {
+			if checkSetter {
+				return nil, fmt.Errorf("Enum constant %s cannot be assigned", strings.Join(names, "."))
+			}
+			return enumType, nil
+		}
 		if v, ok := r.Context.ClassTypes.Get(name); ok {
 			check := false
 			if len(names) == 2 {
@@ -159,6 +165,10 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.ClassType
 		if v, ok := r.Context.Env.Get("this"); ok {
 			classType, method, err := FindInstanceMethod(v, methodName, parameters, MODIFIER_ALL_OK)
 			if err != nil {
+				// static method of current class can be called without class name
+				if classType, method, staticErr := FindStaticMethod(v, methodName, parameters, MODIFIER_ALL_OK); staticErr == nil {
+					return classType, method, nil
+				}
 				return nil, nil, err
 			}
 			if method == nil {
@@ -192,6 +202,9 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.ClassType
 			}
 			return FindInstanceMethod(fieldType, methodName, parameters, allowedModifier)
 		}
+		if enumType, ok := r.resolveEnumConstant(names[:len(names)-1]); ok {
+			return FindInstanceMethod(enumType, methodName, parameters, MODIFIER_PUBLIC_ONLY)
+		}
 		if len(names) == 2 {
 			if v, ok := r.Context.ClassTypes.Get(first); ok {
 				return FindStaticMethod(v, methodName, parameters, MODIFIER_PUBLIC_ONLY)
@@ -233,10 +246,29 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.ClassType
 				}
 			}
 		}
+		// static method of inner class, such as Inner.method() or Outer.Inner.method()
+		if classType, err := r.ResolveType(names[:len(names)-1]); err == nil {
+			return FindStaticMethod(classType, methodName, parameters, MODIFIER_PUBLIC_ONLY)
+		}
 	}
 	return nil, nil, errors.Errorf("%s is not found in this scope", strings.Join(names, "."))
 }
 
+// resolveEnumConstant returns the enum type if the names refer to its constant, such as Season.WINTER or Outer.Season.WINTER
+func (r *TypeResolver) resolveEnumConstant(names []string) (*ast.ClassType, bool) {
+	if len(names) < 2 {
+		return nil, false
+	}
+	classType, err := r.ResolveType(names[:len(names)-1])
+	if err != nil {
+		return nil, false
+	}
+	if _, ok := builtin.EnumValueOf(classType, names[len(names)-1]); !ok {
+		return nil, false
+	}
+	return classType, true
+}
+
 func (r *TypeResolver) ResolveType(names []string) (*ast.ClassType, error) {
 	return r.resolver.ResolveType(names)
 }
@@ -0,0 +1,311 @@
+package interpreter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+// arithmetic evaluates + - * / of numbers, the operands are widened to the wider type.
+// + of String concatenates the operands.
+func arithmetic(op string, lObj, rObj *ast.Object) (*ast.Object, error) {
+	if op == "+" && lObj.ClassType == builtin.StringType {
+		return builtin.NewString(lObj.StringValue() + builtin.String(rObj)), nil
+	}
+	if lObj == builtin.Null || rObj == builtin.Null {
+		return nil, builtin.NewNullPointerException(op)
+	}
+	if !builtin.IsNumeric(lObj.ClassType) || !builtin.IsNumeric(rObj.ClassType) {
+		return nil, operandTypeError(op, lObj, rObj)
+	}
+	switch builtin.NumericType(lObj.ClassType, rObj.ClassType) {
+	case builtin.DecimalType:
+		l, err := builtin.ToDecimal(lObj)
+		if err != nil {
+			return nil, err
+		}
+		r, err := builtin.ToDecimal(rObj)
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case "+":
+			return builtin.NewDecimal(l.Add(r)), nil
+		case "-":
+			return builtin.NewDecimal(l.Sub(r)), nil
+		case "*":
+			return builtin.NewDecimal(l.Mul(r)), nil
+		case "/":
+			d, err := l.Divide(r)
+			if err != nil {
+				return nil, divideByZero()
+			}
+			return builtin.NewDecimal(d), nil
+		}
+	case builtin.DoubleType:
+		l, r := toFloat(lObj), toFloat(rObj)
+		switch op {
+		case "+":
+			return builtin.NewDouble(l + r), nil
+		case "-":
+			return builtin.NewDouble(l - r), nil
+		case "*":
+			return builtin.NewDouble(l * r), nil
+		case "/":
+			if r == 0 {
+				return nil, divideByZero()
+			}
+			return builtin.NewDouble(l / r), nil
+		}
+	case builtin.LongType, builtin.IntegerType:
+		l := lObj.IntegerValue()
+		r := rObj.IntegerValue()
+		var value int
+		switch op {
+		case "+":
+			value = l + r
+		case "-":
+			value = l - r
+		case "*":
+			value = l * r
+		case "/":
+			if r == 0 {
+				return nil, divideByZero()
+			}
+			value = l / r
+		}
+		if builtin.NumericType(lObj.ClassType, rObj.ClassType) == builtin.LongType {
+			return builtin.NewLong(value), nil
+		}
+		return builtin.NewInteger(value), nil
+	}
+	return nil, operandTypeError(op, lObj, rObj)
+}
+
+// bitwise evaluates & | ^ of Integer, Long or Boolean, and shift operators << >> >>> of Integer or Long.
+// Shift distance is masked by 31 for Integer and 63 for Long as the JVM does.
+func bitwise(op string, lObj, rObj *ast.Object) (*ast.Object, error) {
+	if lObj == builtin.Null || rObj == builtin.Null {
+		return nil, builtin.NewNullPointerException(op)
+	}
+	if lObj.ClassType == builtin.BooleanType && rObj.ClassType == builtin.BooleanType {
+		l := lObj.BoolValue()
+		r := rObj.BoolValue()
+		switch op {
+		case "&":
+			return builtin.NewBoolean(l && r), nil
+		case "|":
+			return builtin.NewBoolean(l || r), nil
+		case "^":
+			return builtin.NewBoolean(l != r), nil
+		}
+		return nil, operandTypeError(op, lObj, rObj)
+	}
+	if !isIntegral(lObj.ClassType) || !isIntegral(rObj.ClassType) {
+		return nil, operandTypeError(op, lObj, rObj)
+	}
+	l := lObj.IntegerValue()
+	r := rObj.IntegerValue()
+	switch op {
+	case "&", "|", "^":
+		var value int
+		switch op {
+		case "&":
+			value = l & r
+		case "|":
+			value = l | r
+		default:
+			value = l ^ r
+		}
+		if builtin.NumericType(lObj.ClassType, rObj.ClassType) == builtin.LongType {
+			return builtin.NewLong(value), nil
+		}
+		return builtin.NewInteger(value), nil
+	}
+	if lObj.ClassType == builtin.LongType {
+		shift := uint(r & 63)
+		switch op {
+		case "<<":
+			return builtin.NewLong(l << shift), nil
+		case ">>":
+			return builtin.NewLong(l >> shift), nil
+		case ">>>":
+			return builtin.NewLong(int(int64(uint64(l) >> shift))), nil
+		}
+		return nil, operandTypeError(op, lObj, rObj)
+	}
+	shift := uint(r & 31)
+	switch op {
+	case "<<":
+		return builtin.NewInteger(int(int32(l) << shift)), nil
+	case ">>":
+		return builtin.NewInteger(int(int32(l) >> shift)), nil
+	case ">>>":
+		return builtin.NewInteger(int(int32(uint32(l) >> shift))), nil
+	}
+	return nil, operandTypeError(op, lObj, rObj)
+}
+
+func isIntegral(t *ast.ClassType) bool {
+	return t == builtin.IntegerType || t == builtin.LongType
+}
+
+// negate evaluates unary - of numbers
+func negate(obj *ast.Object) (*ast.Object, error) {
+	if obj == builtin.Null {
+		return nil, builtin.NewNullPointerException("-")
+	}
+	switch obj.ClassType {
+	case builtin.DecimalType:
+		return builtin.NewDecimal(builtin.DecimalValue(obj).Neg()), nil
+	case builtin.DoubleType:
+		return builtin.NewDouble(-obj.DoubleValue()), nil
+	case builtin.LongType:
+		return builtin.NewLong(-obj.IntegerValue()), nil
+	case builtin.IntegerType:
+		return builtin.NewInteger(-obj.IntegerValue()), nil
+	}
+	return nil, operandTypeError("-", obj)
+}
+
+// complement evaluates unary ~ of Integer or Long
+func complement(obj *ast.Object) (*ast.Object, error) {
+	if obj == builtin.Null {
+		return nil, builtin.NewNullPointerException("~")
+	}
+	switch obj.ClassType {
+	case builtin.LongType:
+		return builtin.NewLong(^obj.IntegerValue()), nil
+	case builtin.IntegerType:
+		return builtin.NewInteger(^obj.IntegerValue()), nil
+	}
+	return nil, operandTypeError("~", obj)
+}
+
+func divideByZero() error {
+	return builtin.NewExceptionError(builtin.NewException(builtin.MathExceptionType, "Divide by 0"))
+}
+
+// operandTypeError raises TypeException for operands which the type checker can not reject, such as values of Object variables
+func operandTypeError(op string, operands ...*ast.Object) error {
+	names := make([]string, len(operands))
+	for i, operand := range operands {
+		names[i] = operand.ClassType.Name
+	}
+	message := fmt.Sprintf("Invalid operand types for %s: %s", op, strings.Join(names, ", "))
+	return builtin.NewExceptionError(builtin.NewException(builtin.TypeExceptionType, message))
+}
+
+// compare compares the operands of < > <= >=, that are numbers, Strings, Dates, Datetimes or Times.
+// It returns false if the result is false for any operator, that is the case of null operand except String.
+// Strings are compared case-insensitively, and a non-null String is greater than null.
+func compare(op string, lObj, rObj *ast.Object) (int, bool, error) {
+	if lObj == builtin.Null || rObj == builtin.Null {
+		switch {
+		case lObj.ClassType == builtin.StringType:
+			return 1, true, nil
+		case rObj.ClassType == builtin.StringType:
+			return -1, true, nil
+		}
+		return 0, false, nil
+	}
+	if c, ok := compareNumbers(lObj, rObj); ok {
+		return c, true, nil
+	}
+	if lObj.ClassType == builtin.StringType && rObj.ClassType == builtin.StringType {
+		return strings.Compare(strings.ToLower(lObj.StringValue()), strings.ToLower(rObj.StringValue())), true, nil
+	}
+	if lObj.ClassType == rObj.ClassType {
+		l, lok := lObj.Extra["value"].(time.Time)
+		r, rok := rObj.Extra["value"].(time.Time)
+		if lok && rok {
+			switch {
+			case l.Before(r):
+				return -1, true, nil
+			case l.After(r):
+				return 1, true, nil
+			}
+			return 0, true, nil
+		}
+	}
+	return 0, false, operandTypeError(op, lObj, rObj)
+}
+
+// compareNumbers compares two numbers with widening, returns false if either operand is not a number
+func compareNumbers(lObj, rObj *ast.Object) (int, bool) {
+	if !builtin.IsNumeric(lObj.ClassType) || !builtin.IsNumeric(rObj.ClassType) {
+		return 0, false
+	}
+	switch builtin.NumericType(lObj.ClassType, rObj.ClassType) {
+	case builtin.DecimalType:
+		return builtin.CompareDecimal(lObj, rObj), true
+	case builtin.DoubleType:
+		l := toFloat(lObj)
+		r := toFloat(rObj)
+		switch {
+		case l < r:
+			return -1, true
+		case l > r:
+			return 1, true
+		}
+		return 0, true
+	}
+	l := lObj.IntegerValue()
+	r := rObj.IntegerValue()
+	switch {
+	case l < r:
+		return -1, true
+	case l > r:
+		return 1, true
+	}
+	return 0, true
+}
+
+// convertNumber converts the number to the class type for widening cast, infinity and NaN of Double can not be converted to Decimal
+func convertNumber(obj *ast.Object, classType *ast.ClassType) (*ast.Object, error) {
+	if obj == builtin.Null || obj.ClassType == classType {
+		return obj, nil
+	}
+	switch classType {
+	case builtin.DecimalType:
+		d, err := builtin.ToDecimal(obj)
+		if err != nil {
+			return nil, err
+		}
+		return builtin.NewDecimal(d), nil
+	case builtin.DoubleType:
+		return builtin.NewDouble(toFloat(obj)), nil
+	case builtin.LongType:
+		if d, ok := obj.Value().(*builtin.Decimal); ok {
+			return builtin.NewLong(d.Int()), nil
+		}
+		if f, ok := obj.Value().(float64); ok {
+			return builtin.NewLong(int(f)), nil
+		}
+		return builtin.NewLong(obj.IntegerValue()), nil
+	case builtin.IntegerType:
+		if d, ok := obj.Value().(*builtin.Decimal); ok {
+			return builtin.NewInteger(d.Int()), nil
+		}
+		if f, ok := obj.Value().(float64); ok {
+			return builtin.NewInteger(int(f)), nil
+		}
+		return builtin.NewInteger(obj.IntegerValue()), nil
+	}
+	return obj, nil
+}
+
+// toFloat converts the number to float64, callers check that the object is a number with builtin.IsNumeric
+func toFloat(obj *ast.Object) float64 {
+	switch v := obj.Value().(type) {
+	case int:
+		return float64(v)
+	case *builtin.Decimal:
+		return v.Float64()
+	}
+	f, _ := obj.Value().(float64)
+	return f
+}
@@ -0,0 +1,110 @@
+package interpreter
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+func TestArithmetic(t *testing.T) {
+	testCases := []struct {
+		Op       string
+		Left     *ast.Object
+		Right    *ast.Object
+		Expected *ast.Object
+		Error    string
+	}{
+		{"+", builtin.NewInteger(1), builtin.NewInteger(2), builtin.NewInteger(3), ""},
+		{"-", builtin.NewLong(1), builtin.NewInteger(2), builtin.NewLong(-1), ""},
+		{"*", builtin.NewInteger(2), builtin.NewDouble(1.5), builtin.NewDouble(3), ""},
+		{"+", builtin.NewString("a"), builtin.NewInteger(1), builtin.NewString("a1"), ""},
+		{"/", builtin.NewInteger(1), builtin.NewInteger(0), nil, "MathException: Divide by 0"},
+		{"/", builtin.NewDouble(1), builtin.NewInteger(0), nil, "MathException: Divide by 0"},
+		{"+", builtin.NewDecimal(builtin.DecimalFromInt(1)), builtin.NewDouble(math.Inf(1)), nil, "TypeException: Invalid decimal: Infinity"},
+		{"+", builtin.NewBoolean(true), builtin.NewInteger(1), nil, "TypeException: Invalid operand types for +: Boolean, Integer"},
+		{"-", builtin.NewInteger(1), builtin.NewString("a"), nil, "TypeException: Invalid operand types for -: Integer, String"},
+		{"+", builtin.Null, builtin.NewInteger(1), nil, "null pointer exception"},
+	}
+	for i, testCase := range testCases {
+		actual, err := arithmetic(testCase.Op, testCase.Left, testCase.Right)
+		assertOperatorResult(t, i, testCase.Expected, testCase.Error, actual, err)
+	}
+}
+
+func TestBitwise(t *testing.T) {
+	testCases := []struct {
+		Op       string
+		Left     *ast.Object
+		Right    *ast.Object
+		Expected *ast.Object
+		Error    string
+	}{
+		{"&", builtin.NewInteger(6), builtin.NewInteger(3), builtin.NewInteger(2), ""},
+		{"^", builtin.NewBoolean(true), builtin.NewBoolean(true), builtin.NewBoolean(false), ""},
+		{"<<", builtin.NewInteger(1), builtin.NewInteger(31), builtin.NewInteger(-2147483648), ""},
+		{">>>", builtin.NewLong(-1), builtin.NewInteger(60), builtin.NewLong(15), ""},
+		{"&", builtin.NewBoolean(true), builtin.NewInteger(1), nil, "TypeException: Invalid operand types for &: Boolean, Integer"},
+		{"<<", builtin.NewBoolean(true), builtin.NewBoolean(true), nil, "TypeException: Invalid operand types for <<: Boolean, Boolean"},
+		{"|", builtin.NewDouble(1), builtin.NewInteger(1), nil, "TypeException: Invalid operand types for |: Double, Integer"},
+	}
+	for i, testCase := range testCases {
+		actual, err := bitwise(testCase.Op, testCase.Left, testCase.Right)
+		assertOperatorResult(t, i, testCase.Expected, testCase.Error, actual, err)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	testCases := []struct {
+		Left     *ast.Object
+		Right    *ast.Object
+		Expected int
+		Ok       bool
+		Error    string
+	}{
+		{builtin.NewLong(9223372036854775807), builtin.NewLong(-9223372036854775807), 1, true, ""},
+		{builtin.NewLong(-9223372036854775807), builtin.NewLong(9223372036854775807), -1, true, ""},
+		{builtin.NewInteger(1), builtin.NewDouble(1), 0, true, ""},
+		{builtin.NewString("a"), builtin.NewString("B"), -1, true, ""},
+		{builtin.NewString("ABC"), builtin.NewString("abc"), 0, true, ""},
+		{builtin.NewString("a"), builtin.Null, 1, true, ""},
+		{builtin.Null, builtin.NewString("a"), -1, true, ""},
+		{builtin.Null, builtin.NewInteger(1), 0, false, ""},
+		{builtin.NewInteger(1), builtin.Null, 0, false, ""},
+		{builtin.NewBoolean(true), builtin.NewBoolean(false), 0, false, "TypeException: Invalid operand types for <: Boolean, Boolean"},
+		{builtin.NewString("1"), builtin.NewInteger(1), 0, false, "TypeException: Invalid operand types for <: String, Integer"},
+	}
+	for i, testCase := range testCases {
+		actual, ok, err := compare("<", testCase.Left, testCase.Right)
+		if testCase.Error != "" {
+			if err == nil || err.Error() != testCase.Error {
+				t.Errorf("%d: expected error %s, actual %v", i, testCase.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error %s", i, err.Error())
+			continue
+		}
+		if ok != testCase.Ok || (ok && actual != testCase.Expected) {
+			t.Errorf("%d: expected %d, %t, actual %d, %t", i, testCase.Expected, testCase.Ok, actual, ok)
+		}
+	}
+}
+
+func assertOperatorResult(t *testing.T, i int, expected *ast.Object, expectedError string, actual *ast.Object, err error) {
+	if expectedError != "" {
+		if err == nil || err.Error() != expectedError {
+			t.Errorf("%d: expected error %s, actual %v", i, expectedError, err)
+		}
+		return
+	}
+	if err != nil {
+		t.Errorf("%d: unexpected error %s", i, err.Error())
+		return
+	}
+	if actual.ClassType != expected.ClassType || builtin.String(actual) != builtin.String(expected) {
+		t.Errorf("%d: expected %s, actual %s", i, builtin.String(expected), builtin.String(actual))
+	}
+}
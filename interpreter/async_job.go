@@ -0,0 +1,251 @@
+package interpreter
+
+import (
+	"fmt"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+	"github.com/tzmfreedom/land/compiler"
+)
+
+func (v *Interpreter) Limits() *builtin.Limits {
+	return v.Extra["limits"].(*builtin.Limits)
+}
+
+func (v *Interpreter) Clock() *builtin.Clock {
+	return v.Extra["clock"].(*builtin.Clock)
+}
+
+func (v *Interpreter) AsyncJobs() *builtin.AsyncJobQueue {
+	return v.Extra["async_jobs"].(*builtin.AsyncJobQueue)
+}
+
+// enqueueFuture records @future method call as async job instead of calling it
+func (v *Interpreter) enqueueFuture(receiver interface{}, m *ast.Method, evaluated []*ast.Object, n ast.Node) (interface{}, error) {
+	queue := v.AsyncJobs()
+	if current := queue.Current; current != nil && (current.JobType == builtin.AsyncJobTypeFuture || current.JobType == builtin.AsyncJobTypeBatchApex) {
+		message := fmt.Sprintf("Future method cannot be called from a future or batch method: %s.%s", current.ClassName(), m.Name)
+		return nil, builtin.NewExceptionError(builtin.NewException(builtin.AsyncExceptionType, message))
+	}
+	if e := v.Limits().AddFutureCall(); e != nil {
+		return nil, builtin.NewExceptionError(e)
+	}
+	queue.Enqueue(&builtin.AsyncJob{
+		JobType:    builtin.AsyncJobTypeFuture,
+		Receiver:   receiver,
+		MethodName: m.Name,
+		Parameters: evaluated,
+		Node:       n,
+	})
+	return nil, nil
+}
+
+// RunAsyncJobs runs enqueued jobs until the queue is empty, including jobs chained from running jobs.
+// Failed jobs are recorded on AsyncApexJob, and the first error is returned after all jobs run.
+// Jobs enqueued by a failed job are dropped, since the transaction which enqueued them is rolled back.
+func (v *Interpreter) RunAsyncJobs() error {
+	var firstErr error
+	queue := v.AsyncJobs()
+	for job := queue.Dequeue(); job != nil; job = queue.Dequeue() {
+		err := v.runAsyncJob(job)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// runAsyncJob runs the job in its own transaction with fresh limits and static variables.
+// Batch jobs are run by runBatchJob, with a transaction for each of start, execute and finish.
+func (v *Interpreter) runAsyncJob(job *builtin.AsyncJob) error {
+	queue := v.AsyncJobs()
+	prevJob := queue.Current
+	queue.Current = job
+	defer func() {
+		queue.Current = prevJob
+	}()
+
+	if job.JobType == builtin.AsyncJobTypeBatchApex {
+		return v.runBatchJob(job)
+	}
+	queue.SetStatus(job, "Processing", "")
+	err := v.runTransaction(job.Id, func() error {
+		return v.invokeAsyncJob(job)
+	})
+	if err != nil {
+		job.NumberOfErrors = 1
+		queue.Drop(job.Id)
+		queue.SetStatus(job, "Failed", err.Error())
+		return err
+	}
+	queue.SetStatus(job, "Completed", "")
+	return nil
+}
+
+// runTransaction runs f in its own transaction with fresh limits and static variables.
+// Changes in the transaction are rolled back if f returns error.
+func (v *Interpreter) runTransaction(name string, f func() error) error {
+	prevLimits := v.Extra["limits"]
+	prevStaticField := v.Context.StaticField
+	prevEnv := v.Context.Env
+	v.Extra["limits"] = builtin.NewLimits(true)
+	v.LoadStaticField()
+	defer func() {
+		v.Extra["limits"] = prevLimits
+		v.Context.StaticField = prevStaticField
+		v.Context.Env = prevEnv
+	}()
+
+	if err := builtin.DatabaseDriver.Savepoint(name); err != nil {
+		return err
+	}
+	defer builtin.DatabaseDriver.Release(name)
+	err := f()
+	if err != nil {
+		builtin.DatabaseDriver.RollbackTo(name)
+	}
+	return err
+}
+
+func (v *Interpreter) invokeAsyncJob(job *builtin.AsyncJob) error {
+	_, err := v.callMethod(job.Receiver, job.MethodName, job.Parameters, job.Node)
+	return err
+}
+
+// callMethod calls the method of the receiver by name, receiver is *ast.Object or *ast.ClassType
+func (v *Interpreter) callMethod(receiver interface{}, methodName string, params []*ast.Object, n ast.Node) (*ast.Object, error) {
+	var m *ast.Method
+	var err error
+	switch typedReceiver := receiver.(type) {
+	case *ast.Object:
+		_, m, err = FindInstanceMethod(typedReceiver, methodName, params, compiler.MODIFIER_ALL_OK)
+	case *ast.ClassType:
+		_, m, err = FindStaticMethod(typedReceiver, methodName, params, compiler.MODIFIER_ALL_OK)
+	}
+	if err != nil {
+		return nil, err
+	}
+	r, err := v.invokeMethod(receiver, m, params, n)
+	if err != nil {
+		return nil, err
+	}
+	if obj, ok := r.(*ast.Object); ok {
+		return obj, nil
+	}
+	return builtin.Null, nil
+}
+
+// runBatchJob runs start, execute for each scope and finish of Database.Batchable, each in its own transaction.
+// Failed execute does not stop the batch, it is counted on NumberOfErrors of AsyncApexJob.
+func (v *Interpreter) runBatchJob(job *builtin.AsyncJob) error {
+	queue := v.AsyncJobs()
+	batch := job.Receiver.(*ast.Object)
+	queue.SetStatus(job, "Preparing", "")
+
+	var records []*ast.Object
+	err := v.runBatchTransaction(job, func(ctx *ast.Object) error {
+		r, err := v.callMethod(batch, "start", []*ast.Object{ctx}, job.Node)
+		if err != nil {
+			return err
+		}
+		records, err = v.iterate(r, job.Node)
+		return err
+	})
+	if err != nil {
+		job.NumberOfErrors = 1
+		queue.Drop(job.Id)
+		queue.SetStatus(job, "Failed", err.Error())
+		return err
+	}
+
+	job.TotalJobItems = (len(records) + job.ScopeSize - 1) / job.ScopeSize
+	queue.SetStatus(job, "Processing", "")
+	elementType := builtin.BatchScopeType(batch.ClassType)
+	if elementType == builtin.SObjectType && len(records) > 0 {
+		// records of QueryLocator are typed with the queried sobject, such as Account
+		elementType = records[0].ClassType
+	}
+	scopeType := builtin.CreateListType(elementType)
+	var firstErr error
+	for i := 0; i < len(records); i += job.ScopeSize {
+		end := i + job.ScopeSize
+		if end > len(records) {
+			end = len(records)
+		}
+		scope := builtin.CreateListObject(scopeType, records[i:end])
+		err := v.runBatchTransaction(job, func(ctx *ast.Object) error {
+			_, err := v.callMethod(batch, "execute", []*ast.Object{ctx, scope}, job.Node)
+			return err
+		})
+		job.JobItemsProcessed++
+		message := ""
+		if err != nil {
+			job.NumberOfErrors++
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		if firstErr != nil {
+			message = "First error: " + firstErr.Error()
+		}
+		queue.SetStatus(job, "Processing", message)
+	}
+
+	queue.SetStatus(job, "Finishing", "")
+	err = v.runBatchTransaction(job, func(ctx *ast.Object) error {
+		_, err := v.callMethod(batch, "finish", []*ast.Object{ctx}, job.Node)
+		return err
+	})
+	if err != nil {
+		queue.SetStatus(job, "Failed", err.Error())
+		return err
+	}
+	if firstErr != nil {
+		queue.SetStatus(job, "Completed", "First error: "+firstErr.Error())
+		return firstErr
+	}
+	queue.SetStatus(job, "Completed", "")
+	return nil
+}
+
+// runBatchTransaction runs a phase of the batch in its own transaction.
+// Instance fields of non-stateful batch are restored to the state at Database.executeBatch.
+func (v *Interpreter) runBatchTransaction(job *builtin.AsyncJob, f func(ctx *ast.Object) error) error {
+	if job.InitialState != nil {
+		batch := job.Receiver.(*ast.Object)
+		batch.InstanceFields = builtin.CopyObject(job.InitialState).InstanceFields
+	}
+	childJobId := v.AsyncJobs().NextId()
+	return v.runTransaction(childJobId, func() error {
+		return f(builtin.NewBatchableContext(job.Id, childJobId))
+	})
+}
+
+// iterate returns records of Database.QueryLocator, List or Iterable object
+func (v *Interpreter) iterate(iterable *ast.Object, n ast.Node) ([]*ast.Object, error) {
+	if records, ok := builtin.QueryLocatorRecords(iterable); ok {
+		return records, nil
+	}
+	if iterable.ClassType.Name == "List" {
+		return iterable.Extra["records"].([]*ast.Object), nil
+	}
+	iterator, err := v.callMethod(iterable, "iterator", []*ast.Object{}, n)
+	if err != nil {
+		return nil, err
+	}
+	records := []*ast.Object{}
+	for {
+		hasNext, err := v.callMethod(iterator, "hasNext", []*ast.Object{}, n)
+		if err != nil {
+			return nil, err
+		}
+		if !hasNext.BoolValue() {
+			return records, nil
+		}
+		record, err := v.callMethod(iterator, "next", []*ast.Object{}, n)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+}
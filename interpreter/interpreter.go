@@ -6,8 +6,8 @@ import (
 	"strconv"
 
 	"strings"
+	"sync/atomic"
 
-	"errors"
 	"fmt"
 
 	"github.com/k0kubun/pp"
@@ -16,18 +16,33 @@ import (
 	"github.com/tzmfreedom/land/compiler"
 )
 
+// maxStackDepth is the maximum depth of method calls, deeper call raises LimitException as Salesforce does
+const maxStackDepth = 1000
+
+// ErrInterrupted is returned when the execution is interrupted by Interrupt, such as on timeout of the server.
+// Natives which check the interruption return the same error.
+var ErrInterrupted = builtin.ErrInterrupted
+
 type Interpreter struct {
-	Context *Context
-	Extra   map[string]interface{}
+	Context     *Context
+	Extra       map[string]interface{}
+	depth       int
+	interrupted int32
 }
 
 func NewInterpreter(classTypeMap *ast.ClassMap) *Interpreter {
+	// the time set by Test.setCurrentTime does not leak into other interpreters
+	clock := builtin.SystemClock.Copy()
 	interpreter := &Interpreter{
 		Context: NewContext(),
 		Extra: map[string]interface{}{
-			"stdout": os.Stdout,
-			"stderr": os.Stderr,
-			"errors": []*builtin.TestError{},
+			"stdout":     os.Stdout,
+			"stderr":     os.Stderr,
+			"errors":     []*builtin.TestError{},
+			"limits":     builtin.NewLimits(false),
+			"clock":      clock,
+			"async_jobs": builtin.NewAsyncJobQueue(clock),
+			"scheduler":  builtin.NewScheduler(clock),
 		},
 	}
 	interpreter.Extra["interpreter"] = interpreter
@@ -44,125 +59,15 @@ func NewInterpreterWithBuiltin(classTypes []*ast.ClassType) *Interpreter {
 	return interpreter
 }
 
-var binaryOperator = map[string]func(*ast.Object, *ast.Object) *ast.Object{
-	"=": func(lObj *ast.Object, rObj *ast.Object) *ast.Object {
-		return rObj
-	},
-	"+=": func(lObj *ast.Object, rObj *ast.Object) *ast.Object {
-		lType := lObj.ClassType
-		rType := rObj.ClassType
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewInteger(l + r)
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(float64(l) + r)
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewDouble(l + float64(r))
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(r + l)
-			}
-		} else if lType == builtin.StringType {
-			l := lObj.StringValue()
-			r := rObj.StringValue()
-			return builtin.NewString(l + r)
-		}
-		panic("not pass")
-	},
-	"-=": func(lObj *ast.Object, rObj *ast.Object) *ast.Object {
-		lType := lObj.ClassType
-		rType := rObj.ClassType
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewInteger(l - r)
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(float64(l) - r)
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewDouble(l - float64(r))
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(r - l)
-			}
-		}
-		panic("not pass")
-	},
-	"*=": func(lObj *ast.Object, rObj *ast.Object) *ast.Object {
-		lType := lObj.ClassType
-		rType := rObj.ClassType
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewInteger(l * r)
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(float64(l) * r)
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewDouble(l * float64(r))
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(r * l)
-			}
-		}
-		panic("not pass")
-	},
-	"/=": func(lObj *ast.Object, rObj *ast.Object) *ast.Object {
-		lType := lObj.ClassType
-		rType := rObj.ClassType
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewInteger(l / r)
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(float64(l) / r)
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewDouble(l / float64(r))
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(r / l)
-			}
-		}
-		panic("not pass")
-	},
-}
-
 func (v *Interpreter) LoadStaticField() {
 	v.Context.StaticField = NewStaticFieldMap()
 	for className, classType := range v.Context.ClassTypes.Data {
 		objectMap := ast.NewObjectMap()
-		if classType.StaticFields != nil {
+		if builtin.IsEnum(classType) {
+			for _, value := range builtin.EnumValues(classType) {
+				objectMap.Set(value.StringValue(), value)
+			}
+		} else if classType.StaticFields != nil {
 			for _, f := range classType.StaticFields.Data {
 				val, err := f.Expression.Accept(v)
 				if err != nil {
@@ -191,7 +96,14 @@ func (v *Interpreter) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration) (in
 	panic("not pass")
 }
 
+func (v *Interpreter) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	panic("not pass")
+}
+
 func (v *Interpreter) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
+	if n.IsLong {
+		return builtin.NewLong(n.Value), nil
+	}
 	return builtin.NewInteger(n.Value), nil
 }
 
@@ -212,11 +124,17 @@ func (v *Interpreter) VisitArrayAccess(n *ast.ArrayAccess) (interface{}, error)
 	key := k.(*ast.Object)
 	if receiver.ClassType.Name == "List" {
 		records := receiver.Extra["records"].([]*ast.Object)
-		return records[key.IntegerValue()], nil
+		index := key.IntegerValue()
+		if index < 0 || index >= len(records) {
+			return nil, builtin.NewExceptionError(builtin.NewException(builtin.ListExceptionType, fmt.Sprintf("List index out of bounds: %d", index)))
+		}
+		return records[index], nil
 	}
 
-	records := receiver.Extra["values"].(map[string]*ast.Object)
-	return records[key.StringValue()], nil
+	if value, ok := receiver.Extra["values"].(*builtin.OrderedMap).Get(key); ok {
+		return value, nil
+	}
+	return builtin.Null, nil
 }
 
 func (v *Interpreter) VisitBooleanLiteral(n *ast.BooleanLiteral) (interface{}, error) {
@@ -244,8 +162,14 @@ func (v *Interpreter) VisitDml(n *ast.Dml) (interface{}, error) {
 	} else {
 		records = []*ast.Object{obj}
 	}
+	if e := v.Limits().AddDml(len(records)); e != nil {
+		return nil, builtin.NewExceptionError(e)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
 	sObjectType := records[0].ClassType.Name
-	builtin.DatabaseDriver.Execute(n.Type, sObjectType, records, n.UpsertKey)
+	builtin.DatabaseDriver.Execute(n.Type, sObjectType, records, n.UpsertKey, v.Clock().Now())
 	return nil, nil
 }
 
@@ -260,30 +184,44 @@ func (v *Interpreter) VisitFieldDeclaration(n *ast.FieldDeclaration) (interface{
 func (v *Interpreter) VisitTry(n *ast.Try) (interface{}, error) {
 	res, err := n.Block.Accept(v)
 	if err != nil {
-		return nil, err
+		exceptionError, ok := err.(*builtin.ExceptionError)
+		if !ok {
+			return nil, err
+		}
+		res, err = builtin.CreateRaise(exceptionError.Exception), nil
 	}
 	if res != nil {
-		switch obj := res.(*ast.Object); obj.ClassType {
-		case builtin.ReturnType, builtin.BreakType, builtin.ContinueType:
-			return obj, nil
-		case builtin.RaiseType:
-			// TODO: implement
+		obj := res.(*ast.Object)
+		if obj.ClassType == builtin.RaiseType {
+			raiseValue := obj.Value().(*ast.Object)
 			for _, catch := range n.CatchClause {
-				raiseValue := obj.Value().(*ast.Object)
+				if raiseValue.ClassType == builtin.LimitExceptionType {
+					break
+				}
 				if builtin.Equals(catch.Type, raiseValue.ClassType) {
 					v.Context.Env.Define(catch.Identifier, raiseValue)
-					_, err := catch.Accept(v)
-					if err != nil {
-						return nil, err
-					}
-					// TODO: return, raise impl
+					res, err = catch.Accept(v)
+					break
 				}
 			}
 		}
 	}
 	if n.FinallyBlock != nil {
-		if _, err = n.FinallyBlock.Accept(v); err != nil {
-			return nil, err
+		r, finallyErr := n.FinallyBlock.Accept(v)
+		if finallyErr != nil {
+			return nil, finallyErr
+		}
+		if r != nil {
+			return r, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if res != nil {
+		switch obj := res.(*ast.Object); obj.ClassType {
+		case builtin.ReturnType, builtin.BreakType, builtin.ContinueType, builtin.RaiseType:
+			return obj, nil
 		}
 	}
 	return nil, nil
@@ -308,12 +246,18 @@ func (v *Interpreter) VisitFor(n *ast.For) (interface{}, error) {
 				}
 			}
 			for {
+				if err := v.checkpoint(n); err != nil {
+					return nil, err
+				}
 				res, err := control.Expression.Accept(v)
 				if err != nil {
 					return nil, err
 				}
 				if res.(*ast.Object).BoolValue() {
 					res, err = n.Statements.Accept(v)
+					if err != nil {
+						return nil, err
+					}
 					if res != nil {
 						switch obj := res.(*ast.Object); obj.ClassType {
 						case builtin.BreakType:
@@ -339,8 +283,17 @@ func (v *Interpreter) VisitFor(n *ast.For) (interface{}, error) {
 			if err != nil {
 				return nil, err
 			}
-			records := iterator.(*ast.Object).Extra["records"].([]*ast.Object)
+			var records []*ast.Object
+			switch iterable := iterator.(*ast.Object); iterable.ClassType.Name {
+			case "Set":
+				records = iterable.Extra["values"].(*builtin.OrderedMap).Keys()
+			default:
+				records = iterable.Extra["records"].([]*ast.Object)
+			}
 			for _, record := range records {
+				if err := v.checkpoint(n); err != nil {
+					return nil, err
+				}
 				v.Context.Env.Define(control.VariableDeclaratorId, record)
 				res, err := n.Statements.Accept(v)
 				if err != nil {
@@ -411,9 +364,9 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		// TODO: extend
 		_, m, err = FindInstanceMethod(receiver.(*ast.Object), exp.FieldName, evaluated, compiler.MODIFIER_ALL_OK)
 		if err != nil {
-			if npe, ok := err.(*builtin.NullPointerException); ok {
-				location := n.Location
-				return nil, fmt.Errorf("null pointer exception: %s at %d:%d", npe.GetName(), location.Line, location.Column)
+			// the null receiver is named by its expression, such as `a.b` of `a.b.size()`
+			if npe, ok := err.(*builtin.NullPointerException); ok && npe.GetLocation() == nil {
+				return nil, builtin.NewNullPointerExceptionAt(ast.ToString(exp.Expression), n.Location)
 			}
 			return nil, err
 		}
@@ -433,13 +386,34 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		resolver := NewTypeResolver(v.Context)
 		receiver, m, err = resolver.ResolveMethod(exp.Value, evaluated)
 		if err != nil {
-			if npe, ok := err.(*builtin.NullPointerException); ok {
-				location := n.Location
-				return nil, fmt.Errorf("null pointer exception: %s at %d:%d", npe.GetName(), location.Line, location.Column)
+			if npe, ok := err.(*builtin.NullPointerException); ok && npe.GetLocation() == nil {
+				// the null receiver is named by its path, such as `a.b` of `a.b.size()`
+				name := npe.GetName()
+				if len(exp.Value) > 1 && name == exp.Value[len(exp.Value)-1] {
+					name = strings.Join(exp.Value[:len(exp.Value)-1], ".")
+				}
+				return nil, builtin.NewNullPointerExceptionAt(name, n.Location)
 			}
 			return nil, err
 		}
 	}
+	if m.IsAnnotated("future") {
+		return v.enqueueFuture(receiver, m, evaluated, n)
+	}
+	if obj, ok := receiver.(*ast.Object); ok {
+		if provider := builtin.StubProvider(obj); provider != nil {
+			evaluated = builtin.StubArguments(obj, m, evaluated)
+			receiver, m, err = FindInstanceMethod(provider, "handleMethodCall", evaluated, compiler.MODIFIER_PUBLIC_ONLY)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return v.invokeMethod(receiver, m, evaluated, n)
+}
+
+// invokeMethod calls the method with evaluated parameters, receiver is *ast.Object for instance method or *ast.ClassType for static method
+func (v *Interpreter) invokeMethod(receiver interface{}, m *ast.Method, evaluated []*ast.Object, n ast.Node) (interface{}, error) {
 	prevClass := v.Context.CurrentClass
 	switch typedReceiver := receiver.(type) {
 	case *ast.Object:
@@ -453,17 +427,62 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 
 	if m.NativeFunction != nil {
 		var r interface{}
+		prevNode := v.Extra["node"]
 		v.Extra["node"] = n
+		var allocated int
 		switch typedReceiver := receiver.(type) {
 		case *ast.Object:
+			size := builtin.SizeOf(typedReceiver)
 			r = m.NativeFunction(typedReceiver, evaluated, v.Extra)
+			// growth of the receiver, such as List.add
+			allocated = builtin.SizeOf(typedReceiver) - size
+			// strings and blobs are immutable, so their methods return new values.
+			// Methods of the other objects may return the elements of the receiver, such as List.get, which are not counted.
+			if obj, ok := r.(*ast.Object); ok && (typedReceiver.ClassType == builtin.StringType || typedReceiver.ClassType == builtin.BlobType) {
+				allocated += builtin.SizeOf(obj)
+			}
 		case *ast.ClassType:
 			r = m.NativeFunction(nil, evaluated, v.Extra)
+			if obj, ok := r.(*ast.Object); ok {
+				allocated = builtin.SizeOf(obj)
+			}
 		}
-		v.Extra["node"] = nil
+		v.Extra["node"] = prevNode
 		Publish("method_end", v.Context, n)
+		if obj, ok := r.(*ast.Object); ok && obj.ClassType == builtin.RaiseType {
+			exception := obj.Value().(*ast.Object)
+			if n != nil {
+				builtin.SetExceptionLocation(exception, n.GetLocation())
+			}
+			return nil, builtin.NewExceptionError(exception)
+		}
+		// error of apex code called from the native function, such as compareTo called by List.sort,
+		// and LimitException of the sandbox raised by the native function
+		if err, ok := r.(error); ok {
+			if npe, ok := err.(*builtin.NullPointerException); ok && npe.GetLocation() == nil && n != nil {
+				return nil, builtin.NewNullPointerExceptionAt(npe.GetName(), n.GetLocation())
+			}
+			if e, ok := err.(*builtin.ExceptionError); ok && n != nil {
+				builtin.SetExceptionLocation(e.Exception, n.GetLocation())
+			}
+			return nil, err
+		}
+		if err := v.allocate(allocated, n); err != nil {
+			return nil, err
+		}
 		return r, nil
 	}
+	if v.depth >= maxStackDepth {
+		exception := builtin.NewException(builtin.LimitExceptionType, fmt.Sprintf("Maximum stack depth reached: %d", v.depth+1))
+		if n != nil {
+			builtin.SetExceptionLocation(exception, n.GetLocation())
+		}
+		return nil, builtin.NewExceptionError(exception)
+	}
+	v.depth++
+	defer func() {
+		v.depth--
+	}()
 	prev := v.Context.Env
 	v.Context.Env = NewEnv(nil)
 	for i, param := range m.Parameters {
@@ -475,10 +494,10 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 	}
 	r, err := m.Statements.Accept(v)
 	Publish("method_end", v.Context, n)
+	v.Context.Env = prev
 	if err != nil {
 		return nil, err
 	}
-	v.Context.Env = prev
 
 	if r != nil {
 		obj := r.(*ast.Object)
@@ -486,7 +505,8 @@ func (v *Interpreter) VisitMethodInvocation(n *ast.MethodInvocation) (interface{
 		case builtin.ReturnType:
 			return obj.Value(), nil
 		case builtin.RaiseType:
-			return obj, nil
+			// exception is propagated as error, to be caught by enclosing try statement of the caller
+			return nil, builtin.NewExceptionError(obj.Value().(*ast.Object))
 		}
 	}
 	return nil, nil
@@ -529,7 +549,10 @@ func (v *Interpreter) VisitNew(n *ast.New) (interface{}, error) {
 		}
 
 		if constructor.NativeFunction != nil {
-			constructor.NativeFunction(newObj, evaluated, v.Extra)
+			r := constructor.NativeFunction(newObj, evaluated, v.Extra)
+			if obj, ok := r.(*ast.Object); ok && obj.ClassType == builtin.RaiseType {
+				return nil, builtin.NewExceptionError(obj.Value().(*ast.Object))
+			}
 		} else {
 			prev := v.Context.Env
 			v.Context.Env = NewEnv(nil)
@@ -560,7 +583,8 @@ func (v *Interpreter) VisitNew(n *ast.New) (interface{}, error) {
 		}
 	}
 
-	if classType.Name == "List" {
+	padded := 0
+	if _, ok := newObj.Extra["records"]; classType.Name == "List" && !ok {
 		newObj.Extra["records"] = []*ast.Object{}
 		if n.Init != nil {
 			if len(n.Init.Records) != 0 {
@@ -585,6 +609,11 @@ func (v *Interpreter) VisitNew(n *ast.New) (interface{}, error) {
 					recordSize := len(records)
 					remain := s.IntegerValue() - recordSize
 					if remain > 0 {
+						// the nulls are counted before they are allocated
+						if err := v.allocate(remain*builtin.SlotSize, n); err != nil {
+							return nil, err
+						}
+						padded = remain
 						for i := 0; i < remain; i++ {
 							records = append(records, builtin.Null)
 						}
@@ -594,24 +623,38 @@ func (v *Interpreter) VisitNew(n *ast.New) (interface{}, error) {
 			}
 		}
 	}
-	if classType.Name == "Map" {
-		if n.Init == nil {
-			newObj.Extra["values"] = map[string]*ast.Object{}
-		} else {
-			values := map[string]*ast.Object{}
-			for key, value := range n.Init.Values {
-				mapValue, err := value.Accept(v)
+	if _, ok := newObj.Extra["values"]; classType.Name == "Map" && !ok {
+		values := builtin.NewOrderedMap()
+		if n.Init != nil {
+			for _, entry := range n.Init.Values {
+				mapKey, err := entry.Key.Accept(v)
 				if err != nil {
 					return nil, err
 				}
-				mapKey, err := key.Accept(v)
+				mapValue, err := entry.Value.Accept(v)
 				if err != nil {
 					return nil, err
 				}
-				values[mapKey.(*ast.Object).StringValue()] = mapValue.(*ast.Object)
+				values.Put(mapKey.(*ast.Object), mapValue.(*ast.Object))
 			}
-			newObj.Extra["values"] = values
 		}
+		newObj.Extra["values"] = values
+	}
+	if _, ok := newObj.Extra["values"]; classType.Name == "Set" && !ok {
+		values := builtin.NewOrderedMap()
+		if n.Init != nil {
+			for _, r := range n.Init.Records {
+				initRecord, err := r.Accept(v)
+				if err != nil {
+					return nil, err
+				}
+				values.Put(initRecord.(*ast.Object), initRecord.(*ast.Object))
+			}
+		}
+		newObj.Extra["values"] = values
+	}
+	if err := v.allocate(builtin.SizeOf(newObj)-padded*builtin.SlotSize, n); err != nil {
+		return nil, err
 	}
 	return newObj, nil
 }
@@ -643,10 +686,15 @@ func (v *Interpreter) assignValue(exp ast.Node, newValue *ast.Object) error {
 		}
 		receiver := r.(*ast.Object)
 		if receiver.ClassType.Name == "List" {
-			receiver.Extra["records"].([]*ast.Object)[key.IntegerValue()] = newValue
+			records := receiver.Extra["records"].([]*ast.Object)
+			index := key.IntegerValue()
+			if index < 0 || index >= len(records) {
+				return builtin.NewExceptionError(builtin.NewException(builtin.ListExceptionType, fmt.Sprintf("List index out of bounds: %d", index)))
+			}
+			records[index] = newValue
 		}
 		if receiver.ClassType.Name == "Map" {
-			receiver.Extra["values"].(map[string]*ast.Object)[key.StringValue()] = newValue
+			receiver.Extra["values"].(*builtin.OrderedMap).Put(key, newValue)
 		}
 		// TODO: implment set type
 	}
@@ -654,33 +702,37 @@ func (v *Interpreter) assignValue(exp ast.Node, newValue *ast.Object) error {
 }
 
 func (v *Interpreter) VisitUnaryOperator(n *ast.UnaryOperator) (interface{}, error) {
-	if n.Op == "++" {
-		l, err := n.Expression.Accept(v)
-		if err != nil {
-			return nil, err
-		}
-		newValue := builtin.NewInteger(l.(*ast.Object).IntegerValue() + 1)
-		err = v.assignValue(n.Expression, newValue)
-		if err != nil {
-			return nil, err
-		}
-		return newValue, nil
+	r, err := n.Expression.Accept(v)
+	if err != nil {
+		return nil, err
 	}
-	if n.Op == "--" {
-		name := n.Expression.(*ast.Name)
-		l, err := name.Accept(v)
+	obj := r.(*ast.Object)
+	switch n.Op {
+	case "++", "--":
+		newValue, err := arithmetic(n.Op[:1], obj, builtin.NewInteger(1))
 		if err != nil {
-			return nil, err
+			return nil, operatorError(err, n, []ast.Node{n.Expression}, []*ast.Object{obj})
 		}
-		newValue := builtin.NewInteger(l.(*ast.Object).IntegerValue() - 1)
 		err = v.assignValue(n.Expression, newValue)
 		if err != nil {
 			return nil, err
 		}
+		if !n.IsPrefix {
+			return obj, nil
+		}
 		return newValue, nil
+	case "+":
+		return obj, nil
+	case "-":
+		value, err := negate(obj)
+		return value, operatorError(err, n, []ast.Node{n.Expression}, []*ast.Object{obj})
+	case "!":
+		return builtin.NewBoolean(!obj.BoolValue()), nil
+	case "~":
+		value, err := complement(obj)
+		return value, operatorError(err, n, []ast.Node{n.Expression}, []*ast.Object{obj})
 	}
 	panic("not pass")
-	return nil, nil
 }
 
 func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, error) {
@@ -706,248 +758,52 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 	}
 
 	switch n.Op {
-	case "+":
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewInteger(l + r), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(float64(l) + r), nil
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewDouble(l + float64(r)), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(r + l), nil
-			}
-		} else if lType == builtin.StringType {
-			l := lObj.StringValue()
-			r := rObj.StringValue()
-			return builtin.NewString(l + r), nil
-		}
-		panic("type error")
-	case "-":
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewInteger(l - r), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(float64(l) - r), nil
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewDouble(l - float64(r)), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(r - l), nil
-			}
-		}
-		panic("type error")
-	case "*":
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewInteger(l * r), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(float64(l) * r), nil
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewDouble(l * float64(r)), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(r * l), nil
-			}
+	case "+", "-", "*", "/":
+		value, err := arithmetic(n.Op, lObj, rObj)
+		if err != nil {
+			return nil, operatorError(err, n, []ast.Node{n.Left, n.Right}, []*ast.Object{lObj, rObj})
 		}
-		panic("type error")
-	case "/":
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewInteger(l / r), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(float64(l) / r), nil
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewDouble(l / float64(r)), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewDouble(r / l), nil
-			}
+		// concatenated string
+		if err := v.allocate(builtin.SizeOf(value), n); err != nil {
+			return nil, err
 		}
-		panic("type error")
-	case "<":
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l < r), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(float64(l) < r), nil
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l < float64(r)), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(r < l), nil
-			}
+		return value, nil
+	case "&", "|", "^", "<<", ">>", ">>>":
+		value, err := bitwise(n.Op, lObj, rObj)
+		return value, operatorError(err, n, []ast.Node{n.Left, n.Right}, []*ast.Object{lObj, rObj})
+	case "<", ">", "<=", ">=":
+		c, ok, err := compare(n.Op, lObj, rObj)
+		if err != nil {
+			return nil, err
 		}
-		panic("type error")
-	case ">":
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l > r), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(float64(l) > r), nil
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l > float64(r)), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(r > l), nil
-			}
+		if !ok {
+			return builtin.NewBoolean(false), nil
 		}
-		panic("type error")
-	case "<=":
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l <= r), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(float64(l) <= r), nil
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l <= float64(r)), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(r <= l), nil
-			}
+		switch n.Op {
+		case "<":
+			return builtin.NewBoolean(c < 0), nil
+		case ">":
+			return builtin.NewBoolean(c > 0), nil
+		case "<=":
+			return builtin.NewBoolean(c <= 0), nil
 		}
-		panic("type error")
-	case ">=":
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l >= r), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(float64(l) >= r), nil
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l >= float64(r)), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(r >= l), nil
-			}
-		}
-		panic("type error")
+		return builtin.NewBoolean(c >= 0), nil
 	case "==":
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l == r), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(float64(l) == r), nil
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l == float64(r)), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(r == l), nil
-			}
-		} else if lType == builtin.StringType {
-			l := lObj.StringValue()
-			r := rObj.StringValue()
-			return builtin.NewBoolean(l == r), nil
+		if c, ok := compareNumbers(lObj, rObj); ok {
+			return builtin.NewBoolean(c == 0), nil
+		}
+		if lType == builtin.StringType && rType == builtin.StringType {
+			return builtin.NewBoolean(lObj.StringValue() == rObj.StringValue()), nil
 		}
 		return builtin.NewBoolean(v.Equals(lObj, rObj)), nil
 	case "===":
 		return builtin.NewBoolean(lObj == rObj), nil
 	case "!=":
-		if lType == builtin.IntegerType {
-			l := lObj.IntegerValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l != r), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(float64(l) != r), nil
-			}
-		} else if lType == builtin.DoubleType {
-			l := lObj.DoubleValue()
-			if rType == builtin.IntegerType {
-				r := rObj.IntegerValue()
-				return builtin.NewBoolean(l != float64(r)), nil
-			}
-			if rType == builtin.DoubleType {
-				r := rObj.DoubleValue()
-				return builtin.NewBoolean(r != l), nil
-			}
-		} else if lType == builtin.StringType {
-			l := lObj.StringValue()
-			r := rObj.StringValue()
-			return builtin.NewBoolean(l != r), nil
+		if c, ok := compareNumbers(lObj, rObj); ok {
+			return builtin.NewBoolean(c != 0), nil
+		}
+		if lType == builtin.StringType && rType == builtin.StringType {
+			return builtin.NewBoolean(lObj.StringValue() != rObj.StringValue()), nil
 		}
 		return builtin.NewBoolean(!v.Equals(lObj, rObj)), nil
 	case "!==":
@@ -967,17 +823,50 @@ func (v *Interpreter) VisitBinaryOperator(n *ast.BinaryOperator) (interface{}, e
 			return nil, err
 		}
 		return builtin.NewBoolean(right.(*ast.Object).BoolValue()), nil
-	case "=", "+=", "-=", "*=", "/=":
-		value := binaryOperator[n.Op](lObj, rObj)
+	case "=", "+=", "-=", "*=", "/=", "&=", "|=", "^=", "<<=", ">>=", ">>>=":
+		value := rObj
+		switch op := strings.TrimSuffix(n.Op, "="); op {
+		case "":
+		case "+", "-", "*", "/":
+			value, err = arithmetic(op, lObj, rObj)
+		default:
+			value, err = bitwise(op, lObj, rObj)
+		}
+		if err != nil {
+			return nil, operatorError(err, n, []ast.Node{n.Left, n.Right}, []*ast.Object{lObj, rObj})
+		}
+		if err := v.allocate(builtin.SizeOf(value), n); err != nil {
+			return nil, err
+		}
 		err := v.assignValue(n.Left, value)
 		if err != nil {
 			return nil, err
 		}
-		return rObj, nil
+		return value, nil
 	}
 	return nil, nil
 }
 
+// operatorError locates the exception of the operator, such as MathException of `1 / 0`, at the operator.
+// NullPointerException is named by the expression of the null operand, such as `a` of `a + 1`, and located at the operand.
+func operatorError(err error, n ast.Node, operands []ast.Node, values []*ast.Object) error {
+	switch e := err.(type) {
+	case *builtin.ExceptionError:
+		builtin.SetExceptionLocation(e.Exception, n.GetLocation())
+	case *builtin.NullPointerException:
+		if e.GetLocation() != nil {
+			return err
+		}
+		for i, value := range values {
+			if value == builtin.Null {
+				return builtin.NewNullPointerExceptionAt(ast.ToString(operands[i]), operands[i].GetLocation())
+			}
+		}
+		return builtin.NewNullPointerExceptionAt(e.GetName(), n.GetLocation())
+	}
+	return err
+}
+
 func (v *Interpreter) VisitInstanceofOperator(n *ast.InstanceofOperator) (interface{}, error) {
 	obj, err := n.Expression.Accept(v)
 	if err != nil {
@@ -1004,23 +893,28 @@ func (v *Interpreter) VisitThrow(n *ast.Throw) (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	builtin.SetExceptionLocation(res.(*ast.Object), n.Location)
 	return builtin.CreateRaise(res.(*ast.Object)), nil
 }
 
 func (v *Interpreter) VisitSoql(n *ast.Soql) (interface{}, error) {
+	if e := v.Limits().AddQuery(); e != nil {
+		return nil, builtin.NewExceptionError(e)
+	}
 	executor := &SoqlExecutor{}
 	objects, err := executor.Execute(n, v)
+	if err != nil {
+		return nil, err
+	}
 	if n.ExactlyOne {
 		records := objects.Extra["records"].([]*ast.Object)
 		if len(records) == 0 {
-			return nil, errors.New("List has no rows for assignment to SObject")
+			return nil, builtin.NewExceptionError(builtin.NewException(builtin.QueryExceptionType, "List has no rows for assignment to SObject"))
 		}
 		if len(records) > 1 {
-			return nil, errors.New("List has more than 1 row for assignment to SObject")
+			return nil, builtin.NewExceptionError(builtin.NewException(builtin.QueryExceptionType, "List has more than 1 row for assignment to SObject"))
 		}
-	}
-	if err != nil {
-		return nil, err
+		return records[0], nil
 	}
 	return objects, nil
 }
@@ -1031,8 +925,7 @@ func (v *Interpreter) VisitSosl(n *ast.Sosl) (interface{}, error) {
 }
 
 func (v *Interpreter) VisitStringLiteral(n *ast.StringLiteral) (interface{}, error) {
-	value := strings.Replace(n.Value, "\\n", "\n", -1)
-	return builtin.NewString(value), nil
+	return builtin.NewString(builtin.UnescapeJava(n.Value)), nil
 }
 
 func (v *Interpreter) VisitSwitch(n *ast.Switch) (interface{}, error) {
@@ -1043,13 +936,29 @@ func (v *Interpreter) VisitSwitch(n *ast.Switch) (interface{}, error) {
 	expObj := exp.(*ast.Object)
 	for _, when := range n.WhenStatements {
 		for _, cond := range when.Condition {
-			c, err := cond.Accept(v)
-			if err != nil {
-				return nil, err
-			}
-			condObj := c.(*ast.Object)
-			if v.Equals(expObj, condObj) {
-				return when.Statements.Accept(v)
+			switch c := cond.(type) {
+			case *ast.WhenType:
+				// when Account a { ... } binds the expression to typed variable in the branch
+				if expObj == builtin.Null || expObj.ClassType.Name != c.Type.Name {
+					continue
+				}
+				return v.NewEnv(func() (interface{}, error) {
+					v.Context.Env.Define(c.Identifier, expObj)
+					return when.Statements.Accept(v)
+				})
+			case *ast.Name:
+				// when value of enum is its constant name, such as `when WINTER`
+				if value, ok := builtin.EnumValueOf(expObj.ClassType, c.Value[0]); ok && value == expObj {
+					return when.Statements.Accept(v)
+				}
+			default:
+				r, err := cond.Accept(v)
+				if err != nil {
+					return nil, err
+				}
+				if v.Equals(expObj, r.(*ast.Object)) {
+					return when.Statements.Accept(v)
+				}
 			}
 		}
 	}
@@ -1073,7 +982,7 @@ func (v *Interpreter) VisitVariableDeclaration(n *ast.VariableDeclaration) (inte
 		if declarator.Expression != nil {
 			val, err := declarator.Expression.Accept(v)
 			if err != nil {
-				panic(err)
+				return nil, err
 			}
 			v.Context.Env.Define(declarator.Name, val.(*ast.Object))
 		} else {
@@ -1098,6 +1007,9 @@ func (v *Interpreter) VisitWhenType(n *ast.WhenType) (interface{}, error) {
 
 func (v *Interpreter) VisitWhile(n *ast.While) (interface{}, error) {
 	for {
+		if err := v.checkpoint(n); err != nil {
+			return nil, err
+		}
 		c, err := n.Condition.Accept(v)
 		if err != nil {
 			return nil, err
@@ -1134,6 +1046,9 @@ func (v *Interpreter) VisitCastExpression(n *ast.CastExpression) (interface{}, e
 		return nil, err
 	}
 	expObj := exp.(*ast.Object)
+	if builtin.IsNumeric(n.CastType) && builtin.IsNumeric(expObj.ClassType) {
+		return convertNumber(expObj, n.CastType)
+	}
 	if !builtin.Equals(n.CastType, expObj.ClassType) {
 		return nil, fmt.Errorf("Cast type is not match %s != %s", n.CastType.Name, expObj.ClassType.Name)
 	}
@@ -1153,7 +1068,12 @@ func (v *Interpreter) VisitFieldAccess(n *ast.FieldAccess) (interface{}, error)
 }
 
 func (v *Interpreter) VisitType(n *ast.TypeRef) (interface{}, error) {
-	panic("not pass")
+	resolver := NewTypeResolver(v.Context)
+	classType, err := resolver.ConvertType(n)
+	if err != nil {
+		return nil, err
+	}
+	return builtin.NewType(classType), nil
 }
 
 func (v *Interpreter) VisitBlock(n *ast.Block) (interface{}, error) {
@@ -1163,6 +1083,9 @@ func (v *Interpreter) VisitBlock(n *ast.Block) (interface{}, error) {
 		v.Context.Env = prevEnv
 	}()
 	for _, stmt := range n.Statements {
+		if err := v.checkpoint(stmt); err != nil {
+			return nil, err
+		}
 		Publish("line", v.Context, stmt)
 		res, err := stmt.Accept(v)
 		if err != nil {
@@ -1220,14 +1143,13 @@ func (v *Interpreter) VisitSetCreator(n *ast.SetCreator) (interface{}, error) {
 
 func (v *Interpreter) VisitName(n *ast.Name) (interface{}, error) {
 	resolver := NewTypeResolver(v.Context)
-	_, err := resolver.ResolveVariable(n.Value)
+	val, err := resolver.ResolveVariable(n.Value)
 	if err != nil {
-		if npe, ok := err.(*builtin.NullPointerException); ok {
-			location := n.Location
-			return nil, fmt.Errorf("null pointer exception: %s at %d:%d", npe.GetName(), location.Line, location.Column)
+		if npe, ok := err.(*builtin.NullPointerException); ok && npe.GetLocation() == nil {
+			return nil, builtin.NewNullPointerExceptionAt(npe.GetName(), n.Location)
 		}
 	}
-	return nil, err
+	return val, err
 }
 
 func (v *Interpreter) VisitConstructorDeclaration(n *ast.ConstructorDeclaration) (interface{}, error) {
@@ -1252,7 +1174,7 @@ func (v *Interpreter) Equals(o, other *ast.Object) bool {
 	}
 	method := m[0]
 	if method.NativeFunction != nil {
-		bObj := method.NativeFunction(o, []*ast.Object{other}, nil).(*ast.Object)
+		bObj := method.NativeFunction(o, []*ast.Object{other}, v.Extra).(*ast.Object)
 		return bObj.BoolValue()
 	}
 	prev := v.Context.Env
@@ -1267,6 +1189,100 @@ func (v *Interpreter) Equals(o, other *ast.Object) bool {
 	return r.(*ast.Object).BoolValue()
 }
 
+// CallMethod calls the instance method of the receiver from native functions, such as compareTo called by List.sort
+func (v *Interpreter) CallMethod(receiver *ast.Object, methodName string, params []*ast.Object) (*ast.Object, error) {
+	n, _ := v.Extra["node"].(ast.Node)
+	return v.callMethod(receiver, methodName, params, n)
+}
+
+// Interrupt stops the execution at the next statement, it can be called from other goroutines
+func (v *Interpreter) Interrupt() {
+	atomic.StoreInt32(&v.interrupted, 1)
+}
+
+func (v *Interpreter) Interrupted() bool {
+	return atomic.LoadInt32(&v.interrupted) != 0
+}
+
+// checkpoint is called before each statement and loop iteration, it stops the interrupted execution
+// and raises LimitException if the code exceeds the caps of the sandbox
+func (v *Interpreter) checkpoint(n ast.Node) error {
+	if v.Interrupted() {
+		return ErrInterrupted
+	}
+	sandbox, ok := v.Extra["sandbox"].(*builtin.Sandbox)
+	if !ok {
+		return nil
+	}
+	if exception := sandbox.Step(); exception != nil {
+		builtin.SetExceptionLocation(exception, n.GetLocation())
+		return builtin.NewExceptionError(exception)
+	}
+	return nil
+}
+
+// allocate counts the bytes allocated by the code in the sandbox, see builtin.SizeOf
+func (v *Interpreter) allocate(size int, n ast.Node) error {
+	sandbox, ok := v.Extra["sandbox"].(*builtin.Sandbox)
+	if !ok {
+		return nil
+	}
+	if exception := sandbox.Allocate(size); exception != nil {
+		if n != nil {
+			builtin.SetExceptionLocation(exception, n.GetLocation())
+		}
+		return builtin.NewExceptionError(exception)
+	}
+	return nil
+}
+
+// EvaluateStatements runs the statements of the class in the env, variables declared by them are kept in the env, such as inputs of the REPL.
+// It returns the value of the last statement, which is nil if it is not an expression.
+func (v *Interpreter) EvaluateStatements(classType *ast.ClassType, statements []ast.Node, env *Env) (*ast.Object, error) {
+	prevClass, prevEnv := v.Context.CurrentClass, v.Context.Env
+	v.Context.CurrentClass, v.Context.Env = classType, env
+	defer func() {
+		v.Context.CurrentClass, v.Context.Env = prevClass, prevEnv
+	}()
+	var value *ast.Object
+	for _, stmt := range statements {
+		if err := v.checkpoint(stmt); err != nil {
+			return nil, err
+		}
+		res, err := stmt.Accept(v)
+		if err != nil {
+			return nil, err
+		}
+		value = nil
+		obj, ok := res.(*ast.Object)
+		if !ok {
+			continue
+		}
+		switch obj.ClassType {
+		case builtin.RaiseType:
+			exception := obj.Value().(*ast.Object)
+			builtin.SetExceptionLocation(exception, stmt.GetLocation())
+			return nil, builtin.NewExceptionError(exception)
+		case builtin.ReturnType, builtin.BreakType, builtin.ContinueType:
+			return nil, nil
+		}
+		value = obj
+	}
+	return value, nil
+}
+
+// InvokeStaticMethod calls the static method with evaluated parameters, such as Apex REST method called by the server
+func (v *Interpreter) InvokeStaticMethod(classType *ast.ClassType, m *ast.Method, params []*ast.Object) (*ast.Object, error) {
+	r, err := v.invokeMethod(classType, m, params, nil)
+	if err != nil {
+		return nil, err
+	}
+	if obj, ok := r.(*ast.Object); ok {
+		return obj, nil
+	}
+	return builtin.Null, nil
+}
+
 // @return controller object, pageref object, error
 func (i *Interpreter) BindAndRun(name, method string, params map[string][]string, state map[string]interface{}) (*ast.Object, *ast.Object, error) {
 	classType, ok := i.Context.ClassTypes.Get(name)
@@ -0,0 +1,63 @@
+package interpreter
+
+import (
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+func (v *Interpreter) Scheduler() *builtin.Scheduler {
+	return v.Extra["scheduler"].(*builtin.Scheduler)
+}
+
+// FireScheduledJobs fires each waiting scheduled job once regardless of its next fire time, as Test.stopTest does.
+func (v *Interpreter) FireScheduledJobs() error {
+	var firstErr error
+	for _, trigger := range v.Scheduler().Waiting() {
+		err := v.fireTrigger(trigger, v.Clock().Now())
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// RunScheduledJobs advances the clock to each fire time until the time, and fires scheduled jobs in order.
+// Async jobs enqueued by scheduled jobs run after each fire.
+func (v *Interpreter) RunScheduledJobs(until time.Time) error {
+	var firstErr error
+	scheduler := v.Scheduler()
+	for trigger := scheduler.NextDue(until); trigger != nil; trigger = scheduler.NextDue(until) {
+		firedAt := *trigger.NextFireTime
+		v.Clock().Set(firedAt)
+		err := v.fireTrigger(trigger, firedAt)
+		if e := v.RunAsyncJobs(); err == nil {
+			err = e
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	v.Clock().Set(until)
+	return firstErr
+}
+
+func (v *Interpreter) fireTrigger(trigger *builtin.CronTrigger, firedAt time.Time) error {
+	queue := v.AsyncJobs()
+	scheduler := v.Scheduler()
+	prevJob := queue.Current
+	queue.Current = trigger.Job
+	defer func() {
+		queue.Current = prevJob
+	}()
+
+	scheduler.Start(trigger)
+	err := v.runTransaction(trigger.Job.Id, func() error {
+		ctx := builtin.NewSchedulableContext(trigger.Id)
+		_, err := v.callMethod(trigger.Receiver, "execute", []*ast.Object{ctx}, trigger.Node)
+		return err
+	})
+	scheduler.Finish(trigger, firedAt, err)
+	return err
+}
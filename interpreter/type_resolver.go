@@ -53,7 +53,7 @@ func (r *TypeResolver) SetVariable(names []string, setValue *ast.Object) error {
 			return setVariable(val, last, setValue)
 		}
 		// this
-		if val, ok := r.Context.Env.Get("this"); ok {
+		if val, ok := r.Context.Env.Get("this"); ok && hasInstanceField(val, name) {
 			for _, f := range names[0 : len(names)-1] {
 				val, ok = val.InstanceFields.Get(f)
 				if !ok {
@@ -69,6 +69,11 @@ func (r *TypeResolver) SetVariable(names []string, setValue *ast.Object) error {
 		}
 		if v, ok := r.Context.StaticField.Get("_", name); ok {
 			if val, ok := v.Get(names[1]); ok {
+				// static field of the class, such as RestContext.request = req
+				if len(names) == 2 {
+					v.Set(names[1], setValue)
+					return nil
+				}
 				for _, f := range names[2 : len(names)-1] {
 					val, ok = val.InstanceFields.Get(f)
 					if !ok {
@@ -103,6 +108,11 @@ func (r *TypeResolver) SetVariable(names []string, setValue *ast.Object) error {
 	return nil
 }
 
+func hasInstanceField(receiver *ast.Object, name string) bool {
+	_, ok := receiver.InstanceFields.Get(name)
+	return ok
+}
+
 func setVariable(receiver *ast.Object, name string, value *ast.Object) error {
 	v, ok := receiver.InstanceFields.Get(name)
 	if !ok {
@@ -133,9 +143,10 @@ func (r *TypeResolver) ResolveVariable(names []string) (*ast.Object, error) {
 	} else {
 		name := names[0]
 		if val, ok := r.Context.Env.Get(name); ok {
-			for _, f := range names[1:] {
+			for i, f := range names[1:] {
+				// the null object is named, such as `a` of `a.b`
 				if val == builtin.Null {
-					return nil, builtin.NewNullPointerException(f)
+					return nil, builtin.NewNullPointerException(strings.Join(names[:i+1], "."))
 				}
 				val, ok = val.InstanceFields.Get(f)
 				if !ok {
@@ -144,6 +155,9 @@ func (r *TypeResolver) ResolveVariable(names []string) (*ast.Object, error) {
 			}
 			return val, nil
 		}
+		if val, ok := r.resolveEnumConstant(names); ok {
+			return val, nil
+		}
 		// this
 		if val, ok := r.Context.Env.Get("this"); ok {
 			for _, f := range names[0:] {
@@ -195,6 +209,9 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.Object) (
 		if v, ok := r.Context.Env.Get("this"); ok {
 			_, method, err := FindInstanceMethod(v, methodName, parameters, compiler.MODIFIER_ALL_OK)
 			if err != nil {
+				if classType, method, staticErr := FindStaticMethod(v.ClassType, methodName, parameters, compiler.MODIFIER_ALL_OK); staticErr == nil {
+					return classType, method, nil
+				}
 				return nil, nil, err
 			}
 			if method == nil {
@@ -202,12 +219,19 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.Object) (
 			}
 			return v, method, nil
 		}
+		// static method of current class can be called without class name
+		if r.Context.CurrentClass != nil {
+			return FindStaticMethod(r.Context.CurrentClass, methodName, parameters, compiler.MODIFIER_ALL_OK)
+		}
 	} else {
 		first := names[0]
 		methodName := names[len(names)-1]
 		fields := names[1 : len(names)-1]
 		if val, ok := r.Context.Env.Get(first); ok {
-			for _, f := range fields {
+			for i, f := range fields {
+				if val == builtin.Null {
+					return nil, nil, builtin.NewNullPointerException(strings.Join(names[:i+1], "."))
+				}
 				val, ok = val.InstanceFields.Get(f)
 				if !ok {
 					return nil, nil, errors.Errorf("%s is not found in this scope", f)
@@ -215,6 +239,9 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.Object) (
 			}
 			return FindInstanceMethod(val, methodName, parameters, compiler.MODIFIER_ALL_OK)
 		}
+		if val, ok := r.resolveEnumConstant(names[:len(names)-1]); ok {
+			return FindInstanceMethod(val, methodName, parameters, compiler.MODIFIER_ALL_OK)
+		}
 		if len(names) == 2 {
 			if v, ok := r.Context.ClassTypes.Get(first); ok {
 				return FindStaticMethod(v, methodName, parameters, compiler.MODIFIER_ALL_OK)
@@ -257,10 +284,26 @@ func (r *TypeResolver) ResolveMethod(names []string, parameters []*ast.Object) (
 				}
 			}
 		}
+		// static method of inner class, such as Inner.method() or Outer.Inner.method()
+		if classType, err := r.resolver.ResolveType(names[:len(names)-1]); err == nil {
+			return FindStaticMethod(classType, methodName, parameters, compiler.MODIFIER_ALL_OK)
+		}
 	}
 	return nil, nil, errors.Errorf("%s is not found in this scope", strings.Join(names, "."))
 }
 
+// resolveEnumConstant returns the constant of enum, such as Season.WINTER or Outer.Season.WINTER
+func (r *TypeResolver) resolveEnumConstant(names []string) (*ast.Object, bool) {
+	if len(names) < 2 {
+		return nil, false
+	}
+	classType, err := r.resolver.ResolveType(names[:len(names)-1])
+	if err != nil {
+		return nil, false
+	}
+	return builtin.EnumValueOf(classType, names[len(names)-1])
+}
+
 func (r *TypeResolver) ResolveType(names []string) (*ast.ClassType, error) {
 	return r.resolver.ResolveType(names)
 }
@@ -31,6 +31,8 @@ func main() {
 		evalServerCommand,
 		formatCommand,
 		runCommand,
+		execCommand,
+		scheduleCommand,
 		checkCommand,
 		visualforceCommand,
 	}
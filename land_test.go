@@ -1,15 +1,46 @@
 package main
 
 import (
+	"fmt"
+	"io/ioutil"
 	"os"
+	"regexp"
+	"strings"
 
 	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
 )
 
 func setup() {
 	classMap = ast.NewClassMap()
 }
 
+// runFixture runs the action of the files, without loading sobjects.yml unlike `land run`
+func runFixture(action string, files ...string) {
+	setup()
+	trees, err := parseFiles(files)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	classTypes, err := buildAllFile(trees)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if err := run(action, classTypes); err != nil {
+		fmt.Println(err)
+	}
+}
+
+// useDatabase creates the tables of the sObjects in sobjects.yml.test, records are rolled back by run
+func useDatabase() {
+	builtin.LoadSObjectClass("sobjects.yml.test")
+	if err := builtin.CreateDatabase("sobjects.yml.test"); err != nil {
+		panic(err)
+	}
+}
+
 // Arithmetic
 func ExampleRun1() {
 	setup()
@@ -79,3 +110,147 @@ func ExampleAbstract() {
 	// hello
 	// world
 }
+
+// Variable
+func ExampleVariable() {
+	runFixture("Foo#action", "fixtures/variable.cls")
+	// Output:
+	// 1
+	// 2
+	// foo
+}
+
+// Exception thrown in called method, Try without Finally
+func ExampleException() {
+	runFixture("Foo#action", "fixtures/exception.cls")
+	// Output:
+	// from method
+	// without finally
+	// finally
+	// caught
+	// FooException: uncaught
+}
+
+// Inner class refers to sibling inner class
+func ExampleInnerClass() {
+	runFixture("Foo#action", "fixtures/inner_class.cls")
+	// Output:
+	// baz
+}
+
+// Throw variable of Exception type, Throw non exception
+func ExampleThrow() {
+	runFixture("Foo#action", "fixtures/throw.cls")
+	runFixture("Foo#action", "fixtures/throw_error.cls")
+	// Output:
+	// rethrown
+	// compile error
+}
+
+// Static method of current class without class name
+func ExampleStaticCall() {
+	runFixture("Foo#action", "fixtures/static_call.cls")
+	// Output:
+	// 2
+	// 4
+}
+
+// Relational operators of Long, null, Date, Datetime, Time and String
+func ExampleComparison() {
+	runFixture("Foo#action", "fixtures/comparison.cls")
+	// Output:
+	// true
+	// false
+	// true
+	// true
+	// false
+	// false
+	// false
+	// true
+	// false
+	// true
+	// true
+	// true
+	// true
+	// true
+	// Invalid operand types for <: Boolean, Integer
+}
+
+// @future, Queueable, System.enqueueJob, Limits of async jobs, jobs enqueued by failed job
+func ExampleAsync() {
+	runFixture("Foo#action", "fixtures/async.cls")
+	// Output:
+	// 1
+	// 50
+	// 2
+	// 50
+	// sync
+	// future a
+	// 200
+	// Future method cannot be called from a future or batch method: Foo.future
+	// parent
+	// true
+	// 1
+	// 1
+	// child
+	// true
+	// null pointer exception: s at 46:12
+}
+
+// Database.executeBatch, a failed scope is rolled back and instance fields are kept only by Database.Stateful
+func ExampleBatch() {
+	useDatabase()
+	runFixture("Foo#action", "fixtures/batch.cls")
+	// Output:
+	// 5
+	// 0
+	// 3
+	// null pointer exception: s at 37:16
+}
+
+// testOutputPattern matches the colors and the number of the output of test methods, the order of test methods is random
+var testOutputPattern = regexp.MustCompile(`\033\[[0-9;]*m|^\(\d+\) `)
+
+// runTestFixture runs the test classes of the files as `land test`, and prints the output without the colors and the numbers
+func runTestFixture(files ...string) {
+	setup()
+	trees, err := parseFiles(files)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	classTypes, err := buildAllFile(trees)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	r, w, _ := os.Pipe()
+	stdout := os.Stdout
+	os.Stdout = w
+	for _, classType := range classTypes {
+		if _, err := runTestClass(classTypes, classType, 1); err != nil {
+			fmt.Println(err)
+		}
+	}
+	w.Close()
+	os.Stdout = stdout
+	output, _ := ioutil.ReadAll(r)
+	for _, line := range strings.Split(string(output), "\n") {
+		if line = testOutputPattern.ReplaceAllString(line, ""); strings.TrimSpace(line) != "" {
+			fmt.Println(line)
+		}
+	}
+}
+
+// @testSetup data is restored before each test method, and org data is hidden unless SeeAllData=true
+func ExampleTestSetup() {
+	useDatabase()
+	builtin.DatabaseDriver.ExecuteRaw("INSERT INTO Account(Id, Name) VALUES ('001000000000000', 'org')")
+	defer builtin.DatabaseDriver.ExecuteRaw("DELETE FROM Account")
+	runTestFixture("fixtures/test_setup.cls")
+	// Unordered output:
+	// FooTest#insertFirst: pass
+	// FooTest#insertSecond: pass
+	// FooTest#hideOrgData: pass
+	// FooTest#seeOrgData: pass
+}
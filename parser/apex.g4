@@ -405,10 +405,15 @@ whenStatement
     ;
 
 whenExpression
-    :   literal (',' literal)*
+    :   whenLiteral (',' whenLiteral)*
     |   apexType apexIdentifier
     ;
 
+whenLiteral
+    :   SUB? literal
+    |   Identifier
+    ;
+
 forControl
     :   enhancedForControl
     |   forInit? ';' expression? ';' forUpdate?
@@ -465,7 +470,7 @@ expression
     |   '(' apexType ')' expression                                 # CastExpression
     |   expression op=('++' | '--')                             # PostUnaryExpression
     |   op=('++'|'--') expression                               # PreUnaryExpression
-    |   op=('+'|'-'|'!') expression                             # UnaryExpression
+    |   op=('+'|'-'|'!'|'~') expression                         # UnaryExpression
     |   expression op=('*'|'/'|'%') expression                  # OpExpression
     |   expression op=('+'|'-') expression                      # OpExpression
     |   expression (op+='<' op+='<' | op+='>' op+='>' op+='>' | op+='>' op+='>') expression # ShiftExpression
@@ -746,6 +751,7 @@ apexIdentifier
     |  FIELDS
     |  RUNAS
     |  SYSTEM
+    |  END
     |  primitiveType
     ;
 
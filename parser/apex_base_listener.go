@@ -445,6 +445,12 @@ func (s *BaseapexListener) EnterWhenExpression(ctx *WhenExpressionContext) {}
 // ExitWhenExpression is called when production whenExpression is exited.
 func (s *BaseapexListener) ExitWhenExpression(ctx *WhenExpressionContext) {}
 
+// EnterWhenLiteral is called when production whenLiteral is entered.
+func (s *BaseapexListener) EnterWhenLiteral(ctx *WhenLiteralContext) {}
+
+// ExitWhenLiteral is called when production whenLiteral is exited.
+func (s *BaseapexListener) ExitWhenLiteral(ctx *WhenLiteralContext) {}
+
 // EnterForControl is called when production forControl is entered.
 func (s *BaseapexListener) EnterForControl(ctx *ForControlContext) {}
 
@@ -288,6 +288,10 @@ func (v *BaseapexVisitor) VisitWhenExpression(ctx *WhenExpressionContext) interf
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseapexVisitor) VisitWhenLiteral(ctx *WhenLiteralContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseapexVisitor) VisitForControl(ctx *ForControlContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -218,6 +218,9 @@ type apexListener interface {
 	// EnterWhenExpression is called when entering the whenExpression production.
 	EnterWhenExpression(c *WhenExpressionContext)
 
+	// EnterWhenLiteral is called when entering the whenLiteral production.
+	EnterWhenLiteral(c *WhenLiteralContext)
+
 	// EnterForControl is called when entering the forControl production.
 	EnterForControl(c *ForControlContext)
 
@@ -635,6 +638,9 @@ type apexListener interface {
 	// ExitWhenExpression is called when exiting the whenExpression production.
 	ExitWhenExpression(c *WhenExpressionContext)
 
+	// ExitWhenLiteral is called when exiting the whenLiteral production.
+	ExitWhenLiteral(c *WhenLiteralContext)
+
 	// ExitForControl is called when exiting the forControl production.
 	ExitForControl(c *ForControlContext)
 
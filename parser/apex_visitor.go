@@ -218,6 +218,9 @@ type apexVisitor interface {
 	// Visit a parse tree produced by apexParser#whenExpression.
 	VisitWhenExpression(ctx *WhenExpressionContext) interface{}
 
+	// Visit a parse tree produced by apexParser#whenLiteral.
+	VisitWhenLiteral(ctx *WhenLiteralContext) interface{}
+
 	// Visit a parse tree produced by apexParser#forControl.
 	VisitForControl(ctx *ForControlContext) interface{}
 
@@ -0,0 +1,485 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chzyer/readline"
+	"github.com/fsnotify/fsnotify"
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+	"github.com/tzmfreedom/land/compiler"
+	"github.com/tzmfreedom/land/interpreter"
+)
+
+const (
+	replPrompt             = "\033[31m>>\033[0m "
+	replContinuationPrompt = "\033[31m..\033[0m "
+)
+
+var replCommands = []string{"execute", "exit", "reload", "run"}
+
+// repl is the session of `land run -i`.
+// Each input is compiled as anonymous Apex, and variables, methods and classes declared by it are kept for the following inputs.
+type repl struct {
+	interpreter *interpreter.Interpreter
+	env         *interpreter.Env
+	variables   map[string]*replVariable
+	// members are methods and classes declared by previous inputs, they are compiled again with each input
+	members []ast.Node
+	stdout  io.Writer
+}
+
+// replVariable is the declared type of the variable, the value is kept in the env
+type replVariable struct {
+	name      string
+	classType *ast.ClassType
+}
+
+func newRepl(classTypes []*ast.ClassType) *repl {
+	i := interpreter.NewInterpreterWithBuiltin(classTypes)
+	i.LoadStaticField()
+	return &repl{
+		interpreter: i,
+		env:         interpreter.NewEnv(nil),
+		variables:   map[string]*replVariable{},
+		members:     []ast.Node{},
+		stdout:      os.Stdout,
+	}
+}
+
+// eval compiles the input and runs it, the value is printed if the last statement is an expression.
+// Errors and panics of the input are returned, so that the session continues.
+func (r *repl) eval(input string) (err error) {
+	src := strings.TrimSpace(input)
+	if src == "" {
+		return nil
+	}
+	// the last expression need not end with semicolon, such as `1 + 2`
+	if !strings.HasSuffix(src, ";") && !strings.HasSuffix(src, "}") {
+		src += ";"
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			fmt.Fprintf(os.Stderr, "%s", debug.Stack())
+			err = fmt.Errorf("panic: %v", recovered)
+		}
+	}()
+
+	classType, statements, err := r.compile(src)
+	if err != nil {
+		return err
+	}
+	r.interpreter.Context.ClassTypes.Set(classType.Name, classType)
+	value, err := r.interpreter.EvaluateStatements(classType, statements, r.env)
+	// variables declared before the error are kept as Apex keeps them
+	r.declare(statements)
+	if err != nil {
+		return err
+	}
+	if value != nil && isReplExpression(statements[len(statements)-1]) {
+		fmt.Fprintf(r.stdout, "=> %s\n", builtin.String(value))
+	}
+	return nil
+}
+
+// compile compiles the input with the members of previous inputs, and returns top level statements of it.
+// Variables of previous inputs are parameters of AnonymousBlock.execute, so that the type checker knows them.
+func (r *repl) compile(src string) (*ast.ClassType, []ast.Node, error) {
+	t, err := ast.ParseAnonymous(src, "<repl>", preprocessors...)
+	if err != nil {
+		return nil, nil, err
+	}
+	decl := t.(*ast.ClassDeclaration)
+	// the last declaration is AnonymousBlock.execute, the others are declared by the input and replace the previous ones
+	declared := decl.Declarations[:len(decl.Declarations)-1]
+	redeclared := map[string]bool{}
+	for _, member := range declared {
+		redeclared[strings.ToLower(replMemberName(member))] = true
+	}
+	members := []ast.Node{}
+	for _, member := range r.members {
+		if !redeclared[strings.ToLower(replMemberName(member))] {
+			members = append(members, member)
+		}
+	}
+	members = append(members, declared...)
+	decl.Declarations = append(append([]ast.Node{}, members...), decl.Declarations[len(decl.Declarations)-1])
+
+	classType, err := register(decl, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	tmpClassMap := builtin.PrimitiveClassMap()
+	for _, classType := range classMap.Data {
+		tmpClassMap.Set(classType.Name, classType)
+	}
+	classType, err = convert(classType, tmpClassMap)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := compiler.CheckClass(classType); err != nil {
+		return nil, nil, err
+	}
+	methods, _ := classType.StaticMethods.Get(ast.AnonymousMethodName)
+	execute := methods[0]
+	statements := execute.Statements.Statements
+	redeclared = map[string]bool{}
+	for _, stmt := range statements {
+		if variable, ok := stmt.(*ast.VariableDeclaration); ok {
+			for _, d := range variable.Declarators {
+				redeclared[strings.ToLower(d.Name)] = true
+			}
+		}
+	}
+	for key, variable := range r.variables {
+		if !redeclared[key] {
+			execute.Parameters = append(execute.Parameters, &ast.Parameter{Name: variable.name, Type: variable.classType})
+		}
+	}
+	if err := semanticAnalysis(classType); err != nil {
+		return nil, nil, err
+	}
+	r.members = members
+	return classType, statements, nil
+}
+
+// declare records the types of variables declared at the top level, if they are defined by the execution
+func (r *repl) declare(statements []ast.Node) {
+	for _, stmt := range statements {
+		variable, ok := stmt.(*ast.VariableDeclaration)
+		if !ok {
+			continue
+		}
+		for _, d := range variable.Declarators {
+			if _, ok := r.env.Data.Get(d.Name); ok {
+				r.variables[strings.ToLower(d.Name)] = &replVariable{name: d.Name, classType: variable.Type}
+			}
+		}
+	}
+}
+
+func replMemberName(n ast.Node) string {
+	switch member := n.(type) {
+	case *ast.MethodDeclaration:
+		return member.Name
+	case *ast.ClassDeclaration:
+		return member.Name
+	case *ast.InterfaceDeclaration:
+		return member.Name
+	case *ast.EnumDeclaration:
+		return member.Name
+	}
+	return ""
+}
+
+// isReplExpression returns false for declarations and assignments, whose values are not printed
+func isReplExpression(n ast.Node) bool {
+	switch stmt := n.(type) {
+	case *ast.VariableDeclaration:
+		return false
+	case *ast.BinaryOperator:
+		switch stmt.Op {
+		case "==", "!=", "<=", ">=", "===", "!==":
+			return true
+		}
+		return !strings.HasSuffix(stmt.Op, "=")
+	}
+	return true
+}
+
+// completions returns names which start with the word, the word is `prefix` or `receiver.prefix`.
+// The receiver is a variable or a class, and its fields, methods and inner classes are completed.
+func (r *repl) completions(word string, commands bool) []string {
+	names := []string{}
+	prefix := word
+	if i := strings.LastIndex(word, "."); i >= 0 {
+		receiver := word[:i]
+		prefix = word[i+1:]
+		if variable, ok := r.variables[strings.ToLower(receiver)]; ok {
+			names = append(names, instanceMemberNames(variable.classType)...)
+		} else if classType, ok := r.lookupClass(receiver); ok {
+			names = append(names, staticMemberNames(classType)...)
+		}
+	} else {
+		for _, variable := range r.variables {
+			names = append(names, variable.name)
+		}
+		for _, member := range r.members {
+			names = append(names, replMemberName(member))
+		}
+		for _, classType := range r.interpreter.Context.ClassTypes.Data {
+			if classType.Name != ast.AnonymousClassName {
+				names = append(names, classType.Name)
+			}
+		}
+		if commands {
+			names = append(names, replCommands...)
+		}
+	}
+
+	candidates := []string{}
+	seen := map[string]bool{}
+	for _, name := range names {
+		if name == "" || seen[name] || !strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+			continue
+		}
+		seen[name] = true
+		candidates = append(candidates, name)
+	}
+	sort.Strings(candidates)
+	return candidates
+}
+
+// lookupClass finds the class of the name such as Account or Outer.Inner
+func (r *repl) lookupClass(name string) (*ast.ClassType, bool) {
+	parts := strings.Split(name, ".")
+	classType, ok := r.interpreter.Context.ClassTypes.Get(parts[0])
+	for _, part := range parts[1:] {
+		if !ok || classType.InnerClasses == nil {
+			return nil, false
+		}
+		classType, ok = classType.InnerClasses.Get(part)
+	}
+	return classType, ok
+}
+
+func instanceMemberNames(classType *ast.ClassType) []string {
+	names := []string{}
+	for classType != nil {
+		if classType.InstanceFields != nil {
+			for _, field := range classType.InstanceFields.Data {
+				names = append(names, field.Name)
+			}
+		}
+		if classType.InstanceMethods != nil {
+			names = append(names, methodNames(classType.InstanceMethods)...)
+		}
+		classType = classType.SuperClass
+	}
+	return names
+}
+
+func staticMemberNames(classType *ast.ClassType) []string {
+	names := []string{}
+	if classType.StaticFields != nil {
+		for _, field := range classType.StaticFields.Data {
+			names = append(names, field.Name)
+		}
+	}
+	if classType.StaticMethods != nil {
+		names = append(names, methodNames(classType.StaticMethods)...)
+	}
+	if classType.InnerClasses != nil {
+		for _, inner := range classType.InnerClasses.Data {
+			names = append(names, inner.Name)
+		}
+	}
+	return names
+}
+
+func methodNames(methods *ast.MethodMap) []string {
+	names := []string{}
+	for _, overloads := range methods.Data {
+		if len(overloads) > 0 {
+			names = append(names, overloads[0].Name)
+		}
+	}
+	return names
+}
+
+// replCompleter completes the word before the cursor by TAB
+type replCompleter struct {
+	repl *repl
+}
+
+func (c *replCompleter) Do(line []rune, pos int) ([][]rune, int) {
+	start := pos
+	for start > 0 && isReplWordRune(line[start-1]) {
+		start--
+	}
+	word := string(line[start:pos])
+	prefix := word
+	if i := strings.LastIndex(word, "."); i >= 0 {
+		prefix = word[i+1:]
+	}
+	commands := strings.TrimSpace(string(line[:start])) == ""
+	candidates := [][]rune{}
+	for _, name := range c.repl.completions(word, commands) {
+		candidates = append(candidates, []rune(name[len(prefix):]))
+	}
+	return candidates, len([]rune(prefix))
+}
+
+func isReplWordRune(r rune) bool {
+	return r == '_' || r == '.' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+// interactiveRun reads Apex from the prompt, lines are joined until brackets are closed.
+// The commands are available at the first line:
+//
+//	run Class#method      runs the method, classes are reloaded if the files are modified
+//	execute Class#method  runs the method
+//	reload [file]         reloads the file or all files
+//	exit
+func interactiveRun(classTypes []*ast.ClassType, files []string) error {
+	lastReloadedAt := time.Now()
+	r := newRepl(classTypes)
+	l, err := readline.NewEx(&readline.Config{
+		Prompt:          replPrompt,
+		HistoryFile:     "/tmp/land.tmp",
+		InterruptPrompt: "^C",
+		EOFPrompt:       "exit",
+		AutoComplete:    &replCompleter{repl: r},
+	})
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	ch := make(chan bool, 1)
+	go func() {
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				ch <- true
+				if event.Op&fsnotify.Write == fsnotify.Write {
+					buildFile(r.interpreter, event.Name)
+				} else if event.Op&fsnotify.Create == fsnotify.Create {
+					buildFile(r.interpreter, event.Name)
+				}
+				<-ch
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				fmt.Println("error:", err)
+			}
+		}
+	}()
+	directories := map[string]bool{}
+	for _, f := range files {
+		directories[filepath.Dir(f)] = true
+	}
+	for directory := range directories {
+		if err := watcher.Add(directory); err != nil {
+			return err
+		}
+	}
+
+	buffer := ""
+	for {
+		line, err := l.Readline()
+		if err == readline.ErrInterrupt {
+			buffer = ""
+			l.SetPrompt(replPrompt)
+			continue
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if buffer == "" {
+			inputs := strings.Fields(line)
+			cmd := ""
+			if len(inputs) > 0 {
+				cmd = inputs[0]
+			}
+			args := []string{}
+			if len(inputs) > 1 {
+				args = inputs[1:]
+			}
+			switch cmd {
+			case "execute":
+				if len(args) == 0 {
+					fmt.Println("Error: execute command required argument")
+					continue
+				}
+				ch <- true
+				if err := run(args[0], classTypes); err != nil {
+					fmt.Println(err.Error())
+				}
+				<-ch
+				continue
+			case "reload":
+				ch <- true
+				if len(args) == 0 {
+					err := reloadAll(r.interpreter, files)
+					if err != nil {
+						<-ch
+						return err
+					}
+				} else {
+					_, err := buildFile(r.interpreter, args[0])
+					if err != nil {
+						fmt.Println(err.Error())
+					}
+				}
+				<-ch
+				continue
+			case "run":
+				if len(args) == 0 {
+					fmt.Println("Error: run command required argument")
+					continue
+				}
+				isReload := false
+				for _, f := range files {
+					info, err := os.Stat(f)
+					if err != nil {
+						return err
+					}
+					if info.ModTime().After(lastReloadedAt) {
+						isReload = true
+						break
+					}
+				}
+				ch <- true
+				if isReload {
+					lastReloadedAt = time.Now()
+					err := reloadAll(r.interpreter, files)
+					if err != nil {
+						<-ch
+						return err
+					}
+				}
+				if err := run(args[0], classTypes); err != nil {
+					fmt.Println(err.Error())
+				}
+				<-ch
+				continue
+			case "exit":
+				return nil
+			}
+		}
+
+		buffer += line + "\n"
+		if ast.IsIncomplete(buffer) {
+			l.SetPrompt(replContinuationPrompt)
+			continue
+		}
+		input := buffer
+		buffer = ""
+		l.SetPrompt(replPrompt)
+		ch <- true
+		if err := r.eval(input); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+		}
+		<-ch
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestReplEval(t *testing.T) {
+	setup()
+	r := newRepl(nil)
+	stdout := new(bytes.Buffer)
+	r.stdout = stdout
+	r.interpreter.Extra["stdout"] = stdout
+
+	inputs := []string{
+		"Integer x = 1;",
+		"for (Integer i = 1; i <= 3; i++) {\n  x += i;\n}",
+		"x",
+		"Integer twice(Integer n) { return n * 2; }",
+		"twice(x)",
+		"System.debug('hello')",
+		"String s = 'a';",
+		"s + String.valueOf(twice(1))",
+	}
+	for _, input := range inputs {
+		if err := r.eval(input); err != nil {
+			t.Fatalf("unexpected error raised: %s: %s", input, err.Error())
+		}
+	}
+	expected := "=> 7\n=> 14\nhello\n=> a2\n"
+	if stdout.String() != expected {
+		t.Errorf("expected %q, actual %q", expected, stdout.String())
+	}
+
+	// errors do not discard variables and methods
+	invalidInputs := []string{
+		"Integer y = ;",
+		"String s = x;",
+		"x / 0",
+	}
+	for _, input := range invalidInputs {
+		if err := r.eval(input); err == nil {
+			t.Errorf("expected error is not raised: %s", input)
+		}
+	}
+	// unknown types are reported as land exec reports them
+	for _, input := range []string{"Foo y;", "Foo f() { return null; }", "void g(Foo x) {}", "try {} catch (Foo e) {}"} {
+		if err := r.eval(input); err == nil || err.Error() != "Foo does not found" {
+			t.Errorf("expected Foo does not found, actual %v: %s", err, input)
+		}
+	}
+	stdout.Reset()
+	if err := r.eval("twice(x)"); err != nil {
+		t.Fatalf("unexpected error raised: %s", err.Error())
+	}
+	if stdout.String() != "=> 14\n" {
+		t.Errorf("expected %q, actual %q", "=> 14\n", stdout.String())
+	}
+}
+
+func TestReplCompletions(t *testing.T) {
+	setup()
+	r := newRepl(nil)
+	r.stdout = new(bytes.Buffer)
+	for _, input := range []string{"String greeting = 'a';", "Integer twice(Integer n) { return n * 2; }"} {
+		if err := r.eval(input); err != nil {
+			t.Fatalf("unexpected error raised: %s", err.Error())
+		}
+	}
+
+	testCases := []struct {
+		Word     string
+		Commands bool
+		Expected []string
+	}{
+		{"gree", false, []string{"greeting"}},
+		{"TW", false, []string{"twice"}},
+		{"Syst", false, []string{"System"}},
+		{"exi", true, []string{"exit"}},
+		{"exi", false, []string{}},
+		{"greeting.toUpper", false, []string{"toUpperCase"}},
+		{"Math.fl", false, []string{"floor"}},
+	}
+	for _, testCase := range testCases {
+		actual := r.completions(testCase.Word, testCase.Commands)
+		if !reflect.DeepEqual(testCase.Expected, actual) {
+			t.Errorf("%s: expected %v, actual %v", testCase.Word, testCase.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+	"github.com/tzmfreedom/land/compiler"
+	"github.com/tzmfreedom/land/interpreter"
+)
+
+// DefaultTimeout is the timeout of a request, the interpreter is interrupted after it
+const DefaultTimeout = 10 * time.Second
+
+// Default caps of the code run by eval-server, the execution time is shorter than the timeout so that LimitException is reported
+const (
+	DefaultMaxExecutionTime = 5 * time.Second
+	DefaultMaxStatements    = 1000000
+	DefaultMaxHeapMB        = 64
+)
+
+// Sizes of the requests of eval-server. The code is limited more strictly,
+// because parsing and formatting can not be interrupted and keep running after the timeout.
+const (
+	maxRequestSize = 1 << 20
+	maxSourceSize  = 64 << 10
+)
+
+// ErrorResponse is the JSON error of the servers, such as
+//
+//	{"errorCode":"APEX_ERROR","message":"Script-thrown exception","exceptionType":"MyException","location":{"line":3,"column":8}}
+//
+// /{Class}/{method} of land server writes it as an object. Apex REST endpoints under /services/apexrest write it
+// in an array, because Salesforce does so and REST clients written for Salesforce expect it.
+// eval-server embeds it in EvalResult.
+type ErrorResponse struct {
+	ErrorCode     string         `json:"errorCode"`
+	Message       string         `json:"message"`
+	ExceptionType string         `json:"exceptionType,omitempty"`
+	Location      *ErrorLocation `json:"location,omitempty"`
+}
+
+type ErrorLocation struct {
+	FileName string `json:"fileName,omitempty"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+}
+
+// requestError is the error of the request itself, such as invalid JSON
+type requestError struct {
+	statusCode int
+	errorCode  string
+	message    string
+}
+
+func (e *requestError) Error() string {
+	return e.message
+}
+
+func newRequestError(statusCode int, errorCode string, format string, args ...interface{}) *requestError {
+	return &requestError{statusCode: statusCode, errorCode: errorCode, message: fmt.Sprintf(format, args...)}
+}
+
+// compileError is the error reported by the type checker
+type compileError struct {
+	message string
+	node    ast.Node
+}
+
+func (e *compileError) Error() string {
+	return e.message
+}
+
+func newCompileError(errors []*compiler.Error) *compileError {
+	return &compileError{message: errors[0].Message, node: errors[0].Node}
+}
+
+type timeoutError struct {
+	timeout time.Duration
+}
+
+func (e *timeoutError) Error() string {
+	return fmt.Sprintf("Request timed out after %s", e.timeout)
+}
+
+// panicError is the panic recovered in the interpreter, it is reported as internal error
+type panicError struct {
+	value interface{}
+}
+
+func (e *panicError) Error() string {
+	return fmt.Sprintf("Internal error: %v", e.value)
+}
+
+// runIsolated runs the function in its own goroutine, so that panics are recovered as errors.
+// The interpreter is interrupted if the function does not finish within the timeout, it may be nil if nothing is interpreted.
+func runIsolated(i *interpreter.Interpreter, timeout time.Duration, f func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				fmt.Fprintf(os.Stderr, "panic: %v\n%s", r, debug.Stack())
+				done <- &panicError{value: r}
+			}
+		}()
+		done <- f()
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		if i != nil {
+			i.Interrupt()
+		}
+		return &timeoutError{timeout: timeout}
+	}
+}
+
+// requestSavepoint is the savepoint of the changes of a request
+const requestSavepoint = "request"
+
+// transactionLock serializes the transactions of requests, because the savepoints are shared by the single connection of the database
+var transactionLock sync.Mutex
+
+// runTransaction runs f in its own savepoint, it is called in runIsolated so that it ends with the goroutine of the request.
+// The changes are rolled back if f fails or panics, if the interpreter is interrupted by the timeout, or if rollback is true.
+func runTransaction(i *interpreter.Interpreter, rollback bool, f func() error) error {
+	transactionLock.Lock()
+	defer transactionLock.Unlock()
+	if err := builtin.DatabaseDriver.Savepoint(requestSavepoint); err != nil {
+		return err
+	}
+	commit := false
+	defer func() {
+		if !commit {
+			builtin.DatabaseDriver.RollbackTo(requestSavepoint)
+		}
+		builtin.DatabaseDriver.Release(requestSavepoint)
+	}()
+	err := f()
+	commit = err == nil && !rollback && !i.Interrupted()
+	return err
+}
+
+// newErrorResponse converts the error to the status code and the JSON error with the exception type and the location
+func newErrorResponse(err error) (int, *ErrorResponse) {
+	switch e := err.(type) {
+	case *requestError:
+		return e.statusCode, &ErrorResponse{ErrorCode: e.errorCode, Message: e.message}
+	case *compileError:
+		res := &ErrorResponse{ErrorCode: "COMPILE_ERROR", Message: e.message}
+		if e.node != nil {
+			res.Location = newErrorLocation(e.node.GetLocation())
+		}
+		return http.StatusBadRequest, res
+	case *timeoutError:
+		return http.StatusGatewayTimeout, &ErrorResponse{ErrorCode: "REQUEST_TIMEOUT", Message: e.Error()}
+	case *panicError:
+		return http.StatusInternalServerError, &ErrorResponse{ErrorCode: "INTERNAL_ERROR", Message: e.Error()}
+	case *builtin.ExceptionError:
+		message := ""
+		if m, ok := e.Exception.Extra["message"].(*ast.Object); ok && m != builtin.Null {
+			message = m.StringValue()
+		}
+		return http.StatusInternalServerError, &ErrorResponse{
+			ErrorCode:     "APEX_ERROR",
+			Message:       message,
+			ExceptionType: e.Exception.ClassType.Name,
+			Location:      newErrorLocation(e.GetLocation()),
+		}
+	case *builtin.NullPointerException:
+		// the name is the null expression, such as `a` of `a.size()` and `a + 1`
+		return http.StatusInternalServerError, &ErrorResponse{
+			ErrorCode:     "APEX_ERROR",
+			Message:       "Attempt to de-reference a null object: " + e.GetName(),
+			ExceptionType: "NullPointerException",
+			Location:      newErrorLocation(e.GetLocation()),
+		}
+	}
+	return http.StatusInternalServerError, &ErrorResponse{ErrorCode: "APEX_ERROR", Message: err.Error()}
+}
+
+func newErrorLocation(location *ast.Location) *ErrorLocation {
+	if location == nil {
+		return nil
+	}
+	return &ErrorLocation{FileName: location.FileName, Line: location.Line, Column: location.Column}
+}
+
+// writeJson writes the value as JSON response with the status code
+func writeJson(w http.ResponseWriter, statusCode int, value interface{}) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		statusCode = http.StatusInternalServerError
+		body = []byte(`{"errorCode":"INTERNAL_ERROR","message":"failed to encode the response"}`)
+	}
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(statusCode)
+	w.Write(body)
+}
+
+// writeError writes the error as a JSON object
+func writeError(w http.ResponseWriter, err error) {
+	statusCode, res := newErrorResponse(err)
+	writeJson(w, statusCode, res)
+}
@@ -0,0 +1,290 @@
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+	"github.com/tzmfreedom/land/compiler"
+)
+
+func TestNewErrorResponse(t *testing.T) {
+	location := &ast.Location{FileName: "Foo.cls", Line: 3, Column: 8}
+	exception := builtin.NewException(builtin.TypeExceptionType, "Invalid integer: a")
+	builtin.SetExceptionLocation(exception, location)
+	testCases := []struct {
+		Error      error
+		StatusCode int
+		Expected   ErrorResponse
+	}{
+		{
+			newRequestError(http.StatusNotFound, "NOT_FOUND", "Class not found: %s", "Foo"),
+			http.StatusNotFound,
+			ErrorResponse{ErrorCode: "NOT_FOUND", Message: "Class not found: Foo"},
+		},
+		{
+			newCompileError([]*compiler.Error{{Message: "variable not found", Node: &ast.Name{Location: location}}}),
+			http.StatusBadRequest,
+			ErrorResponse{ErrorCode: "COMPILE_ERROR", Message: "variable not found", Location: &ErrorLocation{FileName: "Foo.cls", Line: 3, Column: 8}},
+		},
+		{
+			&timeoutError{timeout: time.Second},
+			http.StatusGatewayTimeout,
+			ErrorResponse{ErrorCode: "REQUEST_TIMEOUT", Message: "Request timed out after 1s"},
+		},
+		{
+			&panicError{value: "boom"},
+			http.StatusInternalServerError,
+			ErrorResponse{ErrorCode: "INTERNAL_ERROR", Message: "Internal error: boom"},
+		},
+		{
+			builtin.NewExceptionError(exception),
+			http.StatusInternalServerError,
+			ErrorResponse{ErrorCode: "APEX_ERROR", Message: "Invalid integer: a", ExceptionType: "TypeException", Location: &ErrorLocation{FileName: "Foo.cls", Line: 3, Column: 8}},
+		},
+		{
+			builtin.NewNullPointerExceptionAt("a", location),
+			http.StatusInternalServerError,
+			ErrorResponse{ErrorCode: "APEX_ERROR", Message: "Attempt to de-reference a null object: a", ExceptionType: "NullPointerException", Location: &ErrorLocation{FileName: "Foo.cls", Line: 3, Column: 8}},
+		},
+		{
+			errors.New("unknown"),
+			http.StatusInternalServerError,
+			ErrorResponse{ErrorCode: "APEX_ERROR", Message: "unknown"},
+		},
+	}
+	for i, testCase := range testCases {
+		statusCode, res := newErrorResponse(testCase.Error)
+		if statusCode != testCase.StatusCode {
+			t.Errorf("%d: expected status %d, actual %d", i, testCase.StatusCode, statusCode)
+		}
+		expected, _ := json.Marshal(testCase.Expected)
+		actual, _ := json.Marshal(res)
+		if !bytes.Equal(expected, actual) {
+			t.Errorf("%d: expected %s, actual %s", i, expected, actual)
+		}
+	}
+}
+
+func TestRunIsolated(t *testing.T) {
+	err := runIsolated(nil, time.Second, func() error {
+		panic("boom")
+	})
+	if _, ok := err.(*panicError); !ok {
+		t.Errorf("expected panicError, actual %v", err)
+	}
+	err = runIsolated(nil, 10*time.Millisecond, func() error {
+		time.Sleep(time.Second)
+		return nil
+	})
+	if _, ok := err.(*timeoutError); !ok {
+		t.Errorf("expected timeoutError, actual %v", err)
+	}
+}
+
+// TestEvalError checks the exception type, the message and the location of errors of eval-server.
+// Lines are counted from the first line of the code.
+func TestEvalError(t *testing.T) {
+	testCases := []struct {
+		Code          string
+		Error         string
+		ExceptionType string
+		Line          int
+		Column        int
+	}{
+		{"Integer a;\nInteger b = a + 1", "NullPointerException: Attempt to de-reference a null object: a", "NullPointerException", 2, 12},
+		{"Integer a = 1;\nInteger b;\nInteger c = a * b", "NullPointerException: Attempt to de-reference a null object: b", "NullPointerException", 3, 16},
+		{"Integer a;\na++", "NullPointerException: Attempt to de-reference a null object: a", "NullPointerException", 2, 0},
+		{"String s;\nInteger i = s.length()", "NullPointerException: Attempt to de-reference a null object: s", "NullPointerException", 2, 12},
+		{"Integer i = 1 / 0", "MathException: Divide by 0", "MathException", 1, 12},
+	}
+	s := &EvalServer{}
+	for i, testCase := range testCases {
+		body, _ := json.Marshal(&EvalRequest{String: base64.StdEncoding.EncodeToString([]byte(testCase.Code))})
+		w := httptest.NewRecorder()
+		s.eval(w, httptest.NewRequest("POST", "/eval", bytes.NewReader(body)))
+		res := &EvalResult{}
+		if err := json.Unmarshal(w.Body.Bytes(), res); err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err.Error())
+			continue
+		}
+		if res.Result || res.Error != testCase.Error || res.ExceptionType != testCase.ExceptionType {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Error, w.Body.String())
+			continue
+		}
+		if res.Location == nil || res.Location.Line != testCase.Line || res.Location.Column != testCase.Column {
+			t.Errorf("%d: expected %d:%d, actual %s", i, testCase.Line, testCase.Column, w.Body.String())
+		}
+	}
+}
+
+// TestEvalSandbox checks that the caps of eval-server stop the code, including the natives which build large values
+func TestEvalSandbox(t *testing.T) {
+	testCases := []struct {
+		Code  string
+		Error string
+	}{
+		{"String s = 'x';\nwhile (true) {\ns += s;\n}", "LimitException: Apex heap size too large: "},
+		{"List<String> l = new List<String>();\nwhile (true) {\nl.add('x');\n}", "LimitException: Apex heap size too large: "},
+		{"Integer[] l = new Integer[100000000]", "LimitException: Apex heap size too large: "},
+		{"String s = 'x'.rightPad(100000000)", "LimitException: Apex heap size too large: "},
+		{"String s = 'a'.rightPad(1000, 'a').replace('a', 'b'.rightPad(2000, 'b'))", "LimitException: Apex heap size too large: "},
+		{"Matcher m = Pattern.compile('a').matcher('a'.rightPad(1000, 'a'));\nString s = m.replaceAll('b'.rightPad(2000, 'b'))", "LimitException: Apex heap size too large: "},
+		{"List<Object> l = new List<Object>();\nl.add(l);\nString s = JSON.serialize(l)", "LimitException: Maximum stack depth reached: 1001"},
+		{"while (true) {}", "LimitException: Too many code statements: 100001"},
+	}
+	s := &EvalServer{MaxStatements: 100000, MaxHeapSize: 1 << 18}
+	for i, testCase := range testCases {
+		body, _ := json.Marshal(&EvalRequest{String: base64.StdEncoding.EncodeToString([]byte(testCase.Code))})
+		w := httptest.NewRecorder()
+		s.eval(w, httptest.NewRequest("POST", "/eval", bytes.NewReader(body)))
+		res := &EvalResult{}
+		if err := json.Unmarshal(w.Body.Bytes(), res); err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err.Error())
+			continue
+		}
+		if res.Result || !strings.HasPrefix(res.Error, testCase.Error) || res.ExceptionType != "LimitException" {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Error, w.Body.String())
+		}
+	}
+
+	// elements returned by the collection are not counted again
+	code := "String s = 'x'.rightPad(100000);\nList<String> l = new List<String>{ s };\nfor (Integer i = 0; i < 100; i++) {\nString t = l.get(0);\n}"
+	body, _ := json.Marshal(&EvalRequest{String: base64.StdEncoding.EncodeToString([]byte(code))})
+	w := httptest.NewRecorder()
+	s.eval(w, httptest.NewRequest("POST", "/eval", bytes.NewReader(body)))
+	res := &EvalResult{}
+	if err := json.Unmarshal(w.Body.Bytes(), res); err != nil || !res.Result {
+		t.Errorf("unexpected error: %s", w.Body.String())
+	}
+}
+
+func TestDecodeSource(t *testing.T) {
+	b, err := decodeSource(base64.StdEncoding.EncodeToString([]byte("Integer i = 1;")))
+	if err != nil || string(b) != "Integer i = 1;" {
+		t.Errorf("unexpected result: %s, %v", b, err)
+	}
+	testCases := []struct {
+		Encoded    string
+		StatusCode int
+	}{
+		{"not base64!", http.StatusBadRequest},
+		{base64.StdEncoding.EncodeToString(make([]byte, maxSourceSize+1)), http.StatusRequestEntityTooLarge},
+	}
+	for i, testCase := range testCases {
+		_, err := decodeSource(testCase.Encoded)
+		e, ok := err.(*requestError)
+		if !ok || e.statusCode != testCase.StatusCode {
+			t.Errorf("%d: expected %d, actual %v", i, testCase.StatusCode, err)
+		}
+	}
+}
+
+// useDatabase creates the tables of the sObjects in sobjects.yml.test, records of Account are deleted after the test
+func useDatabase(t *testing.T) {
+	builtin.LoadSObjectClass("../sobjects.yml.test")
+	if err := builtin.CreateDatabase("../sobjects.yml.test"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		builtin.DatabaseDriver.ExecuteRaw("DELETE FROM Account")
+	})
+}
+
+// compileClasses registers the classes and resolves their types as land server does
+func compileClasses(t *testing.T, sources ...string) []*ast.ClassType {
+	classTypes := make([]*ast.ClassType, len(sources))
+	for i, src := range sources {
+		root, err := ast.ParseString(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		classType, err := root.Accept(&compiler.ClassRegisterVisitor{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		classTypes[i] = classType.(*ast.ClassType)
+	}
+	resolver := compiler.NewTypeRefResolver(builtin.NewClassMapWithPrimivie(classTypes), builtin.GetNameSpaceStore())
+	for i, classType := range classTypes {
+		resolved, err := resolver.Resolve(classType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		classTypes[i] = resolved
+	}
+	return classTypes
+}
+
+// TestRequestTransaction checks that the changes of a failed request are rolled back
+func TestRequestTransaction(t *testing.T) {
+	useDatabase(t)
+	classTypes := compileClasses(t, `public class Foo {
+	public static Integer ok() {
+		insert new Account(Name = 'kept');
+		return [SELECT Id FROM Account].size();
+	}
+	public static void fail() {
+		insert new Account(Name = 'rolled');
+		String s;
+		s.length();
+	}
+	public static Integer count() {
+		return [SELECT Id FROM Account].size();
+	}
+}`)
+	s := &Server{classMap: builtin.NewClassMapWithPrimivie(classTypes)}
+	testCases := []struct {
+		Path       string
+		StatusCode int
+		Body       string
+	}{
+		{"/Foo/fail", http.StatusInternalServerError, ""},
+		{"/Foo/count", http.StatusOK, "0\n"},
+		{"/Foo/ok", http.StatusOK, "1\n"},
+		{"/Foo/count", http.StatusOK, "1\n"},
+	}
+	for i, testCase := range testCases {
+		w := httptest.NewRecorder()
+		s.serveInvocation(w, httptest.NewRequest("POST", testCase.Path, strings.NewReader("[]")))
+		if w.Code != testCase.StatusCode || (testCase.Body != "" && w.Body.String() != testCase.Body) {
+			t.Errorf("%d: expected %d %s, actual %d %s", i, testCase.StatusCode, testCase.Body, w.Code, w.Body.String())
+		}
+	}
+}
+
+// TestEvalRollback checks that the changes of the code of eval-server are not visible to the other users
+func TestEvalRollback(t *testing.T) {
+	useDatabase(t)
+	testCases := []struct {
+		Code   string
+		Output string
+	}{
+		{"insert new Account(Name = 'a');\nSystem.debug([SELECT Id FROM Account].size())", "1"},
+		{"insert new Account(Name = 'b');\nSystem.debug([SELECT Id FROM Account].size())", "1"},
+		{"System.debug([SELECT Id FROM Account].size())", "0"},
+	}
+	s := &EvalServer{}
+	for i, testCase := range testCases {
+		body, _ := json.Marshal(&EvalRequest{String: base64.StdEncoding.EncodeToString([]byte(testCase.Code))})
+		w := httptest.NewRecorder()
+		s.eval(w, httptest.NewRequest("POST", "/eval", bytes.NewReader(body)))
+		res := &EvalResult{}
+		if err := json.Unmarshal(w.Body.Bytes(), res); err != nil || !res.Result {
+			t.Errorf("%d: unexpected error: %s", i, w.Body.String())
+			continue
+		}
+		output, _ := base64.StdEncoding.DecodeString(res.String)
+		if !strings.Contains(string(output), testCase.Output) {
+			t.Errorf("%d: expected %s, actual %s", i, testCase.Output, output)
+		}
+	}
+}
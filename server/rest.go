@@ -0,0 +1,191 @@
+package server
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
+)
+
+// RestPathPrefix is the path prefix of Apex REST services, such as /services/apexrest/accounts/001
+const RestPathPrefix = "/services/apexrest"
+
+// restMethodAnnotations maps the annotations of Apex REST methods to HTTP verbs
+var restMethodAnnotations = map[string]string{
+	"HttpGet":    http.MethodGet,
+	"HttpPost":   http.MethodPost,
+	"HttpPut":    http.MethodPut,
+	"HttpPatch":  http.MethodPatch,
+	"HttpDelete": http.MethodDelete,
+}
+
+// restResource is the class annotated with @RestResource(urlMapping='/accounts/*')
+type restResource struct {
+	classType  *ast.ClassType
+	urlMapping string
+	pattern    *regexp.Regexp
+	methods    map[string]*ast.Method
+}
+
+// newRestResources collects @RestResource classes, they are sorted so that the most specific url mapping is matched first
+func newRestResources(classTypes []*ast.ClassType) ([]*restResource, error) {
+	resources := []*restResource{}
+	for _, classType := range classTypes {
+		annotation := classType.GetAnnotation("RestResource")
+		if annotation == nil {
+			continue
+		}
+		value, ok := annotation.Parameter("urlMapping")
+		literal, isString := value.(*ast.StringLiteral)
+		if !ok || !isString || !strings.HasPrefix(literal.Value, "/") {
+			return nil, fmt.Errorf("%s: urlMapping of @RestResource must be a string which starts with /", classType.Name)
+		}
+		resource := &restResource{
+			classType:  classType,
+			urlMapping: literal.Value,
+			pattern:    urlMappingPattern(literal.Value),
+			methods:    map[string]*ast.Method{},
+		}
+		for _, methods := range classType.StaticMethods.Data {
+			for _, m := range methods {
+				for annotation, verb := range restMethodAnnotations {
+					if !m.IsAnnotated(annotation) {
+						continue
+					}
+					if _, ok := resource.methods[verb]; ok {
+						return nil, fmt.Errorf("%s: only one method can be annotated with @%s", classType.Name, annotation)
+					}
+					resource.methods[verb] = m
+				}
+			}
+		}
+		resources = append(resources, resource)
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		return len(strings.Replace(resources[i].urlMapping, "*", "", -1)) > len(strings.Replace(resources[j].urlMapping, "*", "", -1))
+	})
+	return resources, nil
+}
+
+// urlMappingPattern converts the url mapping to the regexp, * matches any characters.
+// Trailing /* also matches the path without it, /accounts/* matches /accounts.
+func urlMappingPattern(urlMapping string) *regexp.Regexp {
+	suffix := ""
+	if strings.HasSuffix(urlMapping, "/*") {
+		urlMapping = strings.TrimSuffix(urlMapping, "/*")
+		suffix = "(/.*)?"
+	}
+	parts := strings.Split(urlMapping, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + suffix + "$")
+}
+
+func findRestResource(resources []*restResource, path string) *restResource {
+	for _, resource := range resources {
+		if resource.pattern.MatchString(path) {
+			return resource
+		}
+	}
+	return nil
+}
+
+// allowedMethods returns the HTTP verbs of the resource for the error message
+func (r *restResource) allowedMethods() string {
+	verbs := []string{}
+	for verb := range r.methods {
+		verbs = append(verbs, verb)
+	}
+	sort.Strings(verbs)
+	return strings.Join(verbs, ", ")
+}
+
+// serveRest calls the Apex REST method of the request with RestContext.request and RestContext.response on a fresh interpreter.
+// The return value of the method is serialized as JSON, or RestContext.response.responseBody is sent if it returns nothing.
+func (s *Server) serveRest(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, RestPathPrefix)
+	resource := findRestResource(s.resources, path)
+	if resource == nil {
+		writeRestError(w, newRequestError(http.StatusNotFound, "NOT_FOUND", "Could not find a match for URL"))
+		return
+	}
+	m, ok := resource.methods[r.Method]
+	if !ok {
+		writeRestError(w, newRequestError(
+			http.StatusMethodNotAllowed,
+			"METHOD_NOT_ALLOWED",
+			"HTTP Method '%s' not allowed. Allowed are %s", r.Method, resource.allowedMethods(),
+		))
+		return
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeRestError(w, newRequestError(http.StatusBadRequest, "INVALID_REQUEST", "%s", err.Error()))
+		return
+	}
+	params, err := builtin.RestParameters(m, body)
+	if err != nil {
+		writeRestError(w, newRequestError(http.StatusBadRequest, "JSON_PARSER_ERROR", "%s", err.Error()))
+		return
+	}
+
+	headers := map[string]string{}
+	for key := range r.Header {
+		headers[key] = r.Header.Get(key)
+	}
+	query := map[string]string{}
+	for key := range r.URL.Query() {
+		query[key] = r.URL.Query().Get(key)
+	}
+	remoteAddress, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remoteAddress = r.RemoteAddr
+	}
+	request := builtin.NewRestRequest(r.Method, path, RestPathPrefix+resource.urlMapping, remoteAddress, headers, query, body)
+	response := builtin.NewRestResponse()
+
+	var responseBody []byte
+	i := s.newInterpreter()
+	err = runIsolated(i, s.timeout(), func() error {
+		return runTransaction(i, false, func() error {
+			i.LoadStaticField()
+			i.Context.StaticField.Add("_", "RestContext", "request", request)
+			i.Context.StaticField.Add("_", "RestContext", "response", response)
+			result, err := i.InvokeStaticMethod(resource.classType, m, params)
+			if err != nil {
+				return err
+			}
+			if m.ReturnType != nil {
+				responseBody, err = builtin.SerializeRestResult(result)
+			}
+			return err
+		})
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	statusCode, responseHeaders, body := builtin.RestResponseOf(response)
+	if m.ReturnType != nil {
+		body = responseBody
+		w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	}
+	for key, value := range responseHeaders {
+		w.Header().Set(key, value)
+	}
+	w.WriteHeader(statusCode)
+	w.Write(body)
+}
+
+// writeRestError writes the error in the list as Salesforce does, unlike the object of /{Class}/{method}
+func writeRestError(w http.ResponseWriter, err error) {
+	statusCode, res := newErrorResponse(err)
+	writeJson(w, statusCode, []*ErrorResponse{res})
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/tzmfreedom/land/builtin"
+)
+
+func TestUrlMappingPattern(t *testing.T) {
+	testCases := []struct {
+		UrlMapping string
+		Path       string
+		Expected   bool
+	}{
+		{"/accounts/*", "/accounts/001", true},
+		{"/accounts/*", "/accounts", true},
+		{"/accounts/*", "/accounts/001/contacts", true},
+		{"/accounts/*", "/accountsX", false},
+		{"/accounts/*", "/contacts/001", false},
+		{"/accounts", "/accounts", true},
+		{"/accounts", "/accounts/001", false},
+		{"/accounts/*/contacts", "/accounts/001/contacts", true},
+		{"/accounts/*/contacts", "/accounts/001/cases", false},
+		{"/v1.0/accounts", "/v1.0/accounts", true},
+		{"/v1.0/accounts", "/v1x0/accounts", false},
+	}
+	for i, testCase := range testCases {
+		actual := urlMappingPattern(testCase.UrlMapping).MatchString(testCase.Path)
+		if actual != testCase.Expected {
+			t.Errorf("%d: expected %t, actual %t: %s, %s", i, testCase.Expected, actual, testCase.UrlMapping, testCase.Path)
+		}
+	}
+}
+
+func TestNewRestResources(t *testing.T) {
+	classTypes := compileClasses(t,
+		`@RestResource(urlMapping='/accounts/*')
+public class AccountResource {
+	@HttpGet
+	public static String show() { return 'account'; }
+}`,
+		`public class NotResource {
+	public static String show() { return 'not'; }
+}`,
+		`@RestResource(urlMapping='/accounts/*/contacts')
+public class ContactResource {
+	@HttpGet
+	public static String show() { return 'contact'; }
+	@HttpPost
+	public static String create() { return 'created'; }
+}`,
+	)
+	resources, err := newRestResources(classTypes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, resource := range resources {
+		names = append(names, resource.classType.Name)
+	}
+	if strings.Join(names, ",") != "ContactResource,AccountResource" {
+		t.Errorf("expected ContactResource,AccountResource, actual %s", strings.Join(names, ","))
+	}
+	if resource := findRestResource(resources, "/accounts/001/contacts"); resource == nil || resource.classType.Name != "ContactResource" {
+		t.Errorf("expected ContactResource, actual %v", resource)
+	}
+	if allowed := resources[0].allowedMethods(); allowed != "GET, POST" {
+		t.Errorf("expected GET, POST, actual %s", allowed)
+	}
+
+	testCases := []struct {
+		Code  string
+		Error string
+	}{
+		{
+			`@RestResource(urlMapping='/accounts')
+public class Foo {
+	@HttpGet
+	public static String a() { return 'a'; }
+	@HttpGet
+	public static String b() { return 'b'; }
+}`,
+			"Foo: only one method can be annotated with @HttpGet",
+		},
+		{
+			`@RestResource(urlMapping='accounts')
+public class Foo {}`,
+			"Foo: urlMapping of @RestResource must be a string which starts with /",
+		},
+		{
+			`@RestResource
+public class Foo {}`,
+			"Foo: urlMapping of @RestResource must be a string which starts with /",
+		},
+	}
+	for i, testCase := range testCases {
+		_, err := newRestResources(compileClasses(t, testCase.Code))
+		if err == nil || err.Error() != testCase.Error {
+			t.Errorf("%d: expected %s, actual %v", i, testCase.Error, err)
+		}
+	}
+}
+
+func TestRestParameters(t *testing.T) {
+	classTypes := compileClasses(t, `public class Foo {
+	public static void create(String name, Integer count, List<String> tags) {}
+}`)
+	methods, _ := classTypes[0].StaticMethods.Get("create")
+	testCases := []struct {
+		Body     string
+		Expected []string
+		Error    string
+	}{
+		{`{"name": "a", "count": 1, "tags": ["x", "y"]}`, []string{"a", "1", "<List> {\n  x,\n  y\n}"}, ""},
+		{`{"COUNT": 2}`, []string{"null", "2", "null"}, ""},
+		{"", []string{"null", "null", "null"}, ""},
+		{`{"unknown": 1}`, nil, "Unexpected parameter encountered during deserialization: unknown"},
+		{`["a"]`, nil, "Expected a JSON object for the parameters of create, got START_ARRAY"},
+	}
+	for i, testCase := range testCases {
+		params, err := builtin.RestParameters(methods[0], []byte(testCase.Body))
+		if testCase.Error != "" {
+			if err == nil || err.Error() != testCase.Error {
+				t.Errorf("%d: expected %s, actual %v", i, testCase.Error, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err.Error())
+			continue
+		}
+		actual := make([]string, len(params))
+		for j, param := range params {
+			actual[j] = builtin.String(param)
+		}
+		if strings.Join(actual, ", ") != strings.Join(testCase.Expected, ", ") {
+			t.Errorf("%d: expected %v, actual %v", i, testCase.Expected, actual)
+		}
+	}
+}
+
+func TestServeRest(t *testing.T) {
+	useDatabase(t)
+	classTypes := compileClasses(t, `@RestResource(urlMapping='/accounts/*')
+public class AccountResource {
+	@HttpGet
+	public static String show() {
+		return RestContext.request.requestURI;
+	}
+	@HttpPost
+	public static void create(String name) {
+		RestContext.response.statusCode = 201;
+		RestContext.response.responseBody = Blob.valueOf(name);
+	}
+}`)
+	resources, err := newRestResources(classTypes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{classMap: builtin.NewClassMapWithPrimivie(classTypes), resources: resources}
+	testCases := []struct {
+		Method     string
+		Path       string
+		Body       string
+		StatusCode int
+		Expected   string
+	}{
+		{"GET", "/services/apexrest/accounts/001", "", http.StatusOK, `"/accounts/001"`},
+		{"POST", "/services/apexrest/accounts", `{"name": "foo"}`, http.StatusCreated, "foo"},
+		{"POST", "/services/apexrest/accounts", `{"name": 1, "x": 2}`, http.StatusBadRequest, `"errorCode":"JSON_PARSER_ERROR"`},
+		{"GET", "/services/apexrest/contacts/001", "", http.StatusNotFound, `[{"errorCode":"NOT_FOUND","message":"Could not find a match for URL"}]`},
+		{"DELETE", "/services/apexrest/accounts/001", "", http.StatusMethodNotAllowed, `[{"errorCode":"METHOD_NOT_ALLOWED","message":"HTTP Method 'DELETE' not allowed. Allowed are GET, POST"}]`},
+	}
+	for i, testCase := range testCases {
+		w := httptest.NewRecorder()
+		s.serveRest(w, httptest.NewRequest(testCase.Method, testCase.Path, strings.NewReader(testCase.Body)))
+		if w.Code != testCase.StatusCode || !strings.Contains(w.Body.String(), testCase.Expected) {
+			t.Errorf("%d: expected %d %s, actual %d %s", i, testCase.StatusCode, testCase.Expected, w.Code, w.Body.String())
+		}
+	}
+}
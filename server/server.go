@@ -3,84 +3,159 @@ package server
 import (
 	"bytes"
 	"crypto/rand"
-	"database/sql"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
-	_ "github.com/lib/pq"
 	"github.com/tzmfreedom/land/ast"
 	"github.com/tzmfreedom/land/builtin"
 	"github.com/tzmfreedom/land/compiler"
 	"github.com/tzmfreedom/land/interpreter"
 )
 
+var invocationPattern = regexp.MustCompile("^/([^/]+)/([^/]+)$")
+
 type Server struct {
 	ClassTypes []*ast.ClassType
+	Timeout    time.Duration
+	classMap   *ast.ClassMap
+	resources  []*restResource
 }
 
-func (s *Server) Run() {
-	classMap := builtin.NewClassMapWithPrimivie(s.ClassTypes)
-	interpreter := interpreter.NewInterpreter(classMap)
+// Run serves the classes, it returns the error if @RestResource classes are invalid or the server fails to listen
+func (s *Server) Run() error {
+	s.classMap = builtin.NewClassMapWithPrimivie(s.ClassTypes)
+	resources, err := newRestResources(s.ClassTypes)
+	if err != nil {
+		return err
+	}
+	s.resources = resources
 
-	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		reg := regexp.MustCompile("^/([^/]+)/([^/]+)")
-		match := reg.FindStringSubmatch(r.RequestURI)
-		klass := match[1]
-		method := match[2]
-		params := []interface{}{}
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(r.Body)
-		json.Unmarshal(buf.Bytes(), &params)
-
-		parameters := make([]ast.Node, len(params))
-		for i, param := range params {
-			var p ast.Node
-			switch n := param.(type) {
-			case string:
-				p = &ast.StringLiteral{Value: n}
-			case float64:
-				p = &ast.DoubleLiteral{Value: n}
-			case int:
-				p = &ast.IntegerLiteral{Value: n}
-			case bool:
-				p = &ast.BooleanLiteral{Value: n}
-			}
-			parameters[i] = p
-		}
-		name := []string{klass, method}
-		invoke := &ast.MethodInvocation{
-			NameOrExpression: &ast.Name{
-				Value: name,
-			},
-			Parameters: parameters,
+	http.HandleFunc(RestPathPrefix+"/", s.serveRest)
+	http.HandleFunc("/", s.serveInvocation)
+	port := getServerPort()
+	fmt.Println("listening to 0.0.0.0:" + port)
+	return http.ListenAndServe(":"+port, nil)
+}
+
+// newInterpreter creates the interpreter for each request, so that static fields and limits are not shared between requests
+func (s *Server) newInterpreter() *interpreter.Interpreter {
+	i := interpreter.NewInterpreter(s.classMap)
+	i.Context.NameSpaces = builtin.GetNameSpaceStore()
+	return i
+}
+
+func (s *Server) timeout() time.Duration {
+	if s.Timeout <= 0 {
+		return DefaultTimeout
+	}
+	return s.Timeout
+}
+
+// serveInvocation calls the static method of /{Class}/{method} with the JSON array of literal parameters
+func (s *Server) serveInvocation(w http.ResponseWriter, r *http.Request) {
+	match := invocationPattern.FindStringSubmatch(r.URL.Path)
+	if match == nil {
+		writeError(w, newRequestError(http.StatusNotFound, "NOT_FOUND", "Path must be /{Class}/{method}: %s", r.URL.Path))
+		return
+	}
+	classType, ok := s.classMap.Get(match[1])
+	if !ok {
+		writeError(w, newRequestError(http.StatusNotFound, "NOT_FOUND", "Class not found: %s", match[1]))
+		return
+	}
+	if classType.StaticMethods == nil || !hasMethod(classType.StaticMethods, match[2]) {
+		writeError(w, newRequestError(http.StatusNotFound, "NOT_FOUND", "Method not found: %s.%s", match[1], match[2]))
+		return
+	}
+	params := []interface{}{}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, newRequestError(http.StatusBadRequest, "INVALID_REQUEST", "%s", err.Error()))
+		return
+	}
+	if len(bytes.TrimSpace(body)) > 0 {
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		if err := decoder.Decode(&params); err != nil {
+			writeError(w, newRequestError(http.StatusBadRequest, "JSON_PARSER_ERROR", "Parameters must be a JSON array: %s", err.Error()))
+			return
 		}
-		//resolver := &compiler.TypeResolver{
-		//	Context: &compiler.Context{ClassTypes: classMap},
-		//}
-		//if _, err := resolver.ResolveMethod(name, parameters); err != nil {
-		//	panic(err)
-		//}
-		res, err := invoke.Accept(interpreter)
-		if err != nil {
-			panic(err)
+	}
+
+	parameters := make([]ast.Node, len(params))
+	for i, param := range params {
+		var p ast.Node
+		switch n := param.(type) {
+		case string:
+			p = &ast.StringLiteral{Value: n}
+		case json.Number:
+			if i, err := strconv.ParseInt(n.String(), 10, 32); err == nil {
+				p = &ast.IntegerLiteral{Value: int(i)}
+			} else {
+				f, _ := n.Float64()
+				p = &ast.DoubleLiteral{Value: f}
+			}
+		case bool:
+			p = &ast.BooleanLiteral{Value: n}
+		case nil:
+			p = &ast.NullLiteral{}
+		default:
+			writeError(w, newRequestError(http.StatusBadRequest, "JSON_PARSER_ERROR", "Parameter %d must be a string, number, boolean or null", i))
+			return
 		}
-		fmt.Fprintf(w, builtin.String(res.(*ast.Object)))
-		fmt.Fprintln(w)
+		parameters[i] = p
+	}
+	invoke := &ast.MethodInvocation{
+		NameOrExpression: &ast.Name{
+			Value: []string{match[1], match[2]},
+		},
+		Parameters: parameters,
+	}
+	result := builtin.Null
+	i := s.newInterpreter()
+	err = runIsolated(i, s.timeout(), func() error {
+		return runTransaction(i, false, func() error {
+			i.LoadStaticField()
+			res, err := invoke.Accept(i)
+			if err != nil {
+				return err
+			}
+			if obj, ok := res.(*ast.Object); ok {
+				result = obj
+			}
+			return nil
+		})
 	})
-	port := getServerPort()
-	fmt.Println("listening to 0.0.0.0:" + port)
-	err := http.ListenAndServe(":"+port, nil)
 	if err != nil {
-		panic(err)
+		writeError(w, err)
+		return
 	}
+	fmt.Fprintln(w, builtin.String(result))
+}
+
+func hasMethod(methods *ast.MethodMap, name string) bool {
+	_, ok := methods.Get(name)
+	return ok
 }
 
-type EvalServer struct{}
+// EvalServer runs the code of the playground in the sandbox, and shares the code as snippets
+type EvalServer struct {
+	Timeout time.Duration
+	Store   SnippetStore
+	// caps of each execution, see builtin.Sandbox
+	MaxExecutionTime time.Duration
+	MaxStatements    int
+	MaxHeapSize      uint64
+}
 
 type EvalRequest struct {
 	String    string
@@ -89,9 +164,11 @@ type EvalRequest struct {
 }
 
 type EvalResult struct {
-	String string
-	Result bool
-	Error  string
+	String        string
+	Result        bool
+	Error         string
+	ExceptionType string         `json:",omitempty"`
+	Location      *ErrorLocation `json:",omitempty"`
 }
 
 type FormatRequest struct {
@@ -103,181 +180,272 @@ type FormatResponse struct {
 }
 
 func (s *EvalServer) Run() {
-	dbUrl := os.Getenv("DATABASE_URL")
-	db, err := sql.Open("postgres", dbUrl)
-	if err != nil {
-		fmt.Fprintln(os.Stderr, err.Error())
-	}
-
 	http.HandleFunc("/code/", func(w http.ResponseWriter, r *http.Request) {
 		id := strings.TrimPrefix(r.URL.Path, "/code/")
 		switch r.Method {
 		case http.MethodPost:
 			req := map[string]string{}
-			buf := new(bytes.Buffer)
-			buf.ReadFrom(r.Body)
-			json.Unmarshal(buf.Bytes(), &req)
-			_, err := db.Exec("UPDATE snippets SET code = $1, updated_at = CURRENT_TIMESTAMP WHERE id = $2", req["code"], id)
-			if err != nil {
-				fmt.Fprintln(os.Stderr, err.Error())
+			if err := readJson(r, &req); err != nil {
+				writeError(w, err)
+				return
+			}
+			if err := s.Store.Update(id, req["code"]); err != nil {
+				writeStorageError(w, err, id)
+				return
 			}
-			body, err := json.Marshal(map[string]string{
+			writeJson(w, http.StatusOK, map[string]string{
 				"id": id,
 			})
-			fmt.Fprintf(w, string(body))
 		case http.MethodGet:
-			rows, err := db.Query("SELECT code FROM snippets WHERE id = $1", id)
+			code, err := s.Store.Get(id)
 			if err != nil {
-				fmt.Fprintln(os.Stderr, err.Error())
-				w.WriteHeader(http.StatusBadRequest)
+				writeStorageError(w, err, id)
 				return
 			}
-			defer rows.Close()
-			var code string
-			rows.Next()
-			rows.Scan(&code)
-			body, err := json.Marshal(map[string]string{
+			writeJson(w, http.StatusOK, map[string]string{
 				"code": code,
 			})
-			fmt.Fprintf(w, string(body))
+		default:
+			writeError(w, newRequestError(http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "HTTP Method '%s' not allowed", r.Method))
 		}
 	})
 	http.HandleFunc("/code", func(w http.ResponseWriter, r *http.Request) {
 		req := map[string]string{}
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(r.Body)
-		json.Unmarshal(buf.Bytes(), &req)
-		id := generateId()
-		_, err := db.Exec("INSERT INTO snippets(id, code) VALUES ($1, $2)", id, req["code"])
+		if err := readJson(r, &req); err != nil {
+			writeError(w, err)
+			return
+		}
+		id, err := s.Store.Create(req["code"])
 		if err != nil {
-			fmt.Fprintln(os.Stderr, err.Error())
+			writeStorageError(w, err, "")
+			return
 		}
-		body, err := json.Marshal(map[string]string{
+		writeJson(w, http.StatusOK, map[string]string{
 			"id": id,
 		})
-		fmt.Fprintf(w, string(body))
 	})
 	http.HandleFunc("/eval", func(w http.ResponseWriter, r *http.Request) {
-		eval(w, r)
+		s.eval(w, r)
 	})
 	http.HandleFunc("/format", func(w http.ResponseWriter, r *http.Request) {
 		// parse request
 		req := &FormatRequest{}
-		buf := new(bytes.Buffer)
-		buf.ReadFrom(r.Body)
-		json.Unmarshal(buf.Bytes(), &req)
-		b, err := base64.StdEncoding.DecodeString(req.String)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error")
+		if err := readJson(r, req); err != nil {
+			writeError(w, err)
+			return
 		}
-
-		// parse source
-		root, err := ast.ParseString(string(b))
+		b, err := decodeSource(req.String)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error")
+			writeError(w, err)
+			return
 		}
-		// format
-		visitor := &ast.TosVisitor{}
-		str, err := root.Accept(visitor)
+
+		var str interface{}
+		err = runIsolated(nil, s.timeout(), func() error {
+			// parse source
+			root, err := ast.ParseString(string(b))
+			if err != nil {
+				return newRequestError(http.StatusBadRequest, "PARSE_ERROR", "%s", err.Error())
+			}
+			// format
+			visitor := &ast.TosVisitor{}
+			str, err = root.Accept(visitor)
+			return err
+		})
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error")
+			writeError(w, err)
+			return
 		}
 
 		// response
 		b64body := base64.StdEncoding.EncodeToString([]byte(str.(string)))
-		body, err := json.Marshal(&FormatResponse{
+		writeJson(w, http.StatusOK, &FormatResponse{
 			String: b64body,
 		})
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error")
-		}
-		fmt.Fprintf(w, string(body))
 	})
 	http.Handle("/", http.FileServer(http.Dir("eval-server")))
 
 	port := getServerPort()
 	fmt.Println("listening to 0.0.0.0:" + port)
-	err = http.ListenAndServe(":"+port, nil)
+	err := http.ListenAndServe(":"+port, nil)
 	if err != nil {
 		panic(err)
 	}
 }
 
-func eval(w http.ResponseWriter, r *http.Request) {
-	classMap := builtin.NewClassMapWithPrimivie([]*ast.ClassType{})
+// writeStorageError writes 404 if the snippet does not exist, the other errors are logged and hidden from the client
+func writeStorageError(w http.ResponseWriter, err error, id string) {
+	if err == ErrSnippetNotFound {
+		writeError(w, newRequestError(http.StatusNotFound, "NOT_FOUND", "Snippet not found: %s", id))
+		return
+	}
+	fmt.Fprintln(os.Stderr, err.Error())
+	writeError(w, newRequestError(http.StatusInternalServerError, "STORAGE_ERROR", "failed to save or load the snippet"))
+}
+
+func (s *EvalServer) timeout() time.Duration {
+	if s.Timeout <= 0 {
+		return DefaultTimeout
+	}
+	return s.Timeout
+}
 
+// eval compiles and runs the code on a fresh interpreter, the output of System.debug is returned even if it fails
+func (s *EvalServer) eval(w http.ResponseWriter, r *http.Request) {
 	req := &EvalRequest{}
-	buf := new(bytes.Buffer)
-	buf.ReadFrom(r.Body)
-	json.Unmarshal(buf.Bytes(), &req)
-	b, err := base64.StdEncoding.DecodeString(req.String)
+	if err := readJson(r, req); err != nil {
+		writeEvalError(w, err, nil, 0)
+		return
+	}
+	b, err := decodeSource(req.String)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error")
+		writeEvalError(w, err, nil, 0)
+		return
+	}
+	method := req.Method
+	if method == "" {
+		method = "action"
 	}
 
 	classBody := string(b)
+	lineOffset := 0
 	if !req.WithClass {
-		classBody = fmt.Sprintf(`public class Land { public static void action() { %s; } }`, classBody)
-	}
-	// compile
-	root, err := ast.ParseString(classBody)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error")
+		// the code starts at the second line, so that locations of errors are shifted only by a line
+		classBody = fmt.Sprintf("public class Land { public static void action() {\n%s;\n} }", classBody)
+		lineOffset = 1
 	}
-	register := &compiler.ClassRegisterVisitor{}
-	t, err := root.Accept(register)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error")
+	// the class map is copied, classes of the request are not visible from other requests
+	classMap := ast.NewClassMap()
+	for name, classType := range builtin.PrimitiveClassMap().Data {
+		classMap.Data[name] = classType
 	}
-	classType := t.(*ast.ClassType)
-	classMap.Set(classType.Name, classType)
-	typeChecker := compiler.NewTypeChecker()
-	typeChecker.Context.ClassTypes = classMap
-	_, err = typeChecker.VisitClassType(classType)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, err.Error())
-		body, err := json.Marshal(&EvalResult{
-			Error:  err.Error(),
-			Result: false,
-		})
+	interpreter := interpreter.NewInterpreter(classMap)
+	interpreter.Context.NameSpaces = builtin.GetNameSpaceStore()
+	stdout := &lockedBuffer{}
+	interpreter.Extra["stdout"] = stdout
+	interpreter.Extra["stderr"] = new(bytes.Buffer)
+	// callouts and files are not allowed, and the execution is limited by the caps
+	sandbox := builtin.NewSandbox(s.MaxExecutionTime, s.MaxStatements, s.MaxHeapSize)
+	interpreter.Extra["sandbox"] = sandbox
+
+	err = runIsolated(interpreter, s.timeout(), func() error {
+		// compile
+		root, err := ast.ParseString(classBody)
+		if err != nil {
+			return newRequestError(http.StatusBadRequest, "PARSE_ERROR", "%s", err.Error())
+		}
+		register := &compiler.ClassRegisterVisitor{}
+		t, err := root.Accept(register)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error")
+			return newRequestError(http.StatusBadRequest, "COMPILE_ERROR", "%s", err.Error())
 		}
-		w.WriteHeader(http.StatusBadRequest)
-		fmt.Fprint(w, string(body))
+		classType, ok := t.(*ast.ClassType)
+		if !ok {
+			return newRequestError(http.StatusBadRequest, "COMPILE_ERROR", "Code must be a class declaration")
+		}
+		classMap.Set(classType.Name, classType)
+		resolver := compiler.NewTypeRefResolver(classMap, builtin.GetNameSpaceStore())
+		classType, err = resolver.Resolve(classType)
+		if err != nil {
+			return newRequestError(http.StatusBadRequest, "COMPILE_ERROR", "%s", err.Error())
+		}
+		typeChecker := compiler.NewTypeChecker()
+		typeChecker.Context.ClassTypes = classMap
+		typeChecker.Context.NameSpaces = builtin.GetNameSpaceStore()
+		_, err = typeChecker.VisitClassType(classType)
+		if err != nil {
+			return newRequestError(http.StatusBadRequest, "COMPILE_ERROR", "%s", err.Error())
+		}
+		if len(typeChecker.Errors) != 0 {
+			return newCompileError(typeChecker.Errors)
+		}
+		// interpreter
+		invoke := &ast.MethodInvocation{
+			NameOrExpression: &ast.Name{
+				Value: []string{classType.Name, method},
+			},
+		}
+		// changes of the code are always rolled back, the database is shared by all users
+		return runTransaction(interpreter, true, func() error {
+			sandbox.Start()
+			interpreter.LoadStaticField()
+			_, err := invoke.Accept(interpreter)
+			return err
+		})
+	})
+	if err != nil {
+		writeEvalError(w, err, stdout.Bytes(), lineOffset)
 		return
 	}
-	if len(typeChecker.Errors) != 0 {
-		for _, e := range typeChecker.Errors {
-			fmt.Fprintf(os.Stderr, "%s\n", e.Message)
-		}
+
+	writeJson(w, http.StatusOK, &EvalResult{
+		String: base64.StdEncoding.EncodeToString(stdout.Bytes()),
+		Result: true,
+	})
+}
+
+// writeEvalError writes the error with the output before the error, lines of the location are shifted by the offset
+func writeEvalError(w http.ResponseWriter, err error, stdout []byte, lineOffset int) {
+	statusCode, res := newErrorResponse(err)
+	if res.Location != nil {
+		res.Location.Line -= lineOffset
 	}
-	// interpreter
-	invoke := &ast.MethodInvocation{
-		NameOrExpression: &ast.Name{
-			Value: []string{classType.Name, req.Method},
-		},
+	message := res.Message
+	if res.ExceptionType != "" {
+		message = res.ExceptionType + ": " + message
 	}
+	writeJson(w, statusCode, &EvalResult{
+		String:        base64.StdEncoding.EncodeToString(stdout),
+		Result:        false,
+		Error:         message,
+		ExceptionType: res.ExceptionType,
+		Location:      res.Location,
+	})
+}
 
-	interpreter := interpreter.NewInterpreter(classMap)
-	interpreter.LoadStaticField()
-	stdout := new(bytes.Buffer)
-	interpreter.Extra["stdout"] = stdout
-	interpreter.Extra["stderr"] = new(bytes.Buffer)
-	_, err = invoke.Accept(interpreter)
+// lockedBuffer is the output of the interpreter, it is read while the interrupted interpreter may still write
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]byte{}, b.buf.Bytes()...)
+}
+
+// readJson decodes JSON body of the request
+func readJson(r *http.Request, value interface{}) error {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(nil, r.Body, maxRequestSize))
+	if _, ok := err.(*http.MaxBytesError); ok {
+		return newRequestError(http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE", "Request body must be at most %d bytes", maxRequestSize)
+	}
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error")
+		return newRequestError(http.StatusBadRequest, "INVALID_REQUEST", "%s", err.Error())
 	}
+	if err := json.Unmarshal(body, value); err != nil {
+		return newRequestError(http.StatusBadRequest, "JSON_PARSER_ERROR", "%s", err.Error())
+	}
+	return nil
+}
 
-	b64body := base64.StdEncoding.EncodeToString(stdout.Bytes())
-	body, err := json.Marshal(&EvalResult{
-		String: b64body,
-		Result: true,
-	})
+// decodeSource decodes the base64 code of eval-server, the code is limited to maxSourceSize
+func decodeSource(encoded string) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(encoded)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error")
+		return nil, newRequestError(http.StatusBadRequest, "INVALID_REQUEST", "String must be base64 encoded")
+	}
+	if len(b) > maxSourceSize {
+		return nil, newRequestError(http.StatusRequestEntityTooLarge, "REQUEST_TOO_LARGE", "Code must be at most %d bytes", maxSourceSize)
 	}
-	fmt.Fprint(w, string(body))
+	return b, nil
 }
 
 func getServerPort() string {
@@ -297,7 +465,7 @@ func generateId() string {
 	return base64.URLEncoding.EncodeToString(b)
 }
 
-func Run(classTypes []*ast.ClassType) {
-	server := &Server{ClassTypes: classTypes}
-	server.Run()
+func Run(classTypes []*ast.ClassType, timeout time.Duration) error {
+	server := &Server{ClassTypes: classTypes, Timeout: timeout}
+	return server.Run()
 }
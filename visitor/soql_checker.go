@@ -4,11 +4,15 @@ import (
 	"errors"
 
 	"github.com/tzmfreedom/land/ast"
+	"github.com/tzmfreedom/land/builtin"
 )
 
 type SoqlChecker struct{}
 
 func (v *SoqlChecker) VisitClassType(n *ast.ClassType) (interface{}, error) {
+	if builtin.IsEnum(n) {
+		return nil, nil
+	}
 	for _, methods := range n.InstanceMethods.All() {
 		for _, method := range methods {
 			_, err := method.Statements.Accept(v)
@@ -44,6 +48,10 @@ func (v *SoqlChecker) VisitInterfaceDeclaration(n *ast.InterfaceDeclaration) (in
 	return ast.VisitInterfaceDeclaration(v, n)
 }
 
+func (v *SoqlChecker) VisitEnumDeclaration(n *ast.EnumDeclaration) (interface{}, error) {
+	return ast.VisitEnumDeclaration(v, n)
+}
+
 func (v *SoqlChecker) VisitIntegerLiteral(n *ast.IntegerLiteral) (interface{}, error) {
 	return ast.VisitIntegerLiteral(v, n)
 }